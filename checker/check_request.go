@@ -16,6 +16,8 @@ package checker
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/ossf/scorecard/v5/clients"
 	"github.com/ossf/scorecard/v5/internal/packageclient"
@@ -31,11 +33,56 @@ type CheckRequest struct {
 	Repo                  clients.Repo
 	VulnerabilitiesClient clients.VulnerabilitiesClient
 	ProjectClient         packageclient.ProjectPackageClient
+	// RemoteRepoClient, if set, returns a client for another repository on the
+	// same forge at the given ref. It's used to follow reusable workflows and
+	// actions hosted elsewhere. The returned clients are cached for the whole
+	// run and closed when it ends, so callers must not close them.
+	RemoteRepoClient func(repo, ref string) (clients.RepoClient, error)
+	// SASTAlertAge is how long code scanning alerts may stay open before
+	// they're reported. Zero means the default of the probe.
+	SASTAlertAge time.Duration
+	// Cache, if set, is shared by the checks of the request.
+	Cache *RequestCache
 	// UPGRADEv6: return raw results instead of scores.
 	RawResults    *RawResults
 	RequiredTypes []RequestType
 }
 
+// RequestCache holds values shared by the checks of a request, such as parsed
+// files, so that they're only computed once. Its zero value is ready to use.
+type RequestCache struct {
+	values map[string]*cachedValue
+	mu     sync.Mutex
+}
+
+type cachedValue struct {
+	value any
+	err   error
+	once  sync.Once
+}
+
+// Get returns the value for key, calling compute to get it the first time.
+// A nil RequestCache calls compute every time.
+func (c *RequestCache) Get(key string, compute func() (any, error)) (any, error) {
+	if c == nil {
+		return compute()
+	}
+	c.mu.Lock()
+	if c.values == nil {
+		c.values = make(map[string]*cachedValue)
+	}
+	v, ok := c.values[key]
+	if !ok {
+		v = &cachedValue{}
+		c.values[key] = v
+	}
+	c.mu.Unlock()
+	v.once.Do(func() {
+		v.value, v.err = compute()
+	})
+	return v.value, v.err
+}
+
 // RequestType identifies special requirements/attributes that need to be supported by checks.
 type RequestType int
 
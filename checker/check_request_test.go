@@ -118,3 +118,26 @@ func Test_contains(t *testing.T) {
 		})
 	}
 }
+
+func TestRequestCache(t *testing.T) {
+	t.Parallel()
+	var calls int
+	compute := func() (any, error) {
+		calls++
+		return calls, nil
+	}
+	var cache RequestCache
+	for range 2 {
+		v, err := cache.Get("key", compute)
+		if err != nil || v != 1 {
+			t.Errorf("Get() = %v, %v, want 1", v, err)
+		}
+	}
+	if v, _ := cache.Get("other", compute); v != 2 {
+		t.Errorf("Get(other) = %v, want 2", v)
+	}
+	var nilCache *RequestCache
+	if v, _ := nilCache.Get("key", compute); v != 3 {
+		t.Errorf("nil Get() = %v, want 3", v)
+	}
+}
@@ -22,6 +22,10 @@ import (
 
 	opencensusstats "go.opencensus.io/stats"
 	"go.opencensus.io/tag"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 
 	sce "github.com/ossf/scorecard/v5/errors"
 	"github.com/ossf/scorecard/v5/stats"
@@ -29,6 +33,8 @@ import (
 
 const checkRetries = 3
 
+var tracer = otel.Tracer("github.com/ossf/scorecard/v5/checker")
+
 // Runner runs a check with retries.
 type Runner struct {
 	CheckName    string
@@ -75,6 +81,7 @@ type CheckNameToFnMap map[string]Check
 func logStats(ctx context.Context, startTime time.Time, result *CheckResult) error {
 	runTimeInSecs := time.Now().Unix() - startTime.Unix()
 	opencensusstats.Record(ctx, stats.CheckRuntimeInSec.M(runTimeInSecs))
+	opencensusstats.Record(ctx, stats.CheckLatencyInMs.M(float64(time.Since(startTime))/float64(time.Millisecond)))
 
 	if result.Error != nil {
 		ctx, err := tag.New(ctx, tag.Upsert(stats.ErrorName, sce.GetName(result.Error)))
@@ -107,6 +114,12 @@ func (r *Runner) Run(ctx context.Context, c Check) CheckResult {
 		l.Warn(&LogMessage{Text: fmt.Sprintf("tag.New: %v", err)})
 	}
 
+	ctx, span := tracer.Start(ctx, "check "+r.CheckName, trace.WithAttributes(
+		attribute.String("scorecard.check", r.CheckName),
+		attribute.String("scorecard.repo", r.Repo),
+	))
+	defer span.End()
+
 	startTime := time.Now()
 
 	var res CheckResult
@@ -115,6 +128,7 @@ func (r *Runner) Run(ctx context.Context, c Check) CheckResult {
 		checkRequest := r.CheckRequest
 		checkRequest.Ctx = ctx
 		checkRequest.Dlogger = l
+		checkRequest.RepoClient = newInstrumentedRepoClient(ctx, checkRequest.RepoClient)
 		res = c.Fn(&checkRequest)
 		if res.Error != nil && errors.Is(res.Error, sce.ErrRepoUnreachable) {
 			checkRequest.Dlogger.Warn(&LogMessage{
@@ -132,5 +146,10 @@ func (r *Runner) Run(ctx context.Context, c Check) CheckResult {
 	if err := logStats(ctx, startTime, &res); err != nil {
 		panic(err)
 	}
+	if res.Error != nil {
+		span.RecordError(res.Error)
+		span.SetStatus(codes.Error, res.Error.Error())
+	}
+	span.SetAttributes(attribute.Int("scorecard.score", res.Score))
 	return res
 }
@@ -0,0 +1,125 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"errors"
+	"testing"
+
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ossf/scorecard/v5/clients"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+	"github.com/ossf/scorecard/v5/stats"
+)
+
+var errList = errors.New("list failed")
+
+// countByMethod returns the count of a view's rows for a check, by RepoClient method.
+func countByMethod(t *testing.T, name, check string) map[string]int64 {
+	t.Helper()
+	rows, err := view.RetrieveData(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	counts := map[string]int64{}
+	for _, row := range rows {
+		tags := map[tag.Key]string{}
+		for _, tg := range row.Tags {
+			tags[tg.Key] = tg.Value
+		}
+		if tags[stats.CheckName] != check {
+			continue
+		}
+		if data, ok := row.Data.(*view.CountData); ok {
+			counts[tags[stats.ClientMethod]] += data.Value
+		}
+	}
+	return counts
+}
+
+//nolint:paralleltest // Sets the global tracer provider.
+func TestRunnerInstrumentation(t *testing.T) {
+	if err := view.Register(&stats.RepoClientCallCount, &stats.RepoClientErrorCount); err != nil {
+		t.Fatal(err)
+	}
+	defer view.Unregister(&stats.RepoClientCallCount, &stats.RepoClientErrorCount)
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(previous)
+
+	ctrl := gomock.NewController(t)
+	repo := mockrepo.NewMockRepo(ctrl)
+	repo.EXPECT().Host().Return("github.com").AnyTimes()
+	client := mockrepo.NewMockRepoClient(ctrl)
+	client.EXPECT().ListCommits().Return([]clients.Commit{{SHA: "abc"}}, nil).Times(2)
+	client.EXPECT().ListFiles(gomock.Any()).Return(nil, errList)
+	client.EXPECT().ListReleases().Return(nil, clients.ErrUnsupportedFeature)
+
+	const check = "Test-Instrumentation"
+	runner := NewRunner(check, "github.com/ossf/scorecard", &CheckRequest{Repo: repo, RepoClient: client})
+	res := runner.Run(t.Context(), Check{
+		Fn: func(c *CheckRequest) CheckResult {
+			if clients.Unwrap(c.RepoClient) != client {
+				t.Error("Unwrap() doesn't return the check's client")
+			}
+			_, _ = c.RepoClient.ListCommits()
+			_, _ = c.RepoClient.ListCommits()
+			_, _ = c.RepoClient.ListFiles(nil)
+			_, _ = c.RepoClient.ListReleases()
+			return CheckResult{Name: check, Score: 7}
+		},
+	})
+	if res.Score != 7 {
+		t.Errorf("Run() score = %d, want 7", res.Score)
+	}
+
+	calls := countByMethod(t, "RepoClientCallCount", check)
+	if calls["ListCommits"] != 2 || calls["ListFiles"] != 1 || calls["ListReleases"] != 1 {
+		t.Errorf("unexpected RepoClient calls: %v", calls)
+	}
+	// Unsupported features aren't errors.
+	errs := countByMethod(t, "RepoClientErrorCount", check)
+	if len(errs) != 1 || errs["ListFiles"] != 1 {
+		t.Errorf("unexpected RepoClient errors: %v", errs)
+	}
+
+	if err := provider.ForceFlush(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	spans := map[string]int{}
+	var checkSpanID string
+	for _, span := range recorder.Ended() {
+		spans[span.Name()]++
+		if span.Name() == "check "+check {
+			checkSpanID = span.SpanContext().SpanID().String()
+		}
+	}
+	if spans["check "+check] != 1 || spans["RepoClient.ListCommits"] != 2 || spans["RepoClient.ListFiles"] != 1 {
+		t.Errorf("unexpected spans: %v", spans)
+	}
+	for _, span := range recorder.Ended() {
+		if span.Name() == "RepoClient.ListFiles" && span.Parent().SpanID().String() != checkSpanID {
+			t.Error("RepoClient span isn't a child of the check span")
+		}
+	}
+}
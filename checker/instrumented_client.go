@@ -0,0 +1,246 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	opencensusstats "go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/stats"
+)
+
+// instrumentedRepoClient counts and traces the RepoClient calls of a check, so
+// that forge API usage can be attributed to checks. ctx carries the tags and
+// the span of the check.
+type instrumentedRepoClient struct {
+	ctx    context.Context
+	client clients.RepoClient
+}
+
+var _ clients.RepoClient = &instrumentedRepoClient{}
+
+func newInstrumentedRepoClient(ctx context.Context, client clients.RepoClient) clients.RepoClient {
+	if client == nil {
+		return nil
+	}
+	return &instrumentedRepoClient{ctx: ctx, client: client}
+}
+
+// clientCall is an ongoing RepoClient call.
+type clientCall struct {
+	ctx  context.Context
+	span trace.Span
+}
+
+func (c *instrumentedRepoClient) start(method string) clientCall {
+	ctx, err := tag.New(c.ctx, tag.Upsert(stats.ClientMethod, method))
+	if err != nil {
+		ctx = c.ctx
+	}
+	ctx, span := tracer.Start(ctx, "RepoClient."+method)
+	opencensusstats.Record(ctx, stats.RepoClientCalls.M(1))
+	return clientCall{ctx: ctx, span: span}
+}
+
+// end records the outcome of the call and returns its error.
+func (call clientCall) end(err error) error {
+	// Unsupported features are expected, e.g. for local repositories.
+	if err != nil && !errors.Is(err, clients.ErrUnsupportedFeature) {
+		opencensusstats.Record(call.ctx, stats.RepoClientErrors.M(1))
+		call.span.RecordError(err)
+		call.span.SetStatus(codes.Error, err.Error())
+	}
+	call.span.End()
+	return err
+}
+
+// Unwrap returns the instrumented client.
+func (c *instrumentedRepoClient) Unwrap() clients.RepoClient {
+	return c.client
+}
+
+func (c *instrumentedRepoClient) InitRepo(repo clients.Repo, commitSHA string, commitDepth int) error {
+	call := c.start("InitRepo")
+	return call.end(c.client.InitRepo(repo, commitSHA, commitDepth))
+}
+
+func (c *instrumentedRepoClient) URI() string {
+	return c.client.URI()
+}
+
+func (c *instrumentedRepoClient) IsArchived() (bool, error) {
+	call := c.start("IsArchived")
+	archived, err := c.client.IsArchived()
+	return archived, call.end(err)
+}
+
+func (c *instrumentedRepoClient) ListFiles(predicate func(string) (bool, error)) ([]string, error) {
+	call := c.start("ListFiles")
+	files, err := c.client.ListFiles(predicate)
+	return files, call.end(err)
+}
+
+func (c *instrumentedRepoClient) LocalPath() (string, error) {
+	call := c.start("LocalPath")
+	path, err := c.client.LocalPath()
+	return path, call.end(err)
+}
+
+func (c *instrumentedRepoClient) GetFileReader(filename string) (io.ReadCloser, error) {
+	call := c.start("GetFileReader")
+	reader, err := c.client.GetFileReader(filename)
+	return reader, call.end(err)
+}
+
+func (c *instrumentedRepoClient) GetBranch(branch string) (*clients.BranchRef, error) {
+	call := c.start("GetBranch")
+	ref, err := c.client.GetBranch(branch)
+	return ref, call.end(err)
+}
+
+func (c *instrumentedRepoClient) GetCreatedAt() (time.Time, error) {
+	call := c.start("GetCreatedAt")
+	createdAt, err := c.client.GetCreatedAt()
+	return createdAt, call.end(err)
+}
+
+func (c *instrumentedRepoClient) GetDefaultBranchName() (string, error) {
+	call := c.start("GetDefaultBranchName")
+	name, err := c.client.GetDefaultBranchName()
+	return name, call.end(err)
+}
+
+func (c *instrumentedRepoClient) GetDefaultBranch() (*clients.BranchRef, error) {
+	call := c.start("GetDefaultBranch")
+	ref, err := c.client.GetDefaultBranch()
+	return ref, call.end(err)
+}
+
+func (c *instrumentedRepoClient) GetOrgRepoClient(ctx context.Context) (clients.RepoClient, error) {
+	call := c.start("GetOrgRepoClient")
+	client, err := c.client.GetOrgRepoClient(ctx)
+	if err != nil {
+		return nil, call.end(err)
+	}
+	return newInstrumentedRepoClient(c.ctx, client), call.end(nil)
+}
+
+func (c *instrumentedRepoClient) ListCommits() ([]clients.Commit, error) {
+	call := c.start("ListCommits")
+	commits, err := c.client.ListCommits()
+	return commits, call.end(err)
+}
+
+func (c *instrumentedRepoClient) ListIssues() ([]clients.Issue, error) {
+	call := c.start("ListIssues")
+	issues, err := c.client.ListIssues()
+	return issues, call.end(err)
+}
+
+func (c *instrumentedRepoClient) ListLicenses() ([]clients.License, error) {
+	call := c.start("ListLicenses")
+	licenses, err := c.client.ListLicenses()
+	return licenses, call.end(err)
+}
+
+func (c *instrumentedRepoClient) ListReleases() ([]clients.Release, error) {
+	call := c.start("ListReleases")
+	releases, err := c.client.ListReleases()
+	return releases, call.end(err)
+}
+
+func (c *instrumentedRepoClient) ListContributors() ([]clients.User, error) {
+	call := c.start("ListContributors")
+	contributors, err := c.client.ListContributors()
+	return contributors, call.end(err)
+}
+
+func (c *instrumentedRepoClient) ListSuccessfulWorkflowRuns(filename string) ([]clients.WorkflowRun, error) {
+	call := c.start("ListSuccessfulWorkflowRuns")
+	runs, err := c.client.ListSuccessfulWorkflowRuns(filename)
+	return runs, call.end(err)
+}
+
+func (c *instrumentedRepoClient) ListCheckRunsForRef(ref string) ([]clients.CheckRun, error) {
+	call := c.start("ListCheckRunsForRef")
+	checkRuns, err := c.client.ListCheckRunsForRef(ref)
+	return checkRuns, call.end(err)
+}
+
+func (c *instrumentedRepoClient) ListStatuses(ref string) ([]clients.Status, error) {
+	call := c.start("ListStatuses")
+	statuses, err := c.client.ListStatuses(ref)
+	return statuses, call.end(err)
+}
+
+func (c *instrumentedRepoClient) ListWebhooks() ([]clients.Webhook, error) {
+	call := c.start("ListWebhooks")
+	webhooks, err := c.client.ListWebhooks()
+	return webhooks, call.end(err)
+}
+
+func (c *instrumentedRepoClient) IsPrivateVulnerabilityReportingEnabled() (bool, error) {
+	call := c.start("IsPrivateVulnerabilityReportingEnabled")
+	enabled, err := c.client.IsPrivateVulnerabilityReportingEnabled()
+	return enabled, call.end(err)
+}
+
+func (c *instrumentedRepoClient) ListSecurityAdvisories() ([]clients.SecurityAdvisory, error) {
+	call := c.start("ListSecurityAdvisories")
+	advisories, err := c.client.ListSecurityAdvisories()
+	return advisories, call.end(err)
+}
+
+func (c *instrumentedRepoClient) GetSecretScanning() (clients.SecretScanning, error) {
+	call := c.start("GetSecretScanning")
+	scanning, err := c.client.GetSecretScanning()
+	return scanning, call.end(err)
+}
+
+func (c *instrumentedRepoClient) ListCodeScanningAlerts() ([]clients.CodeScanningAlert, error) {
+	call := c.start("ListCodeScanningAlerts")
+	alerts, err := c.client.ListCodeScanningAlerts()
+	return alerts, call.end(err)
+}
+
+func (c *instrumentedRepoClient) ListProgrammingLanguages() ([]clients.Language, error) {
+	call := c.start("ListProgrammingLanguages")
+	languages, err := c.client.ListProgrammingLanguages()
+	return languages, call.end(err)
+}
+
+func (c *instrumentedRepoClient) Search(request clients.SearchRequest) (clients.SearchResponse, error) {
+	call := c.start("Search")
+	response, err := c.client.Search(request)
+	return response, call.end(err)
+}
+
+func (c *instrumentedRepoClient) SearchCommits(request clients.SearchCommitsOptions) ([]clients.Commit, error) {
+	call := c.start("SearchCommits")
+	commits, err := c.client.SearchCommits(request)
+	return commits, call.end(err)
+}
+
+func (c *instrumentedRepoClient) Close() error {
+	return c.client.Close()
+}
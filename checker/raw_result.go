@@ -43,6 +43,7 @@ type RawResults struct {
 	PackagingResults            PackagingData
 	PinningDependenciesResults  PinningDependenciesData
 	SASTResults                 SASTData
+	SecretScanningResults       SecretScanningData
 	SecurityPolicyResults       SecurityPolicyData
 	SignedReleasesResults       SignedReleasesData
 	TokenPermissionsResults     TokenPermissionsData
@@ -63,6 +64,15 @@ type RevisionCIInfo struct {
 
 type CITestData struct {
 	CIInfo []RevisionCIInfo
+	// ConfigTestJobs are the jobs of CI configuration files running tests.
+	ConfigTestJobs []CIConfigTestJob
+}
+
+// CIConfigTestJob is a job of a CI configuration file running tests.
+type CIConfigTestJob struct {
+	File   File
+	System string
+	Job    string
 }
 
 // FuzzingData represents different fuzzing done.
@@ -120,8 +130,73 @@ const (
 	DependencyUseTypePipCommand DependencyUseType = "pipCommand"
 	// DependencyUseTypeNugetCommand is a nuget command.
 	DependencyUseTypeNugetCommand DependencyUseType = "nugetCommand"
+	// DependencyUseTypeComposeContainerImage is a container image used by a docker-compose service.
+	DependencyUseTypeComposeContainerImage DependencyUseType = "composeContainerImage"
+	// DependencyUseTypeKubernetesContainerImage is a container image used by a Kubernetes manifest
+	// or set by Kustomize.
+	DependencyUseTypeKubernetesContainerImage DependencyUseType = "kubernetesContainerImage"
+	// DependencyUseTypeHelmContainerImage is a container image set in the values of a Helm chart.
+	DependencyUseTypeHelmContainerImage DependencyUseType = "helmContainerImage"
+	// DependencyUseTypeDevcontainerImage is the container image of a devcontainer.json file.
+	DependencyUseTypeDevcontainerImage DependencyUseType = "devcontainerImage"
+	// DependencyUseTypeGHActionContainerImage is the container image of a workflow job or service.
+	DependencyUseTypeGHActionContainerImage DependencyUseType = "workflowContainerImage"
+	// DependencyUseTypeAzurePipelinesTask is a task of an Azure Pipelines job.
+	DependencyUseTypeAzurePipelinesTask DependencyUseType = "azurePipelinesTask"
+	// DependencyUseTypeAzurePipelinesRepository is a repository resource of an Azure Pipelines file.
+	DependencyUseTypeAzurePipelinesRepository DependencyUseType = "azurePipelinesRepository"
+	// DependencyUseTypeAzurePipelinesContainerImage is a container resource or job container of an Azure Pipelines file.
+	DependencyUseTypeAzurePipelinesContainerImage DependencyUseType = "azurePipelinesContainerImage"
+	// DependencyUseTypeCircleCIOrb is an orb of a CircleCI configuration.
+	DependencyUseTypeCircleCIOrb DependencyUseType = "circleCIOrb"
+	// DependencyUseTypeBuildkitePlugin is a plugin of a Buildkite pipeline step.
+	DependencyUseTypeBuildkitePlugin DependencyUseType = "buildkitePlugin"
+	// DependencyUseTypeJenkinsLibrary is a shared library loaded by a Jenkinsfile.
+	DependencyUseTypeJenkinsLibrary DependencyUseType = "jenkinsLibrary"
+	// DependencyUseTypeCIContainerImage is the image of a CircleCI, Jenkins or Buildkite job.
+	DependencyUseTypeCIContainerImage DependencyUseType = "ciContainerImage"
+	// DependencyUseTypeCargoManifest is a Cargo.toml manifest.
+	DependencyUseTypeCargoManifest DependencyUseType = "cargoManifest"
+	// DependencyUseTypeNpmManifest is a package.json manifest.
+	DependencyUseTypeNpmManifest DependencyUseType = "npmManifest"
+	// DependencyUseTypePythonManifest is a pyproject.toml manifest.
+	DependencyUseTypePythonManifest DependencyUseType = "pythonManifest"
+	// DependencyUseTypeBundlerManifest is a Gemfile manifest.
+	DependencyUseTypeBundlerManifest DependencyUseType = "bundlerManifest"
+	// DependencyUseTypeGoManifest is a go.mod manifest.
+	DependencyUseTypeGoManifest DependencyUseType = "goManifest"
+	// DependencyUseTypeGradleManifest is a build.gradle(.kts) manifest.
+	DependencyUseTypeGradleManifest DependencyUseType = "gradleManifest"
+	// DependencyUseTypeMavenManifest is a pom.xml manifest.
+	DependencyUseTypeMavenManifest DependencyUseType = "mavenManifest"
 )
 
+// IsContainerImage returns whether the dependency use is a container image.
+func (t DependencyUseType) IsContainerImage() bool {
+	switch t {
+	case DependencyUseTypeDockerfileContainerImage, DependencyUseTypeComposeContainerImage,
+		DependencyUseTypeKubernetesContainerImage, DependencyUseTypeHelmContainerImage,
+		DependencyUseTypeDevcontainerImage, DependencyUseTypeGHActionContainerImage,
+		DependencyUseTypeAzurePipelinesContainerImage, DependencyUseTypeCIContainerImage:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsManifest returns whether the dependency use is a manifest declaring
+// dependencies, which are pinned by a lockfile.
+func (t DependencyUseType) IsManifest() bool {
+	switch t {
+	case DependencyUseTypeCargoManifest, DependencyUseTypeNpmManifest, DependencyUseTypePythonManifest,
+		DependencyUseTypeBundlerManifest, DependencyUseTypeGoManifest, DependencyUseTypeGradleManifest,
+		DependencyUseTypeMavenManifest:
+		return true
+	default:
+		return false
+	}
+}
+
 // PinningDependenciesData represents pinned dependency data.
 type PinningDependenciesData struct {
 	Dependencies     []Dependency
@@ -138,7 +213,16 @@ type Dependency struct {
 	Msg         *string // Only for debug messages.
 	Pinned      *bool
 	Remediation *finding.Remediation
-	Type        DependencyUseType
+	// Lockfile is the lockfile of a manifest dependency use, nil if it has none.
+	Lockfile *Lockfile
+	Type     DependencyUseType
+}
+
+// Lockfile represents the lockfile pinning the dependencies of a manifest.
+type Lockfile struct {
+	Path string
+	// Integrity is whether the lockfile records the hashes of the packages.
+	Integrity bool
 }
 
 // MaintainedData contains the raw results
@@ -257,9 +341,15 @@ type SecurityPolicyFile struct {
 // SASTData contains the raw results
 // for the SAST check.
 type SASTData struct {
-	Workflows    []SASTWorkflow
-	Commits      []SASTCommit
-	NumWorkflows int
+	Workflows []SASTWorkflow
+	Commits   []SASTCommit
+	// Alerts holds the open code scanning alerts, if AlertsAvailable.
+	Alerts []clients.CodeScanningAlert
+	// AlertAge is how long alerts may stay open before they're reported,
+	// or zero for the default.
+	AlertAge        time.Duration
+	NumWorkflows    int
+	AlertsAvailable bool
 }
 
 type SASTCommit struct {
@@ -288,6 +378,24 @@ const (
 	QodanaWorkflow SASTWorkflowType = "Qodana"
 	// HadolintWorkflow represents a workflow that runs Hadolint.
 	HadolintWorkflow SASTWorkflowType = "Hadolint"
+	// SemgrepWorkflow represents a workflow that runs Semgrep.
+	SemgrepWorkflow SASTWorkflowType = "Semgrep"
+	// GosecWorkflow represents a workflow that runs gosec.
+	GosecWorkflow SASTWorkflowType = "gosec"
+	// BanditWorkflow represents a workflow that runs Bandit.
+	BanditWorkflow SASTWorkflowType = "Bandit"
+	// BrakemanWorkflow represents a workflow that runs Brakeman.
+	BrakemanWorkflow SASTWorkflowType = "Brakeman"
+	// SpotBugsWorkflow represents a build that runs SpotBugs.
+	SpotBugsWorkflow SASTWorkflowType = "SpotBugs"
+	// ClangTidyWorkflow represents a workflow that runs clang-tidy.
+	ClangTidyWorkflow SASTWorkflowType = "clang-tidy"
+	// BearerWorkflow represents a workflow that runs Bearer.
+	BearerWorkflow SASTWorkflowType = "Bearer"
+	// GitLabSASTWorkflow represents a pipeline that includes GitLab SAST.
+	GitLabSASTWorkflow SASTWorkflowType = "GitLab SAST"
+	// MicrosoftSecurityDevOpsWorkflow represents a pipeline that runs Microsoft Security DevOps.
+	MicrosoftSecurityDevOpsWorkflow SASTWorkflowType = "Microsoft Security DevOps"
 )
 
 // SASTWorkflow represents a SAST workflow.
@@ -299,7 +407,18 @@ type SASTWorkflow struct {
 // SecurityPolicyData contains the raw results
 // for the Security-Policy check.
 type SecurityPolicyData struct {
-	PolicyFiles []SecurityPolicyFile
+	PolicyFiles            []SecurityPolicyFile
+	VulnerabilityReporting VulnerabilityReportingData
+}
+
+// VulnerabilityReportingData contains the forge settings and history
+// related to handling vulnerability reports.
+type VulnerabilityReportingData struct {
+	// PrivateReportingEnabled is nil if the forge didn't provide the setting.
+	PrivateReportingEnabled *bool
+	Advisories              []clients.SecurityAdvisory
+	// AdvisoriesAvailable is false if the forge didn't provide the advisories.
+	AdvisoriesAvailable bool
 }
 
 // BinaryArtifactData contains the raw results
@@ -323,6 +442,29 @@ type DependencyUpdateToolData struct {
 	Tools []Tool
 }
 
+// SecretScanningData contains the raw results
+// for the Secret-Scanning check.
+type SecretScanningData struct {
+	// Enabled reports whether the forge scans pushed content for secrets.
+	// nil means the forge doesn't expose the setting.
+	Enabled *bool
+	// PushProtection reports whether pushes containing secrets are blocked.
+	// nil means the forge doesn't expose the setting.
+	PushProtection *bool
+	// Tools contains secret scanners configured in the repository itself.
+	Tools []Tool
+	// CommittedSecrets contains credentials found in the repository's files.
+	CommittedSecrets []CommittedSecret
+}
+
+// CommittedSecret is a credential found in a repository file.
+// File.Snippet holds the matching line with the secret redacted.
+type CommittedSecret struct {
+	// Rule is the name of the detector that matched, e.g. "GitHub token".
+	Rule string
+	File File
+}
+
 // WebhooksData contains the raw results
 // for the Webhook check.
 type WebhooksData struct {
@@ -371,6 +513,10 @@ type File struct {
 	EndOffset uint             // End of offset in the file, e.g. if the command spans multiple lines.
 	FileSize  uint             // Total size of file.
 	Type      finding.FileType // Type of file.
+	// CallChain lists the files through which a reusable workflow or action was
+	// reached, starting with a workflow of the repository, separated by " -> ".
+	// Empty for other files.
+	CallChain string
 	// TODO: add hash.
 }
 
@@ -387,6 +533,15 @@ const (
 	DangerousWorkflowScriptInjection DangerousWorkflowType = "scriptInjection"
 	// DangerousWorkflowUntrustedCheckout represents an untrusted checkout.
 	DangerousWorkflowUntrustedCheckout DangerousWorkflowType = "untrustedCheckout"
+	// DangerousWorkflowArtifactPoisoning represents a workflow_run workflow
+	// which unpacks or executes artifacts of the run which triggered it.
+	DangerousWorkflowArtifactPoisoning DangerousWorkflowType = "artifactPoisoning"
+	// DangerousWorkflowCachePoisoning represents a privileged job which
+	// writes to a cache after checking out untrusted code.
+	DangerousWorkflowCachePoisoning DangerousWorkflowType = "cachePoisoning"
+	// DangerousWorkflowSelfHostedRunner represents a job which runs
+	// on a self-hosted runner for pull requests, including from forks.
+	DangerousWorkflowSelfHostedRunner DangerousWorkflowType = "selfHostedRunner"
 )
 
 // DangerousWorkflowData contains raw results
@@ -401,6 +556,10 @@ type DangerousWorkflow struct {
 	Job  *WorkflowJob
 	Type DangerousWorkflowType
 	File File
+	// TaintPath is set for script injections of untrusted input which reaches
+	// the script through intermediate values. It lists the untrusted input,
+	// followed by the values it flows through, e.g. "env.TITLE".
+	TaintPath []string
 }
 
 // WorkflowJob represents a workflow job.
@@ -450,7 +609,12 @@ type TokenPermission struct {
 	Value        *string
 	File         *File
 	Msg          *string
-	Type         PermissionLevel
+	// Remediation, if set, holds a patch declaring the minimal permissions
+	// inferred from the actions and API calls of the job. Top-level patches
+	// only grant read scopes, or read-all if the needs of the jobs can't be
+	// inferred, and write scopes go in the patches of the jobs.
+	Remediation *finding.Remediation
+	Type        PermissionLevel
 }
 
 // Location generates location from a file.
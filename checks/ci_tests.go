@@ -29,6 +29,7 @@ const CheckCITests = "CI-Tests"
 func init() {
 	supportedRequestTypes := []checker.RequestType{
 		checker.CommitBased,
+		checker.FileBased,
 	}
 	if err := registerCheck(CheckCITests, CITests, supportedRequestTypes); err != nil {
 		// this should never happen
@@ -37,7 +38,7 @@ func init() {
 }
 
 func CITests(c *checker.CheckRequest) checker.CheckResult {
-	rawData, err := raw.CITests(c.RepoClient)
+	rawData, err := raw.CITests(c)
 	if err != nil {
 		e := sce.WithMessage(sce.ErrScorecardInternal, err.Error())
 		return checker.CreateRuntimeErrorResult(CheckCITests, e)
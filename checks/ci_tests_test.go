@@ -29,6 +29,7 @@ func TestCITestsRuntimeError(t *testing.T) {
 
 	ctrl := gomock.NewController(t)
 	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return(nil, nil).AnyTimes()
 	mockRepoClient.EXPECT().ListCommits().Return(nil, fmt.Errorf("some runtime error")).AnyTimes()
 
 	req := checker.CheckRequest{
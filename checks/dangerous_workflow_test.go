@@ -60,6 +60,15 @@ func TestDangerousWorkflow(t *testing.T) {
 				NumberOfWarn: 1,
 			},
 		},
+		{
+			name:          "self-hosted runner on pull requests is a failing score",
+			workflowPaths: []string{".github/workflows/github-workflow-dangerous-pattern-self-hosted-runner.yml"},
+			err:           nil,
+			expected: scut.TestReturn{
+				Score:        checker.MinResultScore,
+				NumberOfWarn: 1,
+			},
+		},
 		{
 			name: "only safe workflows is passing score",
 			workflowPaths: []string{
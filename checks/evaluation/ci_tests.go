@@ -20,6 +20,7 @@ import (
 	"github.com/ossf/scorecard/v5/checker"
 	sce "github.com/ossf/scorecard/v5/errors"
 	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/testsConfiguredInCI"
 	"github.com/ossf/scorecard/v5/probes/testsRunInCI"
 )
 
@@ -30,6 +31,7 @@ func CITests(name string,
 	dl checker.DetailLogger,
 ) checker.CheckResult {
 	expectedProbes := []string{
+		testsConfiguredInCI.Probe,
 		testsRunInCI.Probe,
 	}
 	if !finding.UniqueProbesEqual(findings, expectedProbes) {
@@ -37,6 +39,23 @@ func CITests(name string,
 		return checker.CreateRuntimeErrorResult(name, e)
 	}
 
+	var configJobs int
+	prFindings := make([]finding.Finding, 0, len(findings))
+	for i := range findings {
+		f := &findings[i]
+		if f.Probe != testsConfiguredInCI.Probe {
+			prFindings = append(prFindings, *f)
+			continue
+		}
+		if f.Outcome == finding.OutcomeTrue {
+			configJobs++
+			dl.Info(&checker.LogMessage{
+				Finding: f,
+			})
+		}
+	}
+	findings = prFindings
+
 	// Debug PRs that were merged without CI tests
 	for i := range findings {
 		f := &findings[i]
@@ -49,6 +68,12 @@ func CITests(name string,
 
 	// check that the project has pull requests
 	if noPullRequestsFound(findings) {
+		// Without pull requests, as for local directories, the CI configuration only
+		// tells whether tests can run, not whether they ran before merging.
+		if configJobs > 0 {
+			return checker.CreateInconclusiveResult(CheckCITests,
+				fmt.Sprintf("no pull request found, %d CI configuration jobs run tests", configJobs))
+		}
 		return checker.CreateInconclusiveResult(CheckCITests, "no pull request found")
 	}
 
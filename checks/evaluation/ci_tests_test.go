@@ -16,6 +16,7 @@ package evaluation
 import (
 	"testing"
 
+	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/finding"
 	scut "github.com/ossf/scorecard/v5/utests"
 )
@@ -38,6 +39,11 @@ func TestCITests(t *testing.T) {
 					Message:  "CI test found: pr: 1, context: e2e",
 					Location: &finding.Location{Type: 4},
 				},
+				{
+					Outcome: finding.OutcomeFalse,
+					Probe:   "testsConfiguredInCI",
+					Message: "no CI configuration running tests found",
+				},
 			},
 			result: scut.TestReturn{
 				Score:         10,
@@ -71,6 +77,11 @@ func TestCITests(t *testing.T) {
 					Message:  "CI test found: pr: 1, context: e2e",
 					Location: &finding.Location{Type: 4},
 				},
+				{
+					Outcome: finding.OutcomeFalse,
+					Probe:   "testsConfiguredInCI",
+					Message: "no CI configuration running tests found",
+				},
 			},
 			result: scut.TestReturn{
 				Score:         7,
@@ -98,12 +109,55 @@ func TestCITests(t *testing.T) {
 					Message:  "merged PR 1 without CI test at HEAD: 1",
 					Location: &finding.Location{Type: 4},
 				},
+				{
+					Outcome: finding.OutcomeFalse,
+					Probe:   "testsConfiguredInCI",
+					Message: "no CI configuration running tests found",
+				},
 			},
 			result: scut.TestReturn{
 				NumberOfDebug: 3,
 				Score:         0,
 			},
 		},
+		{
+			name: "No pull requests, CI configuration runs tests",
+			findings: []finding.Finding{
+				{
+					Outcome: finding.OutcomeNotApplicable,
+					Probe:   "testsRunInCI",
+					Message: "no pull requests found",
+				},
+				{
+					Outcome:  finding.OutcomeTrue,
+					Probe:    "testsConfiguredInCI",
+					Message:  "CircleCI job 'test' runs tests",
+					Location: &finding.Location{Path: ".circleci/config.yml"},
+				},
+			},
+			result: scut.TestReturn{
+				Score:        checker.InconclusiveResultScore,
+				NumberOfInfo: 1,
+			},
+		},
+		{
+			name: "No pull requests, no CI configuration running tests",
+			findings: []finding.Finding{
+				{
+					Outcome: finding.OutcomeNotApplicable,
+					Probe:   "testsRunInCI",
+					Message: "no pull requests found",
+				},
+				{
+					Outcome: finding.OutcomeFalse,
+					Probe:   "testsConfiguredInCI",
+					Message: "no CI configuration running tests found",
+				},
+			},
+			result: scut.TestReturn{
+				Score: checker.InconclusiveResultScore,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
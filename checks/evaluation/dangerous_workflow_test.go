@@ -49,6 +49,18 @@ func TestDangerousWorkflow(t *testing.T) {
 						Snippet:   &testSnippet,
 					},
 				},
+				{
+					Probe:   "hasDangerousWorkflowArtifactPoisoning",
+					Outcome: finding.OutcomeFalse,
+				},
+				{
+					Probe:   "hasDangerousWorkflowCachePoisoning",
+					Outcome: finding.OutcomeFalse,
+				},
+				{
+					Probe:   "hasDangerousWorkflowSelfHostedRunner",
+					Outcome: finding.OutcomeFalse,
+				},
 			},
 			result: scut.TestReturn{
 				Score:        0,
@@ -65,6 +77,18 @@ func TestDangerousWorkflow(t *testing.T) {
 					Probe:   "hasDangerousWorkflowUntrustedCheckout",
 					Outcome: finding.OutcomeNotApplicable,
 				},
+				{
+					Probe:   "hasDangerousWorkflowArtifactPoisoning",
+					Outcome: finding.OutcomeNotApplicable,
+				},
+				{
+					Probe:   "hasDangerousWorkflowCachePoisoning",
+					Outcome: finding.OutcomeNotApplicable,
+				},
+				{
+					Probe:   "hasDangerousWorkflowSelfHostedRunner",
+					Outcome: finding.OutcomeNotApplicable,
+				},
 			},
 			result: scut.TestReturn{
 				Score: checker.InconclusiveResultScore,
@@ -80,6 +104,18 @@ func TestDangerousWorkflow(t *testing.T) {
 					Probe:   "hasDangerousWorkflowUntrustedCheckout",
 					Outcome: finding.OutcomeFalse,
 				},
+				{
+					Probe:   "hasDangerousWorkflowArtifactPoisoning",
+					Outcome: finding.OutcomeFalse,
+				},
+				{
+					Probe:   "hasDangerousWorkflowCachePoisoning",
+					Outcome: finding.OutcomeFalse,
+				},
+				{
+					Probe:   "hasDangerousWorkflowSelfHostedRunner",
+					Outcome: finding.OutcomeFalse,
+				},
 			},
 			result: scut.TestReturn{
 				Score: 10,
@@ -101,6 +137,18 @@ func TestDangerousWorkflow(t *testing.T) {
 					Probe:   "hasDangerousWorkflowUntrustedCheckout",
 					Outcome: finding.OutcomeFalse,
 				},
+				{
+					Probe:   "hasDangerousWorkflowArtifactPoisoning",
+					Outcome: finding.OutcomeFalse,
+				},
+				{
+					Probe:   "hasDangerousWorkflowCachePoisoning",
+					Outcome: finding.OutcomeFalse,
+				},
+				{
+					Probe:   "hasDangerousWorkflowSelfHostedRunner",
+					Outcome: finding.OutcomeFalse,
+				},
 			},
 			result: scut.TestReturn{
 				Score:        0,
@@ -132,6 +180,18 @@ func TestDangerousWorkflow(t *testing.T) {
 					Probe:   "hasDangerousWorkflowUntrustedCheckout",
 					Outcome: finding.OutcomeFalse,
 				},
+				{
+					Probe:   "hasDangerousWorkflowArtifactPoisoning",
+					Outcome: finding.OutcomeFalse,
+				},
+				{
+					Probe:   "hasDangerousWorkflowCachePoisoning",
+					Outcome: finding.OutcomeFalse,
+				},
+				{
+					Probe:   "hasDangerousWorkflowSelfHostedRunner",
+					Outcome: finding.OutcomeFalse,
+				},
 			},
 			result: scut.TestReturn{
 				Score:        0,
@@ -217,12 +277,65 @@ func TestDangerousWorkflow(t *testing.T) {
 					Probe:   "hasDangerousWorkflowUntrustedCheckout",
 					Outcome: finding.OutcomeFalse,
 				},
+				{
+					Probe:   "hasDangerousWorkflowArtifactPoisoning",
+					Outcome: finding.OutcomeFalse,
+				},
+				{
+					Probe:   "hasDangerousWorkflowCachePoisoning",
+					Outcome: finding.OutcomeFalse,
+				},
+				{
+					Probe:   "hasDangerousWorkflowSelfHostedRunner",
+					Outcome: finding.OutcomeFalse,
+				},
 			},
 			result: scut.TestReturn{
 				Score:        0,
 				NumberOfWarn: 8,
 			},
 		},
+		{
+			name: "DangerousWorkflow - self-hosted runner and artifact poisoning detected",
+			findings: []finding.Finding{
+				{
+					Probe:   "hasDangerousWorkflowScriptInjection",
+					Outcome: finding.OutcomeFalse,
+				},
+				{
+					Probe:   "hasDangerousWorkflowUntrustedCheckout",
+					Outcome: finding.OutcomeFalse,
+				},
+				{
+					Probe:   "hasDangerousWorkflowArtifactPoisoning",
+					Outcome: finding.OutcomeTrue,
+					Location: &finding.Location{
+						Type:      finding.FileTypeText,
+						Path:      "./github/workflows/dangerous-workflow.yml",
+						LineStart: &testLineStart,
+						Snippet:   &testSnippet,
+					},
+				},
+				{
+					Probe:   "hasDangerousWorkflowCachePoisoning",
+					Outcome: finding.OutcomeFalse,
+				},
+				{
+					Probe:   "hasDangerousWorkflowSelfHostedRunner",
+					Outcome: finding.OutcomeTrue,
+					Location: &finding.Location{
+						Type:      finding.FileTypeText,
+						Path:      "./github/workflows/dangerous-workflow.yml",
+						LineStart: &testLineStart,
+						Snippet:   &testSnippet,
+					},
+				},
+			},
+			result: scut.TestReturn{
+				Score:        0,
+				NumberOfWarn: 2,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -16,14 +16,23 @@ package evaluation
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/checks/fileparser"
 	sce "github.com/ossf/scorecard/v5/errors"
 	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/hasLockfile"
+	"github.com/ossf/scorecard/v5/probes/lockfileHasIntegrityHashes"
 	"github.com/ossf/scorecard/v5/probes/pinsDependencies"
 )
 
+// manifestKey identifies the manifest of a lockfile finding.
+type manifestKey struct {
+	path    string
+	depType checker.DependencyUseType
+}
+
 type pinnedResult struct {
 	pinned int
 	total  int
@@ -54,9 +63,22 @@ const (
 func PinningDependencies(name string,
 	findings []finding.Finding,
 	dl checker.DetailLogger,
+) checker.CheckResult {
+	// Libraries usually don't commit lockfiles, so manifests are only scored
+	// in experimental mode until they are told apart from applications.
+	_, scoreManifests := os.LookupEnv("SCORECARD_EXPERIMENTAL")
+	return pinningDependencies(name, findings, dl, scoreManifests)
+}
+
+func pinningDependencies(name string,
+	findings []finding.Finding,
+	dl checker.DetailLogger,
+	scoreManifests bool,
 ) checker.CheckResult {
 	expectedProbes := []string{
 		pinsDependencies.Probe,
+		hasLockfile.Probe,
+		lockfileHasIntegrityHashes.Probe,
 	}
 
 	if !finding.UniqueProbesEqual(findings, expectedProbes) {
@@ -66,12 +88,43 @@ func PinningDependencies(name string,
 
 	var wp workflowPinningResult
 	pr := make(map[checker.DependencyUseType]pinnedResult)
+	// Manifests are pinned by a lockfile with integrity hashes.
+	locked := make(map[manifestKey]bool)
+	hashed := make(map[manifestKey]bool)
 
 	for i := range findings {
 		f := findings[i]
+		if f.Probe != pinsDependencies.Probe {
+			if f.Outcome != finding.OutcomeTrue && f.Outcome != finding.OutcomeFalse {
+				continue
+			}
+			if f.Outcome == finding.OutcomeFalse && scoreManifests {
+				dl.Warn(&checker.LogMessage{
+					Finding: &f,
+				})
+			} else if f.Outcome == finding.OutcomeFalse {
+				dl.Debug(&checker.LogMessage{
+					Finding: &f,
+				})
+			}
+			if !scoreManifests {
+				continue
+			}
+			key := manifestKey{depType: checker.DependencyUseType(f.Values[hasLockfile.DepTypeKey])}
+			if f.Location != nil {
+				key.path = f.Location.Path
+			}
+			if f.Probe == hasLockfile.Probe {
+				locked[key] = f.Outcome == finding.OutcomeTrue
+			} else {
+				hashed[key] = f.Outcome == finding.OutcomeTrue
+			}
+			continue
+		}
 		switch f.Outcome {
 		case finding.OutcomeNotApplicable:
-			return checker.CreateInconclusiveResult(name, "no dependencies found")
+			// Manifests may still have been found.
+			continue
 		case finding.OutcomeNotSupported:
 			dl.Debug(&checker.LogMessage{
 				Finding: &f,
@@ -106,6 +159,14 @@ func PinningDependencies(name string,
 			&wp, pr)
 	}
 
+	for key, isLocked := range locked {
+		outcome := finding.OutcomeFalse
+		if isLocked && hashed[key] {
+			outcome = finding.OutcomeTrue
+		}
+		updatePinningResults(key.depType, outcome, nil, &wp, pr)
+	}
+
 	// Generate scores and Info results.
 	var scores []checker.ProportionalScoreWeighted
 	// Go through all dependency types
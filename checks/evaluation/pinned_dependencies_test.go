@@ -15,12 +15,15 @@
 package evaluation
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/hasLockfile"
+	"github.com/ossf/scorecard/v5/probes/lockfileHasIntegrityHashes"
 	"github.com/ossf/scorecard/v5/probes/pinsDependencies"
 	scut "github.com/ossf/scorecard/v5/utests"
 )
@@ -233,9 +236,10 @@ func Test_PinningDependencies(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name     string
-		findings []finding.Finding
-		result   scut.TestReturn
+		name           string
+		findings       []finding.Finding
+		result         scut.TestReturn
+		scoreManifests bool
 	}{
 		{
 			name: "pinned pip dependency scores 10 and shows no warn message",
@@ -430,6 +434,131 @@ func Test_PinningDependencies(t *testing.T) {
 				NumberOfInfo: 1,
 			},
 		},
+		{
+			name: "manifests are pinned by lockfiles with integrity hashes",
+			findings: []finding.Finding{
+				{
+					Probe:   pinsDependencies.Probe,
+					Outcome: finding.OutcomeTrue,
+					Location: &finding.Location{
+						Type:      finding.FileTypeText,
+						Path:      "test-file",
+						LineStart: &testLineStart,
+						Snippet:   &testSnippet,
+					},
+					Values: map[string]string{
+						"dependencyType": string(checker.DependencyUseTypePipCommand),
+					},
+				},
+				{
+					Probe:    hasLockfile.Probe,
+					Outcome:  finding.OutcomeFalse,
+					Location: &finding.Location{Path: "a/Cargo.toml"},
+					Values: map[string]string{
+						"dependencyType": string(checker.DependencyUseTypeCargoManifest),
+					},
+				},
+				{
+					Probe:    hasLockfile.Probe,
+					Outcome:  finding.OutcomeTrue,
+					Location: &finding.Location{Path: "b/Cargo.toml"},
+					Values: map[string]string{
+						"dependencyType": string(checker.DependencyUseTypeCargoManifest),
+					},
+				},
+				{
+					Probe:    lockfileHasIntegrityHashes.Probe,
+					Outcome:  finding.OutcomeTrue,
+					Location: &finding.Location{Path: "b/Cargo.toml"},
+					Values: map[string]string{
+						"dependencyType": string(checker.DependencyUseTypeCargoManifest),
+					},
+				},
+				{
+					Probe:    hasLockfile.Probe,
+					Outcome:  finding.OutcomeTrue,
+					Location: &finding.Location{Path: "package.json"},
+					Values: map[string]string{
+						"dependencyType": string(checker.DependencyUseTypeNpmManifest),
+					},
+				},
+				{
+					Probe:    lockfileHasIntegrityHashes.Probe,
+					Outcome:  finding.OutcomeFalse,
+					Location: &finding.Location{Path: "package.json"},
+					Values: map[string]string{
+						"dependencyType": string(checker.DependencyUseTypeNpmManifest),
+					},
+				},
+			},
+			result: scut.TestReturn{
+				// pip 1/1, cargo 1/2 and npm 0/1, equally weighted.
+				Score:        5,
+				NumberOfInfo: 3,
+				NumberOfWarn: 2,
+			}, scoreManifests: true,
+		},
+		{
+			name: "only manifests",
+			findings: []finding.Finding{
+				{
+					Probe:   pinsDependencies.Probe,
+					Outcome: finding.OutcomeNotApplicable,
+				},
+				{
+					Probe:    hasLockfile.Probe,
+					Outcome:  finding.OutcomeTrue,
+					Location: &finding.Location{Path: "build.gradle"},
+					Values: map[string]string{
+						"dependencyType": string(checker.DependencyUseTypeGradleManifest),
+					},
+				},
+				{
+					Probe:    lockfileHasIntegrityHashes.Probe,
+					Outcome:  finding.OutcomeTrue,
+					Location: &finding.Location{Path: "build.gradle"},
+					Values: map[string]string{
+						"dependencyType": string(checker.DependencyUseTypeGradleManifest),
+					},
+				},
+			},
+			result: scut.TestReturn{
+				Score:        checker.MaxResultScore,
+				NumberOfInfo: 1,
+			},
+			scoreManifests: true,
+		},
+		{
+			name: "manifests are only scored in experimental mode",
+			findings: []finding.Finding{
+				{
+					Probe:   pinsDependencies.Probe,
+					Outcome: finding.OutcomeTrue,
+					Location: &finding.Location{
+						Type:      finding.FileTypeText,
+						Path:      "test-file",
+						LineStart: &testLineStart,
+						Snippet:   &testSnippet,
+					},
+					Values: map[string]string{
+						"dependencyType": string(checker.DependencyUseTypePipCommand),
+					},
+				},
+				{
+					Probe:    hasLockfile.Probe,
+					Outcome:  finding.OutcomeFalse,
+					Location: &finding.Location{Path: "package.json"},
+					Values: map[string]string{
+						"dependencyType": string(checker.DependencyUseTypeNpmManifest),
+					},
+				},
+			},
+			result: scut.TestReturn{
+				Score:         checker.MaxResultScore,
+				NumberOfInfo:  1,
+				NumberOfDebug: 1,
+			},
+		},
 		{
 			name: "processing errors dont affect other dependencies",
 			findings: []finding.Finding{
@@ -462,12 +591,23 @@ func Test_PinningDependencies(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			dl := scut.TestDetailLogger{}
-			got := PinningDependencies(tt.name, tt.findings, &dl)
+			got := pinningDependencies(tt.name, withLockfileProbes(tt.findings), &dl, tt.scoreManifests)
 			scut.ValidateTestReturn(t, tt.name, &tt.result, &got, &dl)
 		})
 	}
 }
 
+// withLockfileProbes adds not applicable findings for the lockfile probes
+// missing from findings.
+func withLockfileProbes(findings []finding.Finding) []finding.Finding {
+	for _, probe := range []string{hasLockfile.Probe, lockfileHasIntegrityHashes.Probe} {
+		if !slices.ContainsFunc(findings, func(f finding.Finding) bool { return f.Probe == probe }) {
+			findings = append(findings, finding.Finding{Probe: probe, Outcome: finding.OutcomeNotApplicable})
+		}
+	}
+	return findings
+}
+
 func stringAsPointer(s string) *string {
 	return &s
 }
@@ -0,0 +1,103 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"fmt"
+
+	"github.com/ossf/scorecard/v5/checker"
+	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/hasCommittedSecrets"
+	"github.com/ossf/scorecard/v5/probes/secretPushProtectionEnabled"
+	"github.com/ossf/scorecard/v5/probes/secretScanningEnabled"
+	"github.com/ossf/scorecard/v5/probes/secretScanningToolConfigured"
+)
+
+const (
+	secretScanningScore = 7
+	pushProtectionScore = 3
+)
+
+// SecretScanning applies the score policy for the Secret-Scanning check.
+func SecretScanning(name string,
+	findings []finding.Finding,
+	dl checker.DetailLogger,
+) checker.CheckResult {
+	expectedProbes := []string{
+		secretScanningEnabled.Probe,
+		secretPushProtectionEnabled.Probe,
+		secretScanningToolConfigured.Probe,
+		hasCommittedSecrets.Probe,
+	}
+
+	if !finding.UniqueProbesEqual(findings, expectedProbes) {
+		e := sce.WithMessage(sce.ErrScorecardInternal, "invalid probe results")
+		return checker.CreateRuntimeErrorResult(name, e)
+	}
+
+	// Forge-side scanning and a scanner configured in the repository
+	// are interchangeable, so either earns the scanning points.
+	var scanning, pushProtection bool
+	var leaks int
+	for i := range findings {
+		f := &findings[i]
+		// Unlike the other probes, a true outcome is bad news.
+		if f.Probe == hasCommittedSecrets.Probe {
+			switch f.Outcome {
+			case finding.OutcomeTrue:
+				leaks++
+				checker.LogFinding(dl, f, checker.DetailWarn)
+			case finding.OutcomeFalse:
+				checker.LogFinding(dl, f, checker.DetailInfo)
+			default:
+				checker.LogFinding(dl, f, checker.DetailDebug)
+			}
+			continue
+		}
+		switch f.Outcome {
+		case finding.OutcomeTrue:
+			switch f.Probe {
+			case secretScanningEnabled.Probe, secretScanningToolConfigured.Probe:
+				scanning = true
+			case secretPushProtectionEnabled.Probe:
+				pushProtection = true
+			}
+			checker.LogFinding(dl, f, checker.DetailInfo)
+		case finding.OutcomeFalse:
+			checker.LogFinding(dl, f, checker.DetailWarn)
+		default:
+			checker.LogFinding(dl, f, checker.DetailDebug)
+		}
+	}
+
+	// A leaked credential is an incident regardless of the tooling in place.
+	if leaks > 0 {
+		return checker.CreateMinScoreResult(name, fmt.Sprintf("%d committed secret(s) found", leaks))
+	}
+
+	switch {
+	case scanning && pushProtection:
+		return checker.CreateMaxScoreResult(name, "secret scanning and push protection are enabled")
+	case scanning:
+		return checker.CreateResultWithScore(name, "secret scanning is enabled without push protection",
+			secretScanningScore)
+	case pushProtection:
+		return checker.CreateResultWithScore(name, "push protection is enabled without secret scanning",
+			pushProtectionScore)
+	default:
+		return checker.CreateMinScoreResult(name, "no secret scanning detected")
+	}
+}
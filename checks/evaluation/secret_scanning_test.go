@@ -0,0 +1,228 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package evaluation
+
+import (
+	"testing"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	scut "github.com/ossf/scorecard/v5/utests"
+)
+
+func TestSecretScanning(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		findings []finding.Finding
+		result   scut.TestReturn
+	}{
+		{
+			name: "Nothing enabled. Min score",
+			findings: []finding.Finding{
+				{
+					Probe:   "secretScanningEnabled",
+					Outcome: finding.OutcomeFalse,
+				},
+				{
+					Probe:   "secretPushProtectionEnabled",
+					Outcome: finding.OutcomeFalse,
+				},
+				{
+					Probe:   "secretScanningToolConfigured",
+					Outcome: finding.OutcomeFalse,
+				},
+				{
+					Probe:   "hasCommittedSecrets",
+					Outcome: finding.OutcomeFalse,
+				},
+			},
+			result: scut.TestReturn{
+				Score:        checker.MinResultScore,
+				NumberOfInfo: 1,
+				NumberOfWarn: 3,
+			},
+		},
+		{
+			name: "Settings unavailable, no tool. Min score",
+			findings: []finding.Finding{
+				{
+					Probe:   "secretScanningEnabled",
+					Outcome: finding.OutcomeNotAvailable,
+				},
+				{
+					Probe:   "secretPushProtectionEnabled",
+					Outcome: finding.OutcomeNotAvailable,
+				},
+				{
+					Probe:   "secretScanningToolConfigured",
+					Outcome: finding.OutcomeFalse,
+				},
+				{
+					Probe:   "hasCommittedSecrets",
+					Outcome: finding.OutcomeFalse,
+				},
+			},
+			result: scut.TestReturn{
+				Score:         checker.MinResultScore,
+				NumberOfInfo:  1,
+				NumberOfWarn:  1,
+				NumberOfDebug: 2,
+			},
+		},
+		{
+			name: "Tool configured, settings unavailable",
+			findings: []finding.Finding{
+				{
+					Probe:   "secretScanningEnabled",
+					Outcome: finding.OutcomeNotAvailable,
+				},
+				{
+					Probe:   "secretPushProtectionEnabled",
+					Outcome: finding.OutcomeNotAvailable,
+				},
+				{
+					Probe:   "secretScanningToolConfigured",
+					Outcome: finding.OutcomeTrue,
+				},
+				{
+					Probe:   "hasCommittedSecrets",
+					Outcome: finding.OutcomeFalse,
+				},
+			},
+			result: scut.TestReturn{
+				Score:         7,
+				NumberOfInfo:  2,
+				NumberOfWarn:  0,
+				NumberOfDebug: 2,
+			},
+		},
+		{
+			name: "Forge scanning without push protection",
+			findings: []finding.Finding{
+				{
+					Probe:   "secretScanningEnabled",
+					Outcome: finding.OutcomeTrue,
+				},
+				{
+					Probe:   "secretPushProtectionEnabled",
+					Outcome: finding.OutcomeFalse,
+				},
+				{
+					Probe:   "secretScanningToolConfigured",
+					Outcome: finding.OutcomeFalse,
+				},
+				{
+					Probe:   "hasCommittedSecrets",
+					Outcome: finding.OutcomeFalse,
+				},
+			},
+			result: scut.TestReturn{
+				Score:        7,
+				NumberOfInfo: 2,
+				NumberOfWarn: 2,
+			},
+		},
+		{
+			name: "Push protection only",
+			findings: []finding.Finding{
+				{
+					Probe:   "secretScanningEnabled",
+					Outcome: finding.OutcomeFalse,
+				},
+				{
+					Probe:   "secretPushProtectionEnabled",
+					Outcome: finding.OutcomeTrue,
+				},
+				{
+					Probe:   "secretScanningToolConfigured",
+					Outcome: finding.OutcomeFalse,
+				},
+				{
+					Probe:   "hasCommittedSecrets",
+					Outcome: finding.OutcomeFalse,
+				},
+			},
+			result: scut.TestReturn{
+				Score:        3,
+				NumberOfInfo: 2,
+				NumberOfWarn: 2,
+			},
+		},
+		{
+			name: "Scanning and push protection. Max score",
+			findings: []finding.Finding{
+				{
+					Probe:   "secretScanningEnabled",
+					Outcome: finding.OutcomeTrue,
+				},
+				{
+					Probe:   "secretPushProtectionEnabled",
+					Outcome: finding.OutcomeTrue,
+				},
+				{
+					Probe:   "secretScanningToolConfigured",
+					Outcome: finding.OutcomeFalse,
+				},
+				{
+					Probe:   "hasCommittedSecrets",
+					Outcome: finding.OutcomeFalse,
+				},
+			},
+			result: scut.TestReturn{
+				Score:        checker.MaxResultScore,
+				NumberOfInfo: 3,
+				NumberOfWarn: 1,
+			},
+		},
+		{
+			name: "Committed secrets. Min score",
+			findings: []finding.Finding{
+				{
+					Probe:   "secretScanningEnabled",
+					Outcome: finding.OutcomeTrue,
+				},
+				{
+					Probe:   "secretPushProtectionEnabled",
+					Outcome: finding.OutcomeTrue,
+				},
+				{
+					Probe:   "secretScanningToolConfigured",
+					Outcome: finding.OutcomeFalse,
+				},
+				{
+					Probe:   "hasCommittedSecrets",
+					Outcome: finding.OutcomeTrue,
+				},
+				{
+					Probe:   "hasCommittedSecrets",
+					Outcome: finding.OutcomeTrue,
+				},
+			},
+			result: scut.TestReturn{
+				Score:        checker.MinResultScore,
+				NumberOfInfo: 2,
+				NumberOfWarn: 3,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			dl := scut.TestDetailLogger{}
+			got := SecretScanning(tt.name, tt.findings, &dl)
+			scut.ValidateTestReturn(t, tt.name, &tt.result, &got, &dl)
+		})
+	}
+}
@@ -0,0 +1,428 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// azurePipelinesDirs are the directories conventionally holding Azure Pipelines files.
+var azurePipelinesDirs = []string{".azure-pipelines", "azure-pipelines", ".azuredevops", ".pipelines"}
+
+// AzurePipeline is a parsed Azure Pipelines file. Stages are flattened
+// into their jobs, and steps listed outside of a job, in a single-job
+// pipeline or a steps template, belong to a job without a name.
+type AzurePipeline struct {
+	Path string
+	// VMImage is the image of the agents running jobs with no pool of their own.
+	VMImage      string
+	Repositories []AzureRepository
+	Containers   []AzureContainer
+	Templates    []AzureTemplate
+	Jobs         []*AzureJob
+}
+
+// AzureRepository is a repository declared in `resources.repositories`.
+type AzureRepository struct {
+	Alias string
+	Type  string
+	Name  string
+	Ref   string
+	Line  int
+}
+
+// AzureContainer is a container image declared in `resources.containers`,
+// or used directly as the container of a job.
+type AzureContainer struct {
+	Alias string
+	Image string
+	Line  int
+}
+
+// AzureTemplate is a reference to a template. Repository is the alias of
+// the repository resource holding the template, empty for local templates.
+type AzureTemplate struct {
+	Path       string
+	Repository string
+	Line       int
+}
+
+// AzureJob is a job, or deployment job, of a pipeline.
+type AzureJob struct {
+	Container *AzureContainer
+	Name      string
+	VMImage   string
+	Steps     []AzureStep
+	Line      int
+}
+
+// AzureStep is a step of a job. Kind is the key defining the step, such as
+// `task`, `script`, `bash`, `pwsh` or `checkout`, and Value its value.
+type AzureStep struct {
+	Inputs             map[string]string
+	Env                map[string]string
+	Kind               string
+	Value              string
+	Line               int
+	PersistCredentials bool
+	// ValueOffset is added to the line numbers within Value to get
+	// the line numbers within the file.
+	ValueOffset int
+}
+
+// Task returns the name and version of a `task` step.
+func (s *AzureStep) Task() (name, version string) {
+	name, version, _ = strings.Cut(s.Value, "@")
+	return name, version
+}
+
+// IsScript returns true if the step runs an inline script.
+func (s *AzureStep) IsScript() bool {
+	switch s.Kind {
+	case "script", "bash", "pwsh", "powershell":
+		return true
+	}
+	return false
+}
+
+// IsAzurePipelinesFile determines if a file is an Azure Pipelines file
+// from its conventional name or location.
+func IsAzurePipelinesFile(pathfn string) bool {
+	pathfn = strings.ToLower(pathfn)
+	if ext := path.Ext(pathfn); ext != ".yml" && ext != ".yaml" {
+		return false
+	}
+	if IsTestdataFile(pathfn) {
+		return false
+	}
+	if strings.HasPrefix(path.Base(pathfn), "azure-pipelines") {
+		return true
+	}
+	for _, dir := range azurePipelinesDirs {
+		if strings.HasPrefix(pathfn, dir+"/") || strings.Contains(pathfn, "/"+dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// azurePipelines are the Azure Pipelines files of a repository, and the
+// errors of those which couldn't be parsed.
+type azurePipelines struct {
+	pipelines []*AzurePipeline
+	invalid   []error
+}
+
+// ReadAzurePipelines parses the Azure Pipelines files of a repository, along
+// with the local templates they reference. Files which can't be parsed are
+// logged and skipped. The files are only parsed once for all the checks of
+// a request with a cache.
+func ReadAzurePipelines(c *checker.CheckRequest) ([]*AzurePipeline, error) {
+	v, err := c.Cache.Get("fileparser.AzurePipelines", func() (any, error) {
+		return readAzurePipelines(c.RepoClient)
+	})
+	if err != nil {
+		return nil, err
+	}
+	//nolint:forcetypeassert // only azurePipelines are cached under this key.
+	r := v.(*azurePipelines)
+	if c.Dlogger != nil {
+		for _, e := range r.invalid {
+			c.Dlogger.Debug(&checker.LogMessage{
+				Text: fmt.Sprintf("skipped %v", e),
+			})
+		}
+	}
+	return r.pipelines, nil
+}
+
+func readAzurePipelines(repoClient clients.RepoClient) (*azurePipelines, error) {
+	files, err := repoClient.ListFiles(func(string) (bool, error) { return true, nil })
+	if err != nil {
+		return nil, fmt.Errorf("error during ListFiles: %w", err)
+	}
+	exists := make(map[string]bool, len(files))
+	var queue []string
+	for _, f := range files {
+		exists[f] = true
+		if IsAzurePipelinesFile(f) {
+			queue = append(queue, f)
+		}
+	}
+
+	var r azurePipelines
+	seen := make(map[string]bool)
+	for len(queue) > 0 {
+		pathfn := queue[0]
+		queue = queue[1:]
+		if seen[pathfn] {
+			continue
+		}
+		seen[pathfn] = true
+
+		content, err := readAll(repoClient, pathfn)
+		if err != nil {
+			return nil, err
+		}
+		p, err := ParseAzurePipeline(pathfn, content)
+		if err != nil {
+			r.invalid = append(r.invalid, err)
+			continue
+		}
+		r.pipelines = append(r.pipelines, p)
+
+		for _, t := range p.Templates {
+			if t.Repository != "" && t.Repository != "self" {
+				continue
+			}
+			tpath := strings.TrimPrefix(t.Path, "/")
+			if !strings.HasPrefix(t.Path, "/") {
+				tpath = path.Join(path.Dir(pathfn), t.Path)
+			}
+			if exists[tpath] && !seen[tpath] {
+				queue = append(queue, tpath)
+			}
+		}
+	}
+	return &r, nil
+}
+
+func readAll(repoClient clients.RepoClient, pathfn string) ([]byte, error) {
+	rc, err := repoClient.GetFileReader(pathfn)
+	if err != nil {
+		return nil, fmt.Errorf("error during GetFileReader: %w", err)
+	}
+	defer rc.Close()
+	content, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, fmt.Errorf("error reading from file: %w", err)
+	}
+	return content, nil
+}
+
+// ParseAzurePipeline parses the content of an Azure Pipelines file.
+// Template expressions are not evaluated: the steps of all their branches
+// are kept, and values set through them are reported as written.
+func ParseAzurePipeline(pathfn string, content []byte) (*AzurePipeline, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", errInvalidAzurePipeline, pathfn, err)
+	}
+	p := &AzurePipeline{Path: pathfn}
+	p.walk(&doc, nil)
+
+	// Job containers naming a container resource refer to its image.
+	aliases := make(map[string]bool, len(p.Containers))
+	for _, c := range p.Containers {
+		aliases[c.Alias] = true
+	}
+	for _, job := range p.Jobs {
+		if job.Container != nil && job.Container.Alias != "" && !aliases[job.Container.Alias] {
+			job.Container.Image, job.Container.Alias = job.Container.Alias, ""
+		}
+	}
+	return p, nil
+}
+
+func (p *AzurePipeline) walk(node *yaml.Node, job *AzureJob) {
+	switch node.Kind {
+	case yaml.DocumentNode, yaml.SequenceNode:
+		for _, n := range node.Content {
+			p.walk(n, job)
+		}
+	case yaml.MappingNode:
+		if isAzureJob(node) {
+			job = &AzureJob{Line: node.Line}
+			p.Jobs = append(p.Jobs, job)
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			switch key.Value {
+			case "job", "deployment":
+				if job != nil && value.Kind == yaml.ScalarNode {
+					job.Name = value.Value
+				}
+				continue
+			case "resources":
+				p.parseResources(value)
+				continue
+			case "template":
+				p.addTemplate(value)
+				continue
+			case "pool":
+				if vmImage := poolVMImage(value); job != nil {
+					job.VMImage = vmImage
+				} else if p.VMImage == "" {
+					p.VMImage = vmImage
+				}
+				continue
+			case "container":
+				if job != nil {
+					job.Container = parseJobContainer(value)
+					continue
+				}
+			case "steps":
+				if value.Kind == yaml.SequenceNode {
+					p.parseSteps(value, job)
+					continue
+				}
+			}
+			p.walk(value, job)
+		}
+	default:
+	}
+}
+
+func isAzureJob(node *yaml.Node) bool {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if k := node.Content[i].Value; k == "job" || k == "deployment" {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *AzurePipeline) parseSteps(steps *yaml.Node, job *AzureJob) {
+	if job == nil {
+		job = &AzureJob{Line: steps.Line}
+		p.Jobs = append(p.Jobs, job)
+	}
+	for _, item := range steps.Content {
+		if item.Kind != yaml.MappingNode {
+			continue
+		}
+		step := AzureStep{Line: item.Line}
+		for i := 0; i+1 < len(item.Content); i += 2 {
+			key, value := item.Content[i], item.Content[i+1]
+			switch key.Value {
+			case "task", "script", "bash", "pwsh", "powershell", "checkout",
+				"download", "downloadBuild", "getPackage", "publish", "reviewApp", "template":
+				step.Kind, step.Value = key.Value, value.Value
+				step.ValueOffset = value.Line - 1
+				if value.Style&(yaml.LiteralStyle|yaml.FoldedStyle) != 0 {
+					step.ValueOffset = value.Line
+				}
+				if key.Value == "template" {
+					p.addTemplate(value)
+				}
+			case "inputs":
+				step.Inputs = scalarMap(value)
+			case "env":
+				step.Env = scalarMap(value)
+			case "persistCredentials":
+				step.PersistCredentials = strings.EqualFold(value.Value, "true")
+			case "parameters":
+				// Steps passed to a step template run in this job.
+				p.walk(value, job)
+			default:
+				// Conditional and loop insertions of steps: `- ${{ if ... }}:`.
+				if isTemplateExpression(key.Value) && value.Kind == yaml.SequenceNode {
+					p.parseSteps(value, job)
+				}
+			}
+		}
+		if step.Kind != "" {
+			job.Steps = append(job.Steps, step)
+		}
+	}
+}
+
+func (p *AzurePipeline) parseResources(node *yaml.Node) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		for _, item := range value.Content {
+			if item.Kind != yaml.MappingNode {
+				continue
+			}
+			fields := scalarMap(item)
+			switch key.Value {
+			case "repositories":
+				p.Repositories = append(p.Repositories, AzureRepository{
+					Alias: fields["repository"],
+					Type:  fields["type"],
+					Name:  fields["name"],
+					Ref:   fields["ref"],
+					Line:  item.Line,
+				})
+			case "containers":
+				p.Containers = append(p.Containers, AzureContainer{
+					Alias: fields["container"],
+					Image: fields["image"],
+					Line:  item.Line,
+				})
+			}
+		}
+	}
+}
+
+func (p *AzurePipeline) addTemplate(node *yaml.Node) {
+	if node.Kind != yaml.ScalarNode || node.Value == "" || isTemplateExpression(node.Value) {
+		return
+	}
+	t := AzureTemplate{Path: node.Value, Line: node.Line}
+	if i := strings.LastIndex(t.Path, "@"); i >= 0 {
+		t.Path, t.Repository = t.Path[:i], t.Path[i+1:]
+	}
+	p.Templates = append(p.Templates, t)
+}
+
+// IsWindows returns true if the job runs on a Windows agent of a Microsoft-hosted pool.
+func (p *AzurePipeline) IsWindows(job *AzureJob) bool {
+	vmImage := job.VMImage
+	if vmImage == "" {
+		vmImage = p.VMImage
+	}
+	return strings.Contains(strings.ToLower(vmImage), "windows")
+}
+
+func poolVMImage(node *yaml.Node) string {
+	if node.Kind != yaml.MappingNode {
+		return ""
+	}
+	return scalarMap(node)["vmImage"]
+}
+
+func parseJobContainer(node *yaml.Node) *AzureContainer {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return &AzureContainer{Alias: node.Value, Line: node.Line}
+	case yaml.MappingNode:
+		return &AzureContainer{Image: scalarMap(node)["image"], Line: node.Line}
+	default:
+		return nil
+	}
+}
+
+func scalarMap(node *yaml.Node) map[string]string {
+	m := make(map[string]string)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i+1].Kind == yaml.ScalarNode {
+			m[node.Content[i].Value] = node.Content[i+1].Value
+		}
+	}
+	return m
+}
+
+func isTemplateExpression(s string) bool {
+	return strings.Contains(s, "${{")
+}
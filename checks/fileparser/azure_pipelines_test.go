@@ -0,0 +1,200 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ossf/scorecard/v5/checker"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+	scut "github.com/ossf/scorecard/v5/utests"
+)
+
+const azurePipeline = `trigger:
+  - main
+resources:
+  repositories:
+    - repository: templates
+      type: github
+      name: contoso/templates
+      ref: refs/tags/v1
+  containers:
+    - container: linux
+      image: ubuntu:22.04
+pool:
+  vmImage: ubuntu-latest
+stages:
+  - stage: Build
+    jobs:
+      - job: Build
+        container: linux
+        steps:
+          - checkout: self
+            persistCredentials: true
+          - task: NuGetCommand@2
+            inputs:
+              command: push
+          - script: |
+              echo hello
+          - ${{ if eq(parameters.sign, true) }}:
+              - bash: ./sign.sh
+                env:
+                  TOKEN: $(System.AccessToken)
+          - template: steps/test.yml
+      - job: Windows
+        pool:
+          vmImage: windows-latest
+        container: mcr.microsoft.com/windows/servercore:ltsc2022
+        steps:
+          - pwsh: Write-Host hello
+  - stage: Deploy
+    jobs:
+      - deployment: Deploy
+        strategy:
+          runOnce:
+            deploy:
+              steps:
+                - template: deploy.yml@templates
+                  parameters:
+                    steps:
+                      - task: AzureCLI@2.1.0
+`
+
+func TestParseAzurePipeline(t *testing.T) {
+	t.Parallel()
+	p, err := ParseAzurePipeline("azure-pipelines.yml", []byte(azurePipeline))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &AzurePipeline{
+		Path:    "azure-pipelines.yml",
+		VMImage: "ubuntu-latest",
+		Repositories: []AzureRepository{
+			{Alias: "templates", Type: "github", Name: "contoso/templates", Ref: "refs/tags/v1", Line: 5},
+		},
+		Containers: []AzureContainer{{Alias: "linux", Image: "ubuntu:22.04", Line: 10}},
+		Templates: []AzureTemplate{
+			{Path: "steps/test.yml", Line: 31},
+			{Path: "deploy.yml", Repository: "templates", Line: 45},
+		},
+		Jobs: []*AzureJob{
+			{
+				Name:      "Build",
+				Line:      17,
+				Container: &AzureContainer{Alias: "linux", Line: 18},
+				Steps: []AzureStep{
+					{Kind: "checkout", Value: "self", Line: 20, ValueOffset: 19, PersistCredentials: true},
+					{Kind: "task", Value: "NuGetCommand@2", Line: 22, ValueOffset: 21,
+						Inputs: map[string]string{"command": "push"}},
+					{Kind: "script", Value: "echo hello\n", Line: 25, ValueOffset: 25},
+					{Kind: "bash", Value: "./sign.sh", Line: 28, ValueOffset: 27,
+						Env: map[string]string{"TOKEN": "$(System.AccessToken)"}},
+					{Kind: "template", Value: "steps/test.yml", Line: 31, ValueOffset: 30},
+				},
+			},
+			{
+				Name:      "Windows",
+				Line:      32,
+				VMImage:   "windows-latest",
+				Container: &AzureContainer{Image: "mcr.microsoft.com/windows/servercore:ltsc2022", Line: 35},
+				Steps:     []AzureStep{{Kind: "pwsh", Value: "Write-Host hello", Line: 37, ValueOffset: 36}},
+			},
+			{
+				Name: "Deploy",
+				Line: 40,
+				Steps: []AzureStep{
+					{Kind: "task", Value: "AzureCLI@2.1.0", Line: 48, ValueOffset: 47},
+					{Kind: "template", Value: "deploy.yml@templates", Line: 45, ValueOffset: 44},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+	if p.IsWindows(p.Jobs[0]) || !p.IsWindows(p.Jobs[1]) {
+		t.Errorf("unexpected Windows jobs")
+	}
+}
+
+func TestIsAzurePipelinesFile(t *testing.T) {
+	t.Parallel()
+	tests := map[string]bool{
+		"azure-pipelines.yml":                true,
+		"ci/azure-pipelines-release.yaml":    true,
+		".azure-pipelines/build.yml":         true,
+		"eng/.pipelines/templates/steps.yml": true,
+		".github/workflows/ci.yml":           false,
+		"azure-pipelines.json":               false,
+		"testdata/azure-pipelines.yml":       false,
+	}
+	for pathfn, want := range tests {
+		if got := IsAzurePipelinesFile(pathfn); got != want {
+			t.Errorf("IsAzurePipelinesFile(%q) = %v, want %v", pathfn, got, want)
+		}
+	}
+}
+
+func TestReadAzurePipelines(t *testing.T) {
+	t.Parallel()
+	files := map[string]string{
+		"azure-pipelines.yml": "steps:\n  - template: build/steps.yml\n  - template: /eng/test.yml\n" +
+			"  - template: remote.yml@templates\n",
+		"build/steps.yml":       "steps:\n  - script: make\n",
+		"eng/test.yml":          "steps:\n  - script: make test\n",
+		"remote.yml":            "steps:\n  - script: make remote\n",
+		"unrelated.yml":         "steps:\n  - script: make\n",
+		".pipelines/broken.yml": "steps: [\n",
+	}
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	var names []string
+	for f := range files {
+		names = append(names, f)
+	}
+	// The files are only read once for the request.
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return(names, nil).Times(1)
+	mockRepoClient.EXPECT().GetFileReader(gomock.Any()).Times(4).DoAndReturn(
+		func(file string) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(files[file])), nil
+		})
+
+	dl := scut.TestDetailLogger{}
+	c := &checker.CheckRequest{RepoClient: mockRepoClient, Dlogger: &dl, Cache: &checker.RequestCache{}}
+	if _, err := ReadAzurePipelines(c); err != nil {
+		t.Fatal(err)
+	}
+	pipelines, err := ReadAzurePipelines(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The invalid file is logged for each read.
+	if n := len(dl.Flush()); n != 2 {
+		t.Errorf("got %d log messages, want 2", n)
+	}
+	var got []string
+	for _, p := range pipelines {
+		got = append(got, p.Path)
+	}
+	want := []string{"azure-pipelines.yml", "build/steps.yml", "eng/test.yml"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
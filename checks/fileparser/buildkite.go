@@ -0,0 +1,101 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// parseBuildkite collects the plugins and the commands of the steps of a
+// Buildkite pipeline, including those of group steps.
+func (c *CIConfig) parseBuildkite(root *yaml.Node) {
+	c.parseBuildkiteSteps(mappingValue(root, "steps"))
+}
+
+func (c *CIConfig) parseBuildkiteSteps(steps *yaml.Node) {
+	if steps == nil || steps.Kind != yaml.SequenceNode {
+		return
+	}
+	for _, step := range steps.Content {
+		if step.Kind != yaml.MappingNode {
+			// `wait` and other scalar steps.
+			continue
+		}
+		if group := mappingValue(step, "steps"); group != nil {
+			c.parseBuildkiteSteps(group)
+			continue
+		}
+		fields := scalarMap(step)
+		job := CIJob{Name: fields["key"], Line: step.Line}
+		if job.Name == "" {
+			job.Name = fields["label"]
+		}
+		for _, key := range []string{"command", "commands"} {
+			command := mappingValue(step, key)
+			if command == nil {
+				continue
+			}
+			switch command.Kind {
+			case yaml.ScalarNode:
+				job.Scripts = append(job.Scripts, scriptOf(command))
+			case yaml.SequenceNode:
+				for _, item := range command.Content {
+					if item.Kind == yaml.ScalarNode {
+						job.Scripts = append(job.Scripts, scriptOf(item))
+					}
+				}
+			default:
+			}
+		}
+		if plugins := mappingValue(step, "plugins"); plugins != nil {
+			c.addBuildkitePlugins(plugins)
+		}
+		if len(job.Scripts) > 0 {
+			c.Jobs = append(c.Jobs, job)
+		}
+	}
+}
+
+// addBuildkitePlugins records the plugins of a step, listed as `name#ref`
+// alone or as the key of their configuration. The images of the docker
+// plugin are recorded too.
+func (c *CIConfig) addBuildkitePlugins(plugins *yaml.Node) {
+	add := func(key, config *yaml.Node) {
+		if key.Kind != yaml.ScalarNode || key.Value == "" {
+			return
+		}
+		c.addReference(key, false, "#")
+		name := c.Uses[len(c.Uses)-1].Name
+		if name == "docker" || strings.HasSuffix(name, "/docker-buildkite-plugin") {
+			c.addReference(mappingValue(config, "image"), true, "")
+		}
+	}
+	switch plugins.Kind {
+	case yaml.SequenceNode:
+		for _, item := range plugins.Content {
+			if item.Kind == yaml.ScalarNode {
+				add(item, nil)
+				continue
+			}
+			mappingPairs(item, add)
+		}
+	case yaml.MappingNode:
+		// The deprecated form of plugins is a mapping.
+		mappingPairs(plugins, add)
+	default:
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// CISystem is a CI system configured by files of the repository.
+type CISystem string
+
+const (
+	// CISystemCircleCI is CircleCI, configured by .circleci/config.yml.
+	CISystemCircleCI CISystem = "CircleCI"
+	// CISystemJenkins is Jenkins, configured by a Jenkinsfile.
+	CISystemJenkins CISystem = "Jenkins"
+	// CISystemBuildkite is Buildkite, configured by .buildkite/pipeline.yml.
+	CISystemBuildkite CISystem = "Buildkite"
+)
+
+// CIConfig is the configuration of a CircleCI, Jenkins or Buildkite
+// pipeline, reduced to the jobs' scripts and the dependencies of the pipeline.
+type CIConfig struct {
+	Path   string
+	System CISystem
+	Jobs   []CIJob
+	// Uses are the CircleCI orbs, Buildkite plugins and Jenkins shared
+	// libraries used by the pipeline.
+	Uses []CIReference
+	// Images are the container images the jobs run in.
+	Images []CIReference
+}
+
+// CIJob is a job, step or stage of a pipeline.
+type CIJob struct {
+	Name    string
+	Scripts []CIScript
+	Line    int
+}
+
+// CIScript is a script run by a job.
+type CIScript struct {
+	Script string
+	// Offset is added to the line numbers within Script to get the line
+	// numbers within the file.
+	Offset int
+	// Windows is true for batch and PowerShell scripts.
+	Windows bool
+}
+
+// CIReference is a reference to an orb, plugin, library or image. Version
+// is the part of Ref after its separator, such as the `@` of an orb.
+type CIReference struct {
+	Ref     string
+	Name    string
+	Version string
+	Line    int
+}
+
+// CISystemOf returns the CI system configured by a file, if any.
+func CISystemOf(pathfn string) (CISystem, bool) {
+	if IsTestdataFile(pathfn) {
+		return "", false
+	}
+	base := path.Base(pathfn)
+	ext := path.Ext(base)
+	isYAML := ext == ".yml" || ext == ".yaml"
+	switch {
+	case pathfn == ".circleci/config.yml" || pathfn == ".circleci/config.yaml":
+		return CISystemCircleCI, true
+	case base == "Jenkinsfile" || strings.HasPrefix(base, "Jenkinsfile.") || strings.HasSuffix(base, ".jenkinsfile"):
+		return CISystemJenkins, true
+	case isYAML && (path.Dir(pathfn) == ".buildkite" || base == "buildkite"+ext || base == ".buildkite"+ext):
+		return CISystemBuildkite, true
+	}
+	return "", false
+}
+
+// ReadCIConfigs parses the CircleCI, Jenkins and Buildkite configuration
+// files of a repository. The files are only parsed once for all the checks of
+// a request with a cache.
+func ReadCIConfigs(c *checker.CheckRequest) ([]*CIConfig, error) {
+	v, err := c.Cache.Get("fileparser.CIConfigs", func() (any, error) {
+		return readCIConfigs(c.RepoClient)
+	})
+	if err != nil {
+		return nil, err
+	}
+	//nolint:forcetypeassert // only CIConfigs are cached under this key.
+	return v.([]*CIConfig), nil
+}
+
+func readCIConfigs(repoClient clients.RepoClient) ([]*CIConfig, error) {
+	files, err := repoClient.ListFiles(func(pathfn string) (bool, error) {
+		_, ok := CISystemOf(pathfn)
+		return ok, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error during ListFiles: %w", err)
+	}
+	var configs []*CIConfig
+	for _, pathfn := range files {
+		system, ok := CISystemOf(pathfn)
+		if !ok {
+			continue
+		}
+		content, err := readAll(repoClient, pathfn)
+		if err != nil {
+			return nil, err
+		}
+		c, err := ParseCIConfig(pathfn, system, content)
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, c)
+	}
+	return configs, nil
+}
+
+// ParseCIConfig parses a configuration file of a CI system.
+func ParseCIConfig(pathfn string, system CISystem, content []byte) (*CIConfig, error) {
+	c := &CIConfig{Path: pathfn, System: system}
+	if system == CISystemJenkins {
+		c.parseJenkinsfile(string(content))
+		return c, nil
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", errInvalidCIConfig, pathfn, err)
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return c, nil
+	}
+	switch system {
+	case CISystemCircleCI:
+		c.parseCircleCI(doc.Content[0])
+	case CISystemBuildkite:
+		c.parseBuildkite(doc.Content[0])
+	default:
+	}
+	return c, nil
+}
+
+func (c *CIConfig) addReference(node *yaml.Node, images bool, sep string) {
+	if node == nil || node.Kind != yaml.ScalarNode || node.Value == "" {
+		return
+	}
+	ref := CIReference{Ref: node.Value, Name: node.Value, Line: node.Line}
+	if i := strings.LastIndex(ref.Name, sep); sep != "" && i >= 0 {
+		ref.Name, ref.Version = ref.Name[:i], ref.Name[i+1:]
+	}
+	if images {
+		c.Images = append(c.Images, ref)
+	} else {
+		c.Uses = append(c.Uses, ref)
+	}
+}
+
+// scriptOf returns a script set by a YAML scalar.
+func scriptOf(node *yaml.Node) CIScript {
+	s := CIScript{Script: node.Value, Offset: node.Line - 1}
+	if node.Style&(yaml.LiteralStyle|yaml.FoldedStyle) != 0 {
+		s.Offset = node.Line
+	}
+	return s
+}
+
+// mappingPairs calls fn on the pairs of a YAML mapping.
+func mappingPairs(node *yaml.Node, fn func(key, value *yaml.Node)) {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		fn(node.Content[i], node.Content[i+1])
+	}
+}
+
+// mappingValue returns the value of a key of a YAML mapping, nil if absent.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	var v *yaml.Node
+	mappingPairs(node, func(k, value *yaml.Node) {
+		if k.Value == key && v == nil {
+			v = value
+		}
+	})
+	return v
+}
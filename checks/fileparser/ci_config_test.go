@@ -0,0 +1,245 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ossf/scorecard/v5/checker"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+)
+
+func TestParseCIConfig(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		want    *CIConfig
+		name    string
+		path    string
+		system  CISystem
+		content string
+	}{
+		{
+			name:   "CircleCI",
+			path:   ".circleci/config.yml",
+			system: CISystemCircleCI,
+			content: `version: 2.1
+orbs:
+  node: circleci/node@5.2.0
+  inline:
+    jobs: {}
+executors:
+  go:
+    docker:
+      - image: cimg/go:1.22
+commands:
+  setup:
+    steps:
+      - run: curl -sSL https://example.com/setup.sh | sh
+jobs:
+  test:
+    docker:
+      - image: cimg/base:stable
+    steps:
+      - checkout
+      - run:
+          name: Test
+          command: |
+            go test ./...
+      - when:
+          condition: << pipeline.parameters.release >>
+          steps:
+            - run: make release
+      - run:
+          shell: powershell.exe
+          command: Write-Host done
+`,
+			want: &CIConfig{
+				Path:   ".circleci/config.yml",
+				System: CISystemCircleCI,
+				Uses:   []CIReference{{Ref: "circleci/node@5.2.0", Name: "circleci/node", Version: "5.2.0", Line: 3}},
+				Images: []CIReference{
+					{Ref: "cimg/go:1.22", Name: "cimg/go:1.22", Line: 9},
+					{Ref: "cimg/base:stable", Name: "cimg/base:stable", Line: 17},
+				},
+				Jobs: []CIJob{
+					{Name: "setup", Line: 11, Scripts: []CIScript{
+						{Script: "curl -sSL https://example.com/setup.sh | sh", Offset: 12},
+					}},
+					{Name: "test", Line: 15, Scripts: []CIScript{
+						{Script: "go test ./...\n", Offset: 22},
+						{Script: "make release", Offset: 26},
+						{Script: "Write-Host done", Offset: 29, Windows: true},
+					}},
+				},
+			},
+		},
+		{
+			name:   "Buildkite",
+			path:   ".buildkite/pipeline.yml",
+			system: CISystemBuildkite,
+			content: `steps:
+  - label: ":go: test"
+    commands:
+      - go vet ./...
+      - go test ./...
+    plugins:
+      - docker#v5.9.0:
+          image: golang:1.22
+      - ssh://git@github.com/org/private-buildkite-plugin.git#0123456789abcdef0123456789abcdef01234567
+  - wait
+  - group: release
+    steps:
+      - key: publish
+        command: ./publish.sh
+`,
+			want: &CIConfig{
+				Path:   ".buildkite/pipeline.yml",
+				System: CISystemBuildkite,
+				Uses: []CIReference{
+					{Ref: "docker#v5.9.0", Name: "docker", Version: "v5.9.0", Line: 7},
+					{
+						Ref:     "ssh://git@github.com/org/private-buildkite-plugin.git#0123456789abcdef0123456789abcdef01234567",
+						Name:    "ssh://git@github.com/org/private-buildkite-plugin.git",
+						Version: "0123456789abcdef0123456789abcdef01234567",
+						Line:    9,
+					},
+				},
+				Images: []CIReference{{Ref: "golang:1.22", Name: "golang:1.22", Line: 8}},
+				Jobs: []CIJob{
+					{Name: ":go: test", Line: 2, Scripts: []CIScript{
+						{Script: "go vet ./...", Offset: 3},
+						{Script: "go test ./...", Offset: 4},
+					}},
+					{Name: "publish", Line: 13, Scripts: []CIScript{{Script: "./publish.sh", Offset: 13}}},
+				},
+			},
+		},
+		{
+			name:   "Jenkinsfile",
+			path:   "Jenkinsfile",
+			system: CISystemJenkins,
+			content: `@Library(['shared@v1', "tools@0123456789abcdef0123456789abcdef01234567"]) _
+pipeline {
+  agent {
+    docker { image 'maven:3.9' }
+  }
+  stages {
+    stage('Build') {
+      steps {
+        sh 'mvn -B package'
+      }
+    }
+    stage("Test") {
+      steps {
+        sh """
+          curl -s https://example.com/it.sh | bash
+          mvn verify
+        """
+        bat(script: 'mvn.cmd verify')
+      }
+    }
+  }
+}
+`,
+			want: &CIConfig{
+				Path:   "Jenkinsfile",
+				System: CISystemJenkins,
+				Uses: []CIReference{
+					{Ref: "shared@v1", Name: "shared", Version: "v1", Line: 1},
+					{
+						Ref:     "tools@0123456789abcdef0123456789abcdef01234567",
+						Name:    "tools",
+						Version: "0123456789abcdef0123456789abcdef01234567",
+						Line:    1,
+					},
+				},
+				Images: []CIReference{{Ref: "maven:3.9", Name: "maven:3.9", Line: 4}},
+				Jobs: []CIJob{
+					{Name: "Build", Line: 7, Scripts: []CIScript{{Script: "mvn -B package", Offset: 8}}},
+					{Name: "Test", Line: 12, Scripts: []CIScript{
+						{Script: "\n          curl -s https://example.com/it.sh | bash\n          mvn verify\n        ", Offset: 13},
+						{Script: "mvn.cmd verify", Offset: 17, Windows: true},
+					}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseCIConfig(tt.path, tt.system, []byte(tt.content))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCISystemOf(t *testing.T) {
+	t.Parallel()
+	tests := map[string]CISystem{
+		".circleci/config.yml":         CISystemCircleCI,
+		"Jenkinsfile":                  CISystemJenkins,
+		"ci/Jenkinsfile.release":       CISystemJenkins,
+		"jobs/nightly.jenkinsfile":     CISystemJenkins,
+		".buildkite/pipeline.yml":      CISystemBuildkite,
+		".buildkite/release.yaml":      CISystemBuildkite,
+		"buildkite.yml":                CISystemBuildkite,
+		".buildkite/hooks/pre-command": "",
+		"testdata/Jenkinsfile":         "",
+		"circleci/config.yml":          "",
+	}
+	for pathfn, want := range tests {
+		got, ok := CISystemOf(pathfn)
+		if got != want || ok != (want != "") {
+			t.Errorf("CISystemOf(%q) = %q, %v, want %q", pathfn, got, ok, want)
+		}
+	}
+}
+
+func TestReadCIConfigsCache(t *testing.T) {
+	t.Parallel()
+	files := map[string]string{
+		".circleci/config.yml": "version: 2.1\n",
+		"Jenkinsfile":          "pipeline {}\n",
+	}
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	// The files are only read once for the request.
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return([]string{".circleci/config.yml", "Jenkinsfile"}, nil).Times(1)
+	mockRepoClient.EXPECT().GetFileReader(gomock.Any()).Times(2).DoAndReturn(
+		func(file string) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(files[file])), nil
+		})
+
+	c := &checker.CheckRequest{RepoClient: mockRepoClient, Cache: &checker.RequestCache{}}
+	for range 2 {
+		configs, err := ReadCIConfigs(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(configs) != 2 {
+			t.Errorf("got %d configurations, want 2", len(configs))
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// parseCircleCI collects the orbs, the images and the `run` steps of the
+// jobs and reusable commands of a CircleCI configuration.
+func (c *CIConfig) parseCircleCI(root *yaml.Node) {
+	mappingPairs(mappingValue(root, "orbs"), func(_, value *yaml.Node) {
+		// Inline orbs are mappings, defined in the configuration itself.
+		c.addReference(value, false, "@")
+	})
+	mappingPairs(mappingValue(root, "executors"), func(_, value *yaml.Node) {
+		c.addCircleCIImages(value)
+	})
+	for _, section := range []string{"commands", "jobs"} {
+		mappingPairs(mappingValue(root, section), func(key, value *yaml.Node) {
+			c.addCircleCIImages(value)
+			job := CIJob{Name: key.Value, Line: key.Line}
+			windows := strings.Contains(strings.ToLower(scalarMap(value)["shell"]), "powershell")
+			job.Scripts = circleCIScripts(mappingValue(value, "steps"), windows)
+			c.Jobs = append(c.Jobs, job)
+		})
+	}
+}
+
+func (c *CIConfig) addCircleCIImages(node *yaml.Node) {
+	docker := mappingValue(node, "docker")
+	if docker == nil || docker.Kind != yaml.SequenceNode {
+		return
+	}
+	for _, item := range docker.Content {
+		c.addReference(mappingValue(item, "image"), true, "")
+	}
+}
+
+// circleCIScripts returns the commands of the `run` steps, including those
+// of the steps run conditionally by `when` and `unless`.
+func circleCIScripts(steps *yaml.Node, windows bool) []CIScript {
+	if steps == nil || steps.Kind != yaml.SequenceNode {
+		return nil
+	}
+	var scripts []CIScript
+	for _, step := range steps.Content {
+		mappingPairs(step, func(key, value *yaml.Node) {
+			switch key.Value {
+			case "run":
+				command := value
+				shell := windows
+				if value.Kind == yaml.MappingNode {
+					command = mappingValue(value, "command")
+					if s, ok := scalarMap(value)["shell"]; ok {
+						shell = strings.Contains(strings.ToLower(s), "powershell") ||
+							strings.Contains(strings.ToLower(s), "cmd")
+					}
+				}
+				if command != nil && command.Kind == yaml.ScalarNode {
+					s := scriptOf(command)
+					s.Windows = shell
+					scripts = append(scripts, s)
+				}
+			case "when", "unless":
+				scripts = append(scripts, circleCIScripts(mappingValue(value, "steps"), windows)...)
+			}
+		})
+	}
+	return scripts
+}
@@ -20,5 +20,7 @@ import (
 
 var (
 	errInvalidGitHubWorkflow = errors.New("invalid GitHub workflow")
+	errInvalidAzurePipeline  = errors.New("invalid Azure Pipelines file")
+	errInvalidCIConfig       = errors.New("invalid CI configuration")
 	errInternalFilenameMatch = errors.New("filename match error")
 )
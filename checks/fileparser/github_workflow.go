@@ -354,20 +354,19 @@ func AnyJobsMatch(workflow *actionlint.Workflow, jobMatchers []JobMatcher, fp st
 	logMsgNoMatch string,
 ) (JobMatchResult, bool) {
 	for _, job := range workflow.Jobs {
-		for _, matcher := range jobMatchers {
-			if !matcher.matches(job) {
-				continue
-			}
-
-			return JobMatchResult{
-				File: checker.File{
-					Path:   fp,
-					Type:   finding.FileTypeSource,
-					Offset: GetLineNumber(job.Pos),
-				},
-				Msg: fmt.Sprintf("%v: %v", matcher.LogText, fp),
-			}, true
+		matcher, ok := MatchJob(job, jobMatchers)
+		if !ok {
+			continue
 		}
+
+		return JobMatchResult{
+			File: checker.File{
+				Path:   fp,
+				Type:   finding.FileTypeSource,
+				Offset: GetLineNumber(job.Pos),
+			},
+			Msg: fmt.Sprintf("%v: %v", matcher.LogText, fp),
+		}, true
 	}
 
 	return JobMatchResult{
@@ -380,6 +379,16 @@ func AnyJobsMatch(workflow *actionlint.Workflow, jobMatchers []JobMatcher, fp st
 	}, false
 }
 
+// MatchJob returns the first of the job matchers matching the job.
+func MatchJob(job *actionlint.Job, jobMatchers []JobMatcher) (*JobMatcher, bool) {
+	for i := range jobMatchers {
+		if jobMatchers[i].matches(job) {
+			return &jobMatchers[i], true
+		}
+	}
+	return nil, false
+}
+
 // matches returns true if the job matches the job matcher.
 func (m *JobMatcher) matches(job *actionlint.Job) bool {
 	for _, stepToMatch := range m.Steps {
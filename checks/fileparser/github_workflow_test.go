@@ -1025,3 +1025,41 @@ func TestIsPackagingWorkflow(t *testing.T) {
 		})
 	}
 }
+
+func TestMatchJob(t *testing.T) {
+	t.Parallel()
+	content := []byte(`on: push
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: step-security/harden-runner@v2
+        with:
+          egress-policy: audit
+      - run: make release
+`)
+	workflow, errs := actionlint.Parse(content)
+	if len(errs) > 0 {
+		t.Fatalf("cannot parse workflow: %v", errs)
+	}
+	job := workflow.Jobs["build"]
+	matchers := []JobMatcher{
+		{
+			LogText: "blocked",
+			Steps: []*JobMatcherStep{
+				{Uses: "step-security/harden-runner", With: map[string]string{"egress-policy": "block"}},
+			},
+		},
+		{
+			LogText: "release",
+			Steps:   []*JobMatcherStep{{Run: "make release"}},
+		},
+	}
+	matcher, ok := MatchJob(job, matchers)
+	if !ok || matcher.LogText != "release" {
+		t.Errorf("MatchJob() = %v, %v, want the release matcher", matcher, ok)
+	}
+	if _, ok := MatchJob(job, matchers[:1]); ok {
+		t.Errorf("MatchJob() matched an audit harden-runner step")
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	reJenkinsStage   = regexp.MustCompile(`\bstage\s*\(\s*(?:name\s*:\s*)?['"]([^'"]+)['"]`)
+	reJenkinsLibrary = regexp.MustCompile(`@Library\s*\(([^)]*)\)|\blibrary\s*\(?\s*(?:identifier\s*:\s*)?(['"][^'"]+['"])`)
+	reJenkinsImage   = regexp.MustCompile(`\bimage\s*\(?\s*['"]([^'"]+)['"]|\bdocker\s+['"]([^'"]+)['"]`)
+	reJenkinsStep    = regexp.MustCompile(`\b(sh|bat|powershell|pwsh)\s*(?:\(\s*)?(?:script\s*:\s*)?('''|"""|'|")`)
+	reQuotedString   = regexp.MustCompile(`'([^']*)'|"([^"]*)"`)
+)
+
+// parseJenkinsfile collects the shared libraries, the agent images and the
+// `sh`, `bat`, `powershell` and `pwsh` steps of a Jenkinsfile. Only string
+// literals are understood, and steps are grouped by the stage preceding them.
+func (c *CIConfig) parseJenkinsfile(content string) {
+	lineAt := func(offset int) int {
+		return strings.Count(content[:offset], "\n") + 1
+	}
+
+	for _, m := range reJenkinsLibrary.FindAllStringSubmatchIndex(content, -1) {
+		args := m[2:4]
+		if args[0] < 0 {
+			args = m[4:6]
+		}
+		for _, q := range reQuotedString.FindAllStringSubmatchIndex(content[args[0]:args[1]], -1) {
+			value := q[2:4]
+			if value[0] < 0 {
+				value = q[4:6]
+			}
+			lib := content[args[0]+value[0] : args[0]+value[1]]
+			ref := CIReference{Ref: lib, Name: lib, Line: lineAt(args[0] + q[0])}
+			ref.Name, ref.Version, _ = strings.Cut(lib, "@")
+			c.Uses = append(c.Uses, ref)
+		}
+	}
+
+	for _, m := range reJenkinsImage.FindAllStringSubmatchIndex(content, -1) {
+		image := m[2:4]
+		if image[0] < 0 {
+			image = m[4:6]
+		}
+		ref := content[image[0]:image[1]]
+		c.Images = append(c.Images, CIReference{Ref: ref, Name: ref, Line: lineAt(image[0])})
+	}
+
+	stages := reJenkinsStage.FindAllStringSubmatchIndex(content, -1)
+	stage := -1
+	for pos := 0; pos < len(content); {
+		m := reJenkinsStep.FindStringSubmatchIndex(content[pos:])
+		if m == nil {
+			break
+		}
+		start := pos + m[1]
+		quote := content[pos+m[4] : pos+m[5]]
+		end := closingQuote(content[start:], quote)
+		if end < 0 {
+			pos = start
+			continue
+		}
+		for stage+1 < len(stages) && stages[stage+1][0] < pos+m[0] {
+			stage++
+			c.Jobs = append(c.Jobs, CIJob{
+				Name: content[stages[stage][2]:stages[stage][3]],
+				Line: lineAt(stages[stage][0]),
+			})
+		}
+		if len(c.Jobs) == 0 {
+			c.Jobs = append(c.Jobs, CIJob{Line: 1})
+		}
+		job := &c.Jobs[len(c.Jobs)-1]
+		step := content[pos+m[2] : pos+m[3]]
+		job.Scripts = append(job.Scripts, CIScript{
+			Script:  content[start : start+end],
+			Offset:  lineAt(start) - 1,
+			Windows: step != "sh",
+		})
+		pos = start + end + len(quote)
+	}
+}
+
+// closingQuote returns the index of the quote closing a Groovy string, or -1
+// if it isn't closed. Single-line strings can't span lines.
+func closingQuote(s, quote string) int {
+	if len(quote) == 3 {
+		return strings.Index(s, quote)
+	}
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '\n':
+			return -1
+		case quote[0]:
+			return i
+		}
+	}
+	return -1
+}
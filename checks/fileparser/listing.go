@@ -51,7 +51,8 @@ func isMatchingPath(fullpath string, matchPathTo PathMatcher) (bool, error) {
 	return match, nil
 }
 
-func isTestdataFile(fullpath string) bool {
+// IsTestdataFile returns true if the file is only used by tests.
+func IsTestdataFile(fullpath string) bool {
 	// testdata/ or /some/dir/testdata/some/other
 	return strings.HasPrefix(fullpath, "testdata/") ||
 		strings.Contains(fullpath, "/testdata/") ||
@@ -100,7 +101,7 @@ func onMatchingFileDo(repoClient clients.RepoClient, matchPathTo PathMatcher,
 ) error {
 	predicate := func(filepath string) (bool, error) {
 		// Filter out test files.
-		if isTestdataFile(filepath) {
+		if IsTestdataFile(filepath) {
 			return false, nil
 		}
 		// Filter out files based on path/names using the pattern.
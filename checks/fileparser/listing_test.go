@@ -329,7 +329,7 @@ func Test_isMatchingPath(t *testing.T) {
 	}
 }
 
-func Test_isTestdataFile(t *testing.T) {
+func Test_IsTestdataFile(t *testing.T) {
 	t.Parallel()
 	type args struct {
 		fullpath string
@@ -385,8 +385,8 @@ func Test_isTestdataFile(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			if got := isTestdataFile(tt.args.fullpath); got != tt.want {
-				t.Errorf("isTestdataFile() = %v, want %v", got, tt.want)
+			if got := IsTestdataFile(tt.args.fullpath); got != tt.want {
+				t.Errorf("IsTestdataFile() = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -17,8 +17,11 @@ package checks
 import (
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/checks/evaluation"
+	"github.com/ossf/scorecard/v5/checks/raw/azuredevops"
 	"github.com/ossf/scorecard/v5/checks/raw/github"
 	"github.com/ossf/scorecard/v5/checks/raw/gitlab"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/azuredevopsrepo"
 	"github.com/ossf/scorecard/v5/clients/githubrepo"
 	"github.com/ossf/scorecard/v5/clients/gitlabrepo"
 	"github.com/ossf/scorecard/v5/clients/localdir"
@@ -43,27 +46,33 @@ func init() {
 
 // Packaging runs Packaging check.
 func Packaging(c *checker.CheckRequest) checker.CheckResult {
-	var rawData, rawDataGithub, rawDataGitlab checker.PackagingData
-	var err, errGithub, errGitlab error
+	var rawData, rawDataGithub, rawDataGitlab, rawDataAzure checker.PackagingData
+	var err, errGithub, errGitlab, errAzure error
 
-	switch v := c.RepoClient.(type) {
+	switch v := clients.Unwrap(c.RepoClient).(type) {
 	case *localdir.Client:
 		// Performing both packaging checks since we dont know when local
 		rawDataGithub, errGithub = github.Packaging(c)
 		rawDataGitlab, errGitlab = gitlab.Packaging(c)
+		rawDataAzure, errAzure = azuredevops.Packaging(c)
 		// Appending results of checks
 		rawData.Packages = append(rawData.Packages, rawDataGithub.Packages...)
 		rawData.Packages = append(rawData.Packages, rawDataGitlab.Packages...)
+		rawData.Packages = append(rawData.Packages, rawDataAzure.Packages...)
 		// checking for errors
 		if errGithub != nil {
 			err = errGithub
 		} else if errGitlab != nil {
 			err = errGitlab
+		} else if errAzure != nil {
+			err = errAzure
 		}
 	case *githubrepo.Client:
 		rawData, err = github.Packaging(c)
 	case *gitlabrepo.Client:
 		rawData, err = gitlab.Packaging(c)
+	case *azuredevopsrepo.Client:
+		rawData, err = azuredevops.Packaging(c)
 	default:
 		_ = v
 	}
@@ -0,0 +1,203 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+var (
+	reAzureTaskVersion = regexp.MustCompile(`^\d+\.\d+\.\d+$`)
+	reCommitSHA        = regexp.MustCompile(`^[a-fA-F\d]{40}$`)
+	// reAzureWrite matches script commands which clearly write with the
+	// job access token: pushes and mutating Azure DevOps CLI or REST calls.
+	reAzureWrite = regexp.MustCompile(`(?i)\bgit\s+push\b|` +
+		`\baz\s+(repos|pipelines|boards|artifacts)\b.*\b(create|update|delete|set|add|remove|publish)\b|` +
+		`(-X|--request|-Method)\s*['"]?(post|put|patch|delete)\b`)
+	// Template expressions, runtime expressions and macros,
+	// replaced to avoid shell parsing failures.
+	reAzureVar = regexp.MustCompile(`\$\{\{[^{}]*\}\}|\$\[[^\[\]]*\]|\$\([\w.]+\)`)
+)
+
+// collectAzurePipelinesPinning checks the pinning of the tasks, repository
+// resources and container images of Azure Pipelines, and the downloads of
+// their scripts.
+func collectAzurePipelinesPinning(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
+	pipelines, err := fileparser.ReadAzurePipelines(c)
+	if err != nil {
+		return fmt.Errorf("reading Azure Pipelines: %w", err)
+	}
+	n := len(r.Dependencies)
+	for _, p := range pipelines {
+		collectAzurePipelineDependencies(p, r)
+	}
+	applyDockerfilePinningRemediations(r.Dependencies[n:])
+	return nil
+}
+
+func collectAzurePipelineDependencies(p *fileparser.AzurePipeline, pdata *checker.PinningDependenciesData) {
+	for _, repo := range p.Repositories {
+		ref := strings.TrimPrefix(repo.Ref, "refs/heads/")
+		dep := checker.Dependency{
+			Location: &checker.File{
+				Path:      p.Path,
+				Type:      finding.FileTypeSource,
+				Offset:    uint(repo.Line),
+				EndOffset: uint(repo.Line),
+				Snippet:   repo.Name,
+			},
+			Name:   asPointer(repo.Name),
+			Pinned: asBoolPointer(reCommitSHA.MatchString(ref)),
+			Type:   checker.DependencyUseTypeAzurePipelinesRepository,
+		}
+		if repo.Ref != "" {
+			dep.PinnedAt = asPointer(repo.Ref)
+			dep.Location.Snippet = repo.Name + "@" + repo.Ref
+		}
+		pdata.Dependencies = append(pdata.Dependencies, dep)
+	}
+
+	for _, container := range p.Containers {
+		addContainerImage(pdata, checker.DependencyUseTypeAzurePipelinesContainerImage, p.Path,
+			container.Line, container.Image, "")
+	}
+
+	for _, job := range p.Jobs {
+		// Containers named by their alias are reported with the container resources.
+		if job.Container != nil && job.Container.Image != "" {
+			addContainerImage(pdata, checker.DependencyUseTypeAzurePipelinesContainerImage, p.Path,
+				job.Container.Line, job.Container.Image, "")
+		}
+
+		taintedFiles := make(map[string]bool)
+		for i := range job.Steps {
+			step := &job.Steps[i]
+			switch {
+			case step.Kind == "task":
+				addAzureTask(p.Path, step, pdata)
+			case step.Kind == "bash", step.Kind == "script" && !p.IsWindows(job):
+				script := reAzureVar.ReplaceAll([]byte(step.Value), []byte("AZURE_REDACTED_VAR"))
+				if err := validateShellFile(p.Path, uint(step.ValueOffset), uint(step.ValueOffset),
+					script, taintedFiles, pdata); err != nil {
+					pdata.Dependencies = append(pdata.Dependencies, checker.Dependency{
+						Msg: asPointer(err.Error()),
+					})
+				}
+			}
+		}
+	}
+}
+
+// addAzureTask records the use of a task. Tasks can't be pinned by hash, so
+// a task is considered pinned to its full version rather than to its major version.
+func addAzureTask(pathfn string, step *fileparser.AzureStep, pdata *checker.PinningDependenciesData) {
+	if strings.Contains(step.Value, "$") {
+		return
+	}
+	name, version := step.Task()
+	dep := checker.Dependency{
+		Location: &checker.File{
+			Path:      pathfn,
+			Type:      finding.FileTypeSource,
+			Offset:    uint(step.Line),
+			EndOffset: uint(step.Line),
+			Snippet:   step.Value,
+		},
+		Name:   asPointer(name),
+		Pinned: asBoolPointer(reAzureTaskVersion.MatchString(version)),
+		Type:   checker.DependencyUseTypeAzurePipelinesTask,
+	}
+	if version != "" {
+		dep.PinnedAt = asPointer(version)
+	}
+	pdata.Dependencies = append(pdata.Dependencies, dep)
+}
+
+// collectAzurePipelinesTokenPermissions reports the jobs of Azure Pipelines
+// exposing the job access token. Its scope is set in the project settings
+// rather than in the pipeline, so jobs persisting it in the git config of
+// the checked out repositories or passing it to steps which clearly write
+// are reported with write permissions. Other steps using the token are
+// reported with unknown permissions.
+func collectAzurePipelinesTokenPermissions(c *checker.CheckRequest, data *checker.TokenPermissionsData) error {
+	pipelines, err := fileparser.ReadAzurePipelines(c)
+	if err != nil {
+		return fmt.Errorf("reading Azure Pipelines: %w", err)
+	}
+	permLoc := checker.PermissionLocationJob
+	for _, p := range pipelines {
+		if len(p.Jobs) == 0 {
+			continue
+		}
+		data.NumTokens++
+		for _, job := range p.Jobs {
+			for i := range job.Steps {
+				step := &job.Steps[i]
+				var name, msg string
+				level := checker.PermissionLevelWrite
+				switch {
+				case step.Kind == "checkout" && step.PersistCredentials:
+					name = "persistCredentials"
+					msg = "checkout persists the job access token in the git config"
+				case usesAzureAccessToken(step):
+					name = "System.AccessToken"
+					msg = "job access token passed to a step which writes with it"
+					if !step.IsScript() || !reAzureWrite.MatchString(step.Value) {
+						level = checker.PermissionLevelUnknown
+						msg = "job access token passed to a step"
+					}
+				default:
+					continue
+				}
+				data.TokenPermissions = append(data.TokenPermissions, checker.TokenPermission{
+					Job:          &checker.WorkflowJob{Name: asPointer(job.Name)},
+					LocationType: &permLoc,
+					Name:         asPointer(name),
+					Value:        asPointer(string(level)),
+					Msg:          asPointer(msg),
+					File: &checker.File{
+						Path:    p.Path,
+						Type:    finding.FileTypeSource,
+						Offset:  uint(step.Line),
+						Snippet: step.Kind + ": " + step.Value,
+					},
+					Type: level,
+				})
+			}
+		}
+	}
+	return nil
+}
+
+func usesAzureAccessToken(step *fileparser.AzureStep) bool {
+	const token = "System.AccessToken"
+	if step.IsScript() && strings.Contains(step.Value, "$("+token+")") {
+		return true
+	}
+	for _, values := range []map[string]string{step.Env, step.Inputs} {
+		for _, v := range values {
+			if strings.Contains(v, token) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,148 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ossf/scorecard/v5/checker"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+)
+
+const azurePipeline = `resources:
+  repositories:
+    - repository: templates
+      type: github
+      name: contoso/templates
+      ref: refs/tags/v1
+    - repository: tools
+      type: git
+      name: project/tools
+      ref: 0123456789abcdef0123456789abcdef01234567
+  containers:
+    - container: py
+      image: python:3.7
+jobs:
+  - job: Build
+    container: py
+    steps:
+      - checkout: self
+        persistCredentials: true
+      - task: UseDotNet@2
+      - task: NuGetToolInstaller@1.2.3
+      - script: |
+          curl https://example.com/install.sh | bash
+          echo $(Build.SourcesDirectory)
+      - bash: git push https://$(System.AccessToken)@dev.azure.com/contoso/repo
+  - job: Windows
+    pool:
+      vmImage: windows-2022
+    container: nginx:1.25
+    steps:
+      - script: curl https://example.com/install.cmd | cmd
+      - script: 'curl -H "Authorization: Bearer $(System.AccessToken)" https://dev.azure.com/contoso/_apis/build/builds'
+`
+
+func newAzurePipelinesRequest(t *testing.T, files map[string]string) *checker.CheckRequest {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	var names []string
+	for f := range files {
+		names = append(names, f)
+	}
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return(names, nil).AnyTimes()
+	mockRepoClient.EXPECT().GetFileReader(gomock.Any()).AnyTimes().DoAndReturn(
+		func(file string) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(files[file])), nil
+		})
+	return &checker.CheckRequest{RepoClient: mockRepoClient}
+}
+
+func TestCollectAzurePipelinesPinning(t *testing.T) {
+	t.Parallel()
+	type dependency struct {
+		typ    checker.DependencyUseType
+		name   string
+		line   uint
+		pinned bool
+	}
+	want := []dependency{
+		{checker.DependencyUseTypeAzurePipelinesRepository, "contoso/templates", 3, false},
+		{checker.DependencyUseTypeAzurePipelinesRepository, "project/tools", 7, true},
+		{checker.DependencyUseTypeAzurePipelinesContainerImage, "python", 12, false},
+		{checker.DependencyUseTypeAzurePipelinesTask, "UseDotNet", 20, false},
+		{checker.DependencyUseTypeAzurePipelinesTask, "NuGetToolInstaller", 21, true},
+		{checker.DependencyUseTypeDownloadThenRun, "", 23, false},
+		{checker.DependencyUseTypeAzurePipelinesContainerImage, "nginx", 29, false},
+	}
+
+	req := newAzurePipelinesRequest(t, map[string]string{"azure-pipelines.yml": azurePipeline})
+	var r checker.PinningDependenciesData
+	if err := collectAzurePipelinesPinning(req, &r); err != nil {
+		t.Fatal(err)
+	}
+	var got []dependency
+	for _, d := range r.Dependencies {
+		dep := dependency{typ: d.Type, line: d.Location.Offset, pinned: *d.Pinned}
+		if d.Name != nil {
+			dep.name = *d.Name
+		}
+		got = append(got, dep)
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(dependency{})); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+	// Unpinned container images get a remediation pinning them by digest.
+	if r.Dependencies[2].Remediation == nil {
+		t.Errorf("missing remediation for %s", *r.Dependencies[2].Name)
+	}
+}
+
+func TestCollectAzurePipelinesTokenPermissions(t *testing.T) {
+	t.Parallel()
+	req := newAzurePipelinesRequest(t, map[string]string{
+		"azure-pipelines.yml": azurePipeline,
+		"README.md":           "# readme\n",
+	})
+	var data checker.TokenPermissionsData
+	if err := collectAzurePipelinesTokenPermissions(req, &data); err != nil {
+		t.Fatal(err)
+	}
+	if data.NumTokens != 1 {
+		t.Errorf("NumTokens = %d, want 1", data.NumTokens)
+	}
+	var got []string
+	for _, p := range data.TokenPermissions {
+		if *p.LocationType != checker.PermissionLocationJob {
+			t.Errorf("unexpected location: %v", *p.LocationType)
+		}
+		got = append(got, fmt.Sprintf("%s %s %s", *p.Job.Name, *p.Name, p.Type))
+	}
+	want := []string{
+		"Build persistCredentials write",
+		"Build System.AccessToken write",
+		"Windows System.AccessToken unknown",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
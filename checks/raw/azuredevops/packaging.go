@@ -0,0 +1,97 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+// packagingTasks maps the tasks publishing packages to the values of their
+// `command` input doing so. buildAndPush is the default command of the
+// Docker task.
+var packagingTasks = map[string][]string{
+	"Docker":            {"push", "buildAndPush", ""},
+	"DotNetCoreCLI":     {"push"},
+	"Npm":               {"publish"},
+	"NuGetCommand":      {"push"},
+	"UniversalPackages": {"publish"},
+}
+
+var packagingCommands = []string{
+	"cargo publish",
+	"docker push",
+	"gem push",
+	"npm publish",
+	"nuget push",
+	"poetry publish",
+	"twine upload",
+}
+
+// Packaging checks for packages published by Azure Pipelines.
+func Packaging(c *checker.CheckRequest) (checker.PackagingData, error) {
+	var data checker.PackagingData
+	pipelines, err := fileparser.ReadAzurePipelines(c)
+	if err != nil {
+		return data, fmt.Errorf("reading Azure Pipelines: %w", err)
+	}
+
+	for _, p := range pipelines {
+		for _, job := range p.Jobs {
+			for i := range job.Steps {
+				if !isPackagingStep(&job.Steps[i]) {
+					continue
+				}
+				data.Packages = append(data.Packages, checker.Package{
+					Name: new(string),
+					Job:  &checker.WorkflowJob{Name: &job.Name},
+					File: &checker.File{
+						Path:   p.Path,
+						Offset: uint(job.Steps[i].Line),
+						Type:   finding.FileTypeSource,
+					},
+					Runs: []checker.Run{{URL: c.Repo.URI()}},
+				})
+				return data, nil
+			}
+		}
+	}
+	return data, nil
+}
+
+func isPackagingStep(step *fileparser.AzureStep) bool {
+	if step.IsScript() {
+		for _, cmd := range packagingCommands {
+			if strings.Contains(step.Value, cmd) {
+				return true
+			}
+		}
+		return false
+	}
+	if step.Kind != "task" {
+		return false
+	}
+	name, _ := step.Task()
+	for _, cmd := range packagingTasks[name] {
+		if strings.EqualFold(step.Inputs["command"], cmd) {
+			return true
+		}
+	}
+	return false
+}
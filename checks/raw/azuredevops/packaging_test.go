@@ -0,0 +1,108 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azuredevops
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ossf/scorecard/v5/checker"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+)
+
+func TestPackaging(t *testing.T) {
+	t.Parallel()
+
+	//nolint:govet
+	tests := []struct {
+		name       string
+		content    string
+		lineNumber uint
+		exists     bool
+	}{
+		{
+			name:    "No Publishing Detected",
+			content: "steps:\n  - script: make\n  - task: NuGetCommand@2\n    inputs:\n      command: restore\n",
+			exists:  false,
+		},
+		{
+			name:       "Docker task default command",
+			content:    "steps:\n  - script: make\n  - task: Docker@2\n    inputs:\n      repository: app\n",
+			lineNumber: 3,
+			exists:     true,
+		},
+		{
+			name:       "Npm publish",
+			content:    "jobs:\n  - job: release\n    steps:\n      - task: Npm@1\n        inputs:\n          command: publish\n",
+			lineNumber: 4,
+			exists:     true,
+		},
+		{
+			name:       "Twine script",
+			content:    "steps:\n  - bash: |\n      python -m build\n      twine upload dist/*\n",
+			lineNumber: 2,
+			exists:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			moqRepoClient := mockrepo.NewMockRepoClient(ctrl)
+			moqRepo := mockrepo.NewMockRepo(ctrl)
+
+			moqRepoClient.EXPECT().ListFiles(gomock.Any()).
+				Return([]string{"azure-pipelines.yml"}, nil).AnyTimes()
+			moqRepoClient.EXPECT().GetFileReader("azure-pipelines.yml").
+				DoAndReturn(func(string) (io.ReadCloser, error) {
+					return io.NopCloser(strings.NewReader(tt.content)), nil
+				}).AnyTimes()
+			if tt.exists {
+				moqRepo.EXPECT().URI().Return("dev.azure.com/org/project/_git/repo")
+			}
+
+			req := checker.CheckRequest{
+				RepoClient: moqRepoClient,
+				Repo:       moqRepo,
+			}
+			packagingData, err := Packaging(&req)
+			if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+
+			if !tt.exists {
+				if len(packagingData.Packages) != 0 {
+					t.Errorf("Repo should not contain any packages")
+				}
+				return
+			}
+			if len(packagingData.Packages) != 1 {
+				t.Fatalf("Repo should contain a single package, got %d", len(packagingData.Packages))
+			}
+			pkg := packagingData.Packages[0]
+			if pkg.File.Offset != tt.lineNumber {
+				t.Errorf("Expected line number: %d != %d", tt.lineNumber, pkg.File.Offset)
+			}
+			if pkg.File.Path != "azure-pipelines.yml" {
+				t.Errorf("Expected filename: azure-pipelines.yml != %v", pkg.File.Path)
+			}
+		})
+	}
+}
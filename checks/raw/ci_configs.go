@@ -0,0 +1,195 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/fuzzers"
+)
+
+var (
+	// CircleCI pipeline parameters, replaced to avoid shell parsing failures.
+	reCircleCIParameter = regexp.MustCompile(`<<[^<>]*>>`)
+	reTestCommand       = regexp.MustCompile(`\b(go test|cargo (\+\S+ )?(test|nextest)|(npm|yarn|pnpm) (run )?test|` +
+		`pytest|tox|nox|make( \S+)* (test|check)|ctest|bazel(isk)? test|mvnw? .*\b(test|verify|install)|` +
+		`gradlew? .*\b(test|check|build)|rspec|rake (test|spec)|phpunit|dotnet test|mix test|swift test)\b`)
+)
+
+// ciConfigFuzzers match fuzzers run by the scripts or images of CI configurations.
+var ciConfigFuzzers = []struct {
+	re   *regexp.Regexp
+	name string
+}{
+	{re: regexp.MustCompile(`\bgo test\b.*\s-fuzz[= ]`), name: fuzzers.BuiltInGo},
+	{re: regexp.MustCompile(`\bcargo (\+\S+ )?fuzz run\b`), name: fuzzers.RustCargoFuzz},
+	{re: regexp.MustCompile(`\boss-fuzz-base/(clusterfuzzlite|cifuzz)`), name: fuzzers.ClusterFuzzLite},
+}
+
+// collectCIConfigPinning checks the pinning of the orbs, plugins, shared
+// libraries and images of CircleCI, Jenkins and Buildkite pipelines, and the
+// downloads of their scripts.
+func collectCIConfigPinning(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
+	configs, err := fileparser.ReadCIConfigs(c)
+	if err != nil {
+		return fmt.Errorf("reading CI configurations: %w", err)
+	}
+	n := len(r.Dependencies)
+	for _, config := range configs {
+		collectCIConfigDependencies(config, r)
+	}
+	applyDockerfilePinningRemediations(r.Dependencies[n:])
+	return nil
+}
+
+func collectCIConfigDependencies(config *fileparser.CIConfig, pdata *checker.PinningDependenciesData) {
+	for _, use := range config.Uses {
+		typ, pinned := checker.DependencyUseTypeJenkinsLibrary, reCommitSHA.MatchString(use.Version)
+		switch config.System {
+		case fileparser.CISystemCircleCI:
+			// Published orb versions are immutable.
+			typ, pinned = checker.DependencyUseTypeCircleCIOrb, reAzureTaskVersion.MatchString(use.Version)
+		case fileparser.CISystemBuildkite:
+			typ = checker.DependencyUseTypeBuildkitePlugin
+		case fileparser.CISystemJenkins:
+		}
+		dep := checker.Dependency{
+			Location: &checker.File{
+				Path:      config.Path,
+				Type:      finding.FileTypeSource,
+				Offset:    uint(use.Line),
+				EndOffset: uint(use.Line),
+				Snippet:   use.Ref,
+			},
+			Name:   asPointer(use.Name),
+			Pinned: asBoolPointer(pinned),
+			Type:   typ,
+		}
+		if use.Version != "" {
+			dep.PinnedAt = asPointer(use.Version)
+		}
+		pdata.Dependencies = append(pdata.Dependencies, dep)
+	}
+
+	for _, image := range config.Images {
+		addContainerImage(pdata, checker.DependencyUseTypeCIContainerImage, config.Path, image.Line, image.Ref, "")
+	}
+
+	for _, job := range config.Jobs {
+		taintedFiles := make(map[string]bool)
+		for _, s := range job.Scripts {
+			if s.Windows {
+				continue
+			}
+			script := reCircleCIParameter.ReplaceAll([]byte(s.Script), []byte("CI_REDACTED_VAR"))
+			if err := validateShellFile(config.Path, uint(s.Offset), uint(s.Offset),
+				script, taintedFiles, pdata); err != nil {
+				pdata.Dependencies = append(pdata.Dependencies, checker.Dependency{
+					Msg: asPointer(err.Error()),
+				})
+			}
+		}
+	}
+}
+
+// collectCIConfigTestJobs returns the jobs of CircleCI, Jenkins and Buildkite
+// pipelines running tests, either by their name or by the commands of their scripts.
+func collectCIConfigTestJobs(c *checker.CheckRequest) ([]checker.CIConfigTestJob, error) {
+	configs, err := fileparser.ReadCIConfigs(c)
+	if err != nil {
+		return nil, fmt.Errorf("reading CI configurations: %w", err)
+	}
+	var jobs []checker.CIConfigTestJob
+	for _, config := range configs {
+		for _, job := range config.Jobs {
+			line, ok := ciJobRunsTests(&job)
+			if !ok {
+				continue
+			}
+			jobs = append(jobs, checker.CIConfigTestJob{
+				File: checker.File{
+					Path:   config.Path,
+					Type:   finding.FileTypeSource,
+					Offset: uint(line),
+				},
+				System: string(config.System),
+				Job:    job.Name,
+			})
+		}
+	}
+	return jobs, nil
+}
+
+// ciJobRunsTests returns the line of the test command of a job, or the line
+// of the job if its name is about tests.
+func ciJobRunsTests(job *fileparser.CIJob) (int, bool) {
+	for _, s := range job.Scripts {
+		loc := reTestCommand.FindStringIndex(s.Script)
+		if loc != nil {
+			return s.Offset + strings.Count(s.Script[:loc[0]], "\n") + 1, true
+		}
+	}
+	return job.Line, strings.Contains(strings.ToLower(job.Name), "test")
+}
+
+// collectCIConfigFuzzers returns the fuzzers run by CircleCI, Jenkins and
+// Buildkite pipelines which aren't in detected already.
+func collectCIConfigFuzzers(c *checker.CheckRequest, detected []checker.Tool) ([]checker.Tool, error) {
+	configs, err := fileparser.ReadCIConfigs(c)
+	if err != nil {
+		return nil, fmt.Errorf("reading CI configurations: %w", err)
+	}
+	seen := make(map[string]bool)
+	for i := range detected {
+		seen[detected[i].Name] = true
+	}
+	var tools []checker.Tool
+	add := func(name, pathfn string, line int) {
+		if seen[name] {
+			return
+		}
+		seen[name] = true
+		tools = append(tools, checker.Tool{
+			Name: name,
+			Files: []checker.File{{
+				Path:   pathfn,
+				Type:   finding.FileTypeSource,
+				Offset: uint(line),
+			}},
+		})
+	}
+	for _, config := range configs {
+		for _, f := range ciConfigFuzzers {
+			for _, image := range config.Images {
+				if f.re.MatchString(image.Ref) {
+					add(f.name, config.Path, image.Line)
+				}
+			}
+			for _, job := range config.Jobs {
+				for _, s := range job.Scripts {
+					if loc := f.re.FindStringIndex(s.Script); loc != nil {
+						add(f.name, config.Path, s.Offset+strings.Count(s.Script[:loc[0]], "\n")+1)
+					}
+				}
+			}
+		}
+	}
+	return tools, nil
+}
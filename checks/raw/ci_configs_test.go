@@ -0,0 +1,148 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+)
+
+var ciConfigFiles = map[string]string{
+	".circleci/config.yml": `version: 2.1
+orbs:
+  node: circleci/node@5.2.0
+  go: circleci/go@volatile
+jobs:
+  build:
+    docker:
+      - image: cimg/go:1.22
+    steps:
+      - checkout
+      - run: curl -sSL https://example.com/install.sh | bash
+      - run: go test -run=^$ -fuzz=FuzzParse -fuzztime=30s ./parser
+`,
+	".buildkite/pipeline.yml": `steps:
+  - label: lint
+    command: golangci-lint run
+    plugins:
+      - docker#v5.9.0:
+          image: golang@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef
+      - org/internal#0123456789abcdef0123456789abcdef01234567
+`,
+	"Jenkinsfile": `@Library('shared@main') _
+pipeline {
+  stages {
+    stage('Integration tests') {
+      steps {
+        sh 'make it'
+      }
+    }
+  }
+}
+`,
+	"README.md": "# readme\n",
+}
+
+func TestCollectCIConfigPinning(t *testing.T) {
+	t.Parallel()
+	type dependency struct {
+		typ    checker.DependencyUseType
+		name   string
+		path   string
+		line   uint
+		pinned bool
+	}
+	want := []dependency{
+		{checker.DependencyUseTypeBuildkitePlugin, "docker", ".buildkite/pipeline.yml", 5, false},
+		{checker.DependencyUseTypeCIContainerImage, "golang", ".buildkite/pipeline.yml", 6, true},
+		{checker.DependencyUseTypeBuildkitePlugin, "org/internal", ".buildkite/pipeline.yml", 7, true},
+		{checker.DependencyUseTypeCircleCIOrb, "circleci/node", ".circleci/config.yml", 3, true},
+		{checker.DependencyUseTypeCircleCIOrb, "circleci/go", ".circleci/config.yml", 4, false},
+		{checker.DependencyUseTypeCIContainerImage, "cimg/go", ".circleci/config.yml", 8, false},
+		{checker.DependencyUseTypeDownloadThenRun, "", ".circleci/config.yml", 11, false},
+		{checker.DependencyUseTypeJenkinsLibrary, "shared", "Jenkinsfile", 1, false},
+	}
+
+	req := newAzurePipelinesRequest(t, ciConfigFiles)
+	var r checker.PinningDependenciesData
+	if err := collectCIConfigPinning(req, &r); err != nil {
+		t.Fatal(err)
+	}
+	var got []dependency
+	for _, d := range r.Dependencies {
+		dep := dependency{typ: d.Type, path: d.Location.Path, line: d.Location.Offset, pinned: *d.Pinned}
+		if d.Name != nil {
+			dep.name = *d.Name
+		}
+		got = append(got, dep)
+	}
+	// The files are listed in any order.
+	sort.Slice(got, func(i, j int) bool {
+		if got[i].path != got[j].path {
+			return got[i].path < got[j].path
+		}
+		return got[i].line < got[j].line
+	})
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(dependency{})); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestCollectCIConfigTestJobs(t *testing.T) {
+	t.Parallel()
+	req := newAzurePipelinesRequest(t, ciConfigFiles)
+	jobs, err := collectCIConfigTestJobs(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, j := range jobs {
+		got = append(got, j.System+" "+j.Job+" "+j.File.Path)
+		if j.System == "CircleCI" && j.File.Offset != 12 {
+			t.Errorf("test command line = %d, want 12", j.File.Offset)
+		}
+	}
+	sort.Strings(got)
+	want := []string{
+		"CircleCI build .circleci/config.yml",
+		"Jenkins Integration tests Jenkinsfile",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestCollectCIConfigFuzzers(t *testing.T) {
+	t.Parallel()
+	req := newAzurePipelinesRequest(t, ciConfigFiles)
+	tools, err := collectCIConfigFuzzers(req, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tools) != 1 || tools[0].Name != "GoBuiltInFuzzer" || tools[0].Files[0].Offset != 12 {
+		t.Errorf("unexpected fuzzers: %+v", tools)
+	}
+	tools, err = collectCIConfigFuzzers(req, []checker.Tool{{Name: "GoBuiltInFuzzer"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tools) != 0 {
+		t.Errorf("unexpected fuzzers: %+v", tools)
+	}
+}
@@ -14,6 +14,7 @@
 package raw
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/ossf/scorecard/v5/checker"
@@ -21,8 +22,18 @@ import (
 	sce "github.com/ossf/scorecard/v5/errors"
 )
 
-func CITests(c clients.RepoClient) (checker.CITestData, error) {
+func CITests(req *checker.CheckRequest) (checker.CITestData, error) {
+	configTestJobs, err := collectCIConfigTestJobs(req)
+	if err != nil {
+		return checker.CITestData{}, err
+	}
+	c := req.RepoClient
+
 	commits, err := c.ListCommits()
+	// Commits aren't available for local directories: only the CI configuration is checked.
+	if errors.Is(err, clients.ErrUnsupportedFeature) {
+		return checker.CITestData{ConfigTestJobs: configTestJobs}, nil
+	}
 	if err != nil {
 		e := sce.WithMessage(
 			sce.ErrScorecardInternal,
@@ -82,5 +93,5 @@ func CITests(c clients.RepoClient) (checker.CITestData, error) {
 		})
 	}
 
-	return checker.CITestData{CIInfo: infos}, nil
+	return checker.CITestData{CIInfo: infos, ConfigTestJobs: configTestJobs}, nil
 }
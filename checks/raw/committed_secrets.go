@@ -0,0 +1,301 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"bytes"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+const (
+	// Larger files are almost always generated or vendored data.
+	maxSecretScanFileSize = 1 << 20
+	// Only this much of a file is inspected when deciding whether it is binary.
+	binarySniffLen = 8000
+	// Characters of context kept on each side of a redacted secret.
+	secretSnippetContext = 40
+)
+
+// secretRule is a high-confidence detector for one kind of credential.
+type secretRule struct {
+	// re must have exactly one capturing group, matching the secret itself.
+	re *regexp.Regexp
+	// valid, if set, verifies the structure of a match, e.g. a checksum.
+	valid func(secret string) bool
+	name  string
+	// minEntropy is the minimum Shannon entropy, in bits per character,
+	// of a match. It filters out placeholders like "ghp_xxxxxxxx...".
+	minEntropy float64
+}
+
+var secretRules = []secretRule{
+	{
+		name:       "AWS access key ID",
+		re:         regexp.MustCompile(`\b((?:AKIA|ASIA|ABIA|ACCA)[A-Z2-7]{16})\b`),
+		minEntropy: 3,
+	},
+	{
+		name: "AWS secret access key",
+		re: regexp.MustCompile(
+			`(?i)aws.{0,20}?(?:secret|private).{0,20}?['"=:\s]+([A-Za-z0-9/+]{40})(?:[^A-Za-z0-9/+=]|$)`),
+		minEntropy: 4.3,
+	},
+	{
+		name:  "GitHub token",
+		re:    regexp.MustCompile(`\b(gh[pousr]_[A-Za-z0-9]{36})\b`),
+		valid: hasValidTokenChecksum,
+	},
+	{
+		name:       "GitHub fine-grained token",
+		re:         regexp.MustCompile(`\b(github_pat_[A-Za-z0-9]{22}_[A-Za-z0-9]{59})\b`),
+		minEntropy: 4,
+	},
+	{
+		name:       "GitLab token",
+		re:         regexp.MustCompile(`\b(glpat-[A-Za-z0-9_-]{20,})\b`),
+		minEntropy: 3.5,
+	},
+	{
+		name:  "npm token",
+		re:    regexp.MustCompile(`\b(npm_[A-Za-z0-9]{36})\b`),
+		valid: hasValidTokenChecksum,
+	},
+	{
+		name:       "PyPI token",
+		re:         regexp.MustCompile(`\b(pypi-AgEIcHlwaS5vcmc[A-Za-z0-9_-]{50,})`),
+		minEntropy: 4,
+	},
+	{
+		name:       "Slack token",
+		re:         regexp.MustCompile(`\b(xox[abprs]-[0-9]{10,13}-[0-9]{10,13}(?:-[0-9]{10,13})?-[A-Za-z0-9]{24,34})\b`),
+		minEntropy: 3.5,
+	},
+	{
+		name:       "Google API key",
+		re:         regexp.MustCompile(`\b(AIza[0-9A-Za-z_-]{35})(?:[^0-9A-Za-z_-]|$)`),
+		minEntropy: 3.5,
+	},
+	{
+		name:       "Stripe secret key",
+		re:         regexp.MustCompile(`\b((?:sk|rk)_live_[0-9A-Za-z]{24,99})\b`),
+		minEntropy: 3.5,
+	},
+}
+
+var (
+	rePrivateKeyHeader = regexp.MustCompile(`-----BEGIN ((?:RSA|EC|DSA|OPENSSH|ENCRYPTED|PGP) )?PRIVATE KEY( BLOCK)?-----`)
+	rePrivateKeyFooter = regexp.MustCompile(`-----END .*PRIVATE KEY`)
+	reBase64Line       = regexp.MustCompile(`^[A-Za-z0-9+/=]+$`)
+)
+
+// Inline markers used by other secret scanners to allowlist a line.
+// Honoring them lets maintainers suppress a known false positive once.
+var secretAllowMarkers = []string{
+	"gitleaks:allow",
+	"pragma: allowlist secret",
+}
+
+// committedSecrets scans every file in the repository for credentials.
+func committedSecrets(c clients.RepoClient) ([]checker.CommittedSecret, error) {
+	var secrets []checker.CommittedSecret
+	err := fileparser.OnAllFilesDo(c, checkFileForSecrets, c, &secrets)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return secrets, nil
+}
+
+var checkFileForSecrets fileparser.DoWhileTrueOnFilename = func(name string, args ...interface{}) (bool, error) {
+	if len(args) != 2 {
+		return false, fmt.Errorf("checkFileForSecrets requires exactly 2 arguments: %w", errInvalid)
+	}
+	c, ok := args[0].(clients.RepoClient)
+	if !ok {
+		return false, fmt.Errorf("checkFileForSecrets expects arg[0] of type clients.RepoClient: %w", errInvalid)
+	}
+	secrets, ok := args[1].(*[]checker.CommittedSecret)
+	if !ok {
+		return false, fmt.Errorf(
+			"checkFileForSecrets expects arg[1] of type *[]checker.CommittedSecret: %w", errInvalid)
+	}
+
+	if fileparser.IsTestdataFile(name) {
+		return true, nil
+	}
+
+	reader, err := c.GetFileReader(name)
+	if err != nil {
+		return false, fmt.Errorf("error during GetFileReader: %w", err)
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(io.LimitReader(reader, maxSecretScanFileSize+1))
+	if err != nil {
+		return false, fmt.Errorf("reading from file: %w", err)
+	}
+	if len(content) > maxSecretScanFileSize || isBinaryContent(content) {
+		return true, nil
+	}
+
+	*secrets = append(*secrets, findSecrets(name, content)...)
+	return true, nil
+}
+
+func isBinaryContent(content []byte) bool {
+	return bytes.IndexByte(content[:min(len(content), binarySniffLen)], 0) >= 0
+}
+
+func findSecrets(path string, content []byte) []checker.CommittedSecret {
+	var secrets []checker.CommittedSecret
+	lines := strings.Split(string(content), "\n")
+	for i, line := range lines {
+		if isAllowlistedLine(line) {
+			continue
+		}
+		for j := range secretRules {
+			rule := &secretRules[j]
+			for _, m := range rule.re.FindAllStringSubmatchIndex(line, -1) {
+				secret := line[m[2]:m[3]]
+				if !rule.matches(secret) {
+					continue
+				}
+				snippet := redactedSnippet(line, m[2], m[3])
+				secrets = append(secrets, committedSecret(rule.name, path, i+1, snippet))
+			}
+		}
+		// The header itself isn't sensitive, so it's reported as-is.
+		if m := rePrivateKeyHeader.FindString(line); m != "" && isPrivateKeyBody(lines[i+1:]) {
+			secrets = append(secrets, committedSecret("Private key", path, i+1, m))
+		}
+	}
+	return secrets
+}
+
+func (r *secretRule) matches(secret string) bool {
+	if strings.Contains(strings.ToLower(secret), "example") {
+		return false
+	}
+	if r.valid != nil && !r.valid(secret) {
+		return false
+	}
+	return shannonEntropy(secret) >= r.minEntropy
+}
+
+func isAllowlistedLine(line string) bool {
+	for _, marker := range secretAllowMarkers {
+		if strings.Contains(line, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// isPrivateKeyBody reports whether the lines following a private key header
+// hold key material, rather than being a truncated example in documentation.
+func isPrivateKeyBody(lines []string) bool {
+	var body strings.Builder
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if rePrivateKeyFooter.MatchString(line) {
+			break
+		}
+		// Skip PEM headers such as "Proc-Type: 4,ENCRYPTED".
+		if !reBase64Line.MatchString(line) {
+			continue
+		}
+		body.WriteString(line)
+	}
+	const minKeyBodyLen = 64
+	return body.Len() >= minKeyBodyLen && shannonEntropy(body.String()) >= 4.5
+}
+
+func committedSecret(rule, path string, lineNum int, snippet string) checker.CommittedSecret {
+	return checker.CommittedSecret{
+		Rule: rule,
+		File: checker.File{
+			Path:    path,
+			Type:    finding.FileTypeSource,
+			Offset:  uint(lineNum),
+			Snippet: snippet,
+		},
+	}
+}
+
+// redactedSnippet returns the line surrounding line[start:end] with everything
+// but the first few characters of the secret masked, so results can be shared
+// without leaking the credential a second time.
+func redactedSnippet(line string, start, end int) string {
+	secret := line[start:end]
+	redacted := secret[:min(4, len(secret)/4)] + "*****"
+	from := max(0, start-secretSnippetContext)
+	to := min(len(line), end+secretSnippetContext)
+	return strings.TrimSpace(line[from:start] + redacted + line[end:to])
+}
+
+func shannonEntropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+	counts := make(map[rune]int)
+	for _, r := range s {
+		counts[r]++
+	}
+	var entropy float64
+	n := float64(len(s))
+	for _, c := range counts {
+		p := float64(c) / n
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// GitHub and npm tokens end with a base62-encoded CRC32 checksum of the
+// random part of the token, which rules out made-up tokens with near certainty.
+// The base62 alphabet isn't documented, so both common orderings are accepted.
+func hasValidTokenChecksum(token string) bool {
+	_, body, ok := strings.Cut(token, "_")
+	const checksumLen = 6
+	if !ok || len(body) <= checksumLen {
+		return false
+	}
+	random, checksum := body[:len(body)-checksumLen], body[len(body)-checksumLen:]
+	sum := crc32.ChecksumIEEE([]byte(random))
+	return checksum == base62(sum, checksumLen, base62Upper) ||
+		checksum == base62(sum, checksumLen, base62Lower)
+}
+
+const (
+	base62Upper = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	base62Lower = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+)
+
+// base62 encodes n, left-padded with zeros to width.
+func base62(n uint32, width int, alphabet string) string {
+	buf := make([]byte, width)
+	for i := width - 1; i >= 0; i-- {
+		buf[i] = alphabet[n%62]
+		n /= 62
+	}
+	return string(buf)
+}
@@ -0,0 +1,336 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/rhysd/actionlint"
+	"go.yaml.in/yaml/v3"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/remediation"
+)
+
+// containerImageDigester resolves the digests suggested by the remediations
+// of unpinned container images.
+var containerImageDigester remediation.Digester = remediation.CraneDigester{}
+
+var (
+	reImageDigest       = regexp.MustCompile(`@sha256:[a-f\d]{64}$`)
+	reDevcontainerImage = regexp.MustCompile(`^\s*"image"\s*:\s*"([^"]+)"`)
+)
+
+// collectContainerImagePinning collects the container images used by
+// docker-compose files, Kubernetes manifests, Helm chart values, Kustomize
+// files and devcontainer.json files.
+func collectContainerImagePinning(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
+	isCandidate := func(pathfn string) (bool, error) {
+		base := strings.ToLower(path.Base(pathfn))
+		return !fileparser.IsTestdataFile(pathfn) && !isVendored(pathfn) &&
+			!strings.HasPrefix(pathfn, ".github/workflows/") &&
+			(strings.HasSuffix(base, ".yml") || strings.HasSuffix(base, ".yaml") ||
+				base == "devcontainer.json" || base == ".devcontainer.json"), nil
+	}
+	files, err := c.RepoClient.ListFiles(isCandidate)
+	if err != nil {
+		return fmt.Errorf("RepoClient.ListFiles: %w", err)
+	}
+	// Helm charts are the directories with a Chart.yaml file.
+	charts := make(map[string]bool)
+	for _, f := range files {
+		if path.Base(f) == "Chart.yaml" {
+			charts[path.Dir(f)] = true
+		}
+	}
+	for _, f := range files {
+		// The predicate may not have been applied, e.g. by mocks.
+		if ok, _ := isCandidate(f); !ok {
+			continue
+		}
+		content, err := readFile(c, f)
+		if err != nil {
+			return err
+		}
+		collectFileContainerImages(f, content, charts, r)
+	}
+	applyDockerfilePinningRemediations(r.Dependencies)
+	return nil
+}
+
+func readFile(c *checker.CheckRequest, pathfn string) ([]byte, error) {
+	reader, err := c.RepoClient.GetFileReader(pathfn)
+	if err != nil {
+		return nil, fmt.Errorf("RepoClient.GetFileReader: %w", err)
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", pathfn, err)
+	}
+	return content, nil
+}
+
+func collectFileContainerImages(pathfn string, content []byte, charts map[string]bool,
+	pdata *checker.PinningDependenciesData,
+) {
+	base := strings.ToLower(path.Base(pathfn))
+	switch {
+	case base == "devcontainer.json" || base == ".devcontainer.json":
+		collectDevcontainerImages(pathfn, content, pdata)
+	case isComposeFile(base):
+		forEachYAMLDocument(content, func(doc *yaml.Node) {
+			collectComposeImages(pathfn, doc, pdata)
+		})
+	case base == "kustomization.yaml" || base == "kustomization.yml":
+		forEachYAMLDocument(content, func(doc *yaml.Node) {
+			collectKustomizeImages(pathfn, doc, pdata)
+		})
+	case charts[path.Dir(pathfn)] && strings.HasPrefix(base, "values"):
+		forEachYAMLDocument(content, func(doc *yaml.Node) {
+			collectHelmImages(pathfn, doc, pdata)
+		})
+	default:
+		forEachYAMLDocument(content, func(doc *yaml.Node) {
+			if mappingValue(doc, "apiVersion") != nil && mappingValue(doc, "kind") != nil {
+				collectKubernetesImages(pathfn, doc, pdata)
+			}
+		})
+	}
+}
+
+func isComposeFile(base string) bool {
+	return strings.HasPrefix(base, "docker-compose") || strings.HasPrefix(base, "compose.") ||
+		strings.HasPrefix(base, "compose-")
+}
+
+// forEachYAMLDocument calls fn with the root mapping of each document of a YAML
+// stream. Files which can't be parsed, e.g. templates, are ignored.
+func forEachYAMLDocument(content []byte, fn func(*yaml.Node)) {
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	for {
+		var doc yaml.Node
+		if err := decoder.Decode(&doc); err != nil {
+			return
+		}
+		if len(doc.Content) == 1 && doc.Content[0].Kind == yaml.MappingNode {
+			fn(doc.Content[0])
+		}
+	}
+}
+
+// mappingValue returns the value of a key of a mapping node, or nil.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func scalarValue(node *yaml.Node, key string) string {
+	if v := mappingValue(node, key); v != nil && v.Kind == yaml.ScalarNode {
+		return v.Value
+	}
+	return ""
+}
+
+func collectComposeImages(pathfn string, doc *yaml.Node, pdata *checker.PinningDependenciesData) {
+	services := mappingValue(doc, "services")
+	if services == nil || services.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 1; i < len(services.Content); i += 2 {
+		if image := mappingValue(services.Content[i], "image"); image != nil && image.Kind == yaml.ScalarNode {
+			addContainerImage(pdata, checker.DependencyUseTypeComposeContainerImage, pathfn, image.Line, image.Value,
+				image.Value)
+		}
+	}
+}
+
+// collectKubernetesImages collects the images of the containers of any
+// workload, e.g. a Pod, a Deployment or the job template of a CronJob.
+func collectKubernetesImages(pathfn string, node *yaml.Node, pdata *checker.PinningDependenciesData) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i].Value, node.Content[i+1]
+			if (key == "containers" || key == "initContainers" || key == "ephemeralContainers") &&
+				value.Kind == yaml.SequenceNode {
+				for _, container := range value.Content {
+					if image := mappingValue(container, "image"); image != nil && image.Kind == yaml.ScalarNode {
+						addContainerImage(pdata, checker.DependencyUseTypeKubernetesContainerImage, pathfn,
+							image.Line, image.Value, image.Value)
+					}
+				}
+				continue
+			}
+			collectKubernetesImages(pathfn, value, pdata)
+		}
+	case yaml.SequenceNode:
+		for _, n := range node.Content {
+			collectKubernetesImages(pathfn, n, pdata)
+		}
+	}
+}
+
+// collectKustomizeImages collects the images of the images transformer,
+// https://kubectl.docs.kubernetes.io/references/kustomize/kustomization/images/.
+func collectKustomizeImages(pathfn string, doc *yaml.Node, pdata *checker.PinningDependenciesData) {
+	images := mappingValue(doc, "images")
+	if images == nil || images.Kind != yaml.SequenceNode {
+		return
+	}
+	for _, image := range images.Content {
+		name := scalarValue(image, "newName")
+		if name == "" {
+			name = scalarValue(image, "name")
+		}
+		if name == "" {
+			continue
+		}
+		ref := name
+		if tag := scalarValue(image, "newTag"); tag != "" {
+			ref += ":" + tag
+		}
+		if digest := scalarValue(image, "digest"); digest != "" {
+			ref += "@" + digest
+		}
+		addContainerImage(pdata, checker.DependencyUseTypeKubernetesContainerImage, pathfn, image.Line, ref,
+			"")
+	}
+}
+
+// collectHelmImages collects the images of Helm chart values, either set as
+// a reference or as the conventional repository, tag and digest keys.
+func collectHelmImages(pathfn string, node *yaml.Node, pdata *checker.PinningDependenciesData) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i].Value, node.Content[i+1]
+			if key != "image" {
+				collectHelmImages(pathfn, value, pdata)
+				continue
+			}
+			switch value.Kind {
+			case yaml.ScalarNode:
+				if value.Value != "" {
+					addContainerImage(pdata, checker.DependencyUseTypeHelmContainerImage, pathfn, value.Line,
+						value.Value, value.Value)
+				}
+			case yaml.MappingNode:
+				repository := mappingValue(value, "repository")
+				if repository == nil || repository.Value == "" {
+					continue
+				}
+				ref := repository.Value
+				if registry := scalarValue(value, "registry"); registry != "" {
+					ref = registry + "/" + ref
+				}
+				if tag := scalarValue(value, "tag"); tag != "" {
+					ref += ":" + tag
+				}
+				if digest := scalarValue(value, "digest"); digest != "" {
+					ref += "@" + digest
+				}
+				addContainerImage(pdata, checker.DependencyUseTypeHelmContainerImage, pathfn, repository.Line, ref,
+					"")
+			}
+		}
+	case yaml.SequenceNode:
+		for _, n := range node.Content {
+			collectHelmImages(pathfn, n, pdata)
+		}
+	}
+}
+
+// collectDevcontainerImages collects the image of a devcontainer.json file.
+// The file is JSON with comments, so it's read line by line.
+func collectDevcontainerImages(pathfn string, content []byte, pdata *checker.PinningDependenciesData) {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for line := 1; scanner.Scan(); line++ {
+		if m := reDevcontainerImage.FindStringSubmatch(scanner.Text()); m != nil {
+			addContainerImage(pdata, checker.DependencyUseTypeDevcontainerImage, pathfn, line, m[1], m[1])
+		}
+	}
+}
+
+// collectWorkflowContainerImages collects the images of a job's container and services.
+func collectWorkflowContainerImages(job *actionlint.Job, pathfn string, pdata *checker.PinningDependenciesData) {
+	containers := []*actionlint.Container{job.Container}
+	if job.Services != nil {
+		for _, service := range job.Services.Value {
+			containers = append(containers, service.Container)
+		}
+	}
+	for _, container := range containers {
+		if container == nil || container.Image == nil || container.Image.Value == "" {
+			continue
+		}
+		image := container.Image
+		addContainerImage(pdata, checker.DependencyUseTypeGHActionContainerImage, pathfn, image.Pos.Line,
+			image.Value, image.Value)
+	}
+}
+
+// addContainerImage records the use of an image reference. Images set
+// through variables or templates can't be evaluated and are skipped. The
+// snippet is empty if the reference is assembled from several values.
+func addContainerImage(pdata *checker.PinningDependenciesData, typ checker.DependencyUseType, pathfn string,
+	line int, ref, snippet string,
+) {
+	ref = strings.TrimPrefix(ref, "docker://")
+	if strings.ContainsAny(ref, "${}") {
+		return
+	}
+	if snippet == "" {
+		snippet = ref
+	}
+	name, tag := ref, ""
+	if i := strings.Index(name, "@"); i >= 0 {
+		name = name[:i]
+	}
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, tag = name[:i], name[i+1:]
+	}
+	dep := checker.Dependency{
+		Location: &checker.File{
+			Path:      pathfn,
+			Type:      finding.FileTypeSource,
+			Offset:    uint(line),
+			EndOffset: uint(line),
+			Snippet:   snippet,
+		},
+		Name:   asPointer(name),
+		Pinned: asBoolPointer(reImageDigest.MatchString(ref)),
+		Type:   typ,
+	}
+	if tag != "" {
+		dep.PinnedAt = asPointer(tag)
+	}
+	pdata.Dependencies = append(pdata.Dependencies, dep)
+}
@@ -0,0 +1,234 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/rhysd/actionlint"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ossf/scorecard/v5/checker"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+)
+
+func TestMain(m *testing.M) {
+	// Remediations of unpinned images are computed without the network.
+	containerImageDigester = stubDigester{
+		"python:3.7": "sha256:eedf63967cdb57d8214db38ce21f105003ed4e4d0358f02bedc057341bcf92a0",
+		"nginx:1.25": "sha256:0b4a7c8f1d6e3f0a9c2b5d7e8f1a3c4b6d8e0f2a4c6b8d0e2f4a6c8b0d2e4f6a",
+	}
+	os.Exit(m.Run())
+}
+
+type stubDigester map[string]string
+
+func (s stubDigester) Digest(name string) (string, error) {
+	digest, ok := s[name]
+	if !ok {
+		return "", fmt.Errorf("%w: no digest for %s", os.ErrNotExist, name)
+	}
+	return digest, nil
+}
+
+const pinnedDigest = "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+
+type containerImage struct {
+	typ    checker.DependencyUseType
+	path   string
+	name   string
+	tag    string
+	line   uint
+	pinned bool
+}
+
+func TestCollectContainerImagePinning(t *testing.T) {
+	t.Parallel()
+	files := map[string]string{
+		"docker-compose.yml": `services:
+  web:
+    image: nginx:1.25
+  db:
+    image: postgres@` + pinnedDigest + `
+  app:
+    build: .
+`,
+		"deploy/app.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+---
+apiVersion: batch/v1
+kind: CronJob
+spec:
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          initContainers:
+            - name: init
+              image: registry.example.com:5000/init:v1
+          containers:
+            - name: job
+              image: "busybox"
+`,
+		"deploy/kustomization.yaml": `images:
+  - name: nginx
+    newTag: "1.26"
+  - name: busybox
+    digest: ` + pinnedDigest + `
+`,
+		"chart/Chart.yaml": "name: chart\n",
+		"chart/values.yaml": `image:
+  repository: example/app
+  tag: ""
+sidecar:
+  image:
+    registry: ghcr.io
+    repository: example/sidecar
+    digest: ` + pinnedDigest + `
+`,
+		"chart/templates/deployment.yaml": `apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      containers:
+        - image: "{{ .Values.image.repository }}:{{ .Values.image.tag }}"
+`,
+		".devcontainer/devcontainer.json": `{
+  // The development image.
+  "image": "mcr.microsoft.com/devcontainers/go:1.22"
+}`,
+		"config.yaml":                  "image: not-a-manifest:1\n",
+		"testdata/docker-compose.yml":  "services:\n  x:\n    image: x:1\n",
+		"vendor/a/docker-compose.yaml": "services:\n  x:\n    image: x:1\n",
+	}
+	want := []containerImage{
+		{checker.DependencyUseTypeDevcontainerImage, ".devcontainer/devcontainer.json", "mcr.microsoft.com/devcontainers/go",
+			"1.22", 3, false},
+		{checker.DependencyUseTypeHelmContainerImage, "chart/values.yaml", "example/app", "", 2, false},
+		{checker.DependencyUseTypeHelmContainerImage, "chart/values.yaml", "ghcr.io/example/sidecar", "", 7, true},
+		{checker.DependencyUseTypeKubernetesContainerImage, "deploy/app.yaml", "registry.example.com:5000/init", "v1",
+			15, false},
+		{checker.DependencyUseTypeKubernetesContainerImage, "deploy/app.yaml", "busybox", "", 18, false},
+		{checker.DependencyUseTypeKubernetesContainerImage, "deploy/kustomization.yaml", "nginx", "1.26", 2, false},
+		{checker.DependencyUseTypeKubernetesContainerImage, "deploy/kustomization.yaml", "busybox", "", 4, true},
+		{checker.DependencyUseTypeComposeContainerImage, "docker-compose.yml", "nginx", "1.25", 3, false},
+		{checker.DependencyUseTypeComposeContainerImage, "docker-compose.yml", "postgres", "", 5, true},
+	}
+
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	var names []string
+	for f := range files {
+		names = append(names, f)
+	}
+	slices.Sort(names)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return(names, nil).AnyTimes()
+	mockRepoClient.EXPECT().GetFileReader(gomock.Any()).AnyTimes().DoAndReturn(
+		func(file string) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(files[file])), nil
+		})
+	req := checker.CheckRequest{RepoClient: mockRepoClient}
+
+	var r checker.PinningDependenciesData
+	if err := collectContainerImagePinning(&req, &r); err != nil {
+		t.Fatal(err)
+	}
+	var got []containerImage
+	for _, d := range r.Dependencies {
+		image := containerImage{
+			typ:    d.Type,
+			path:   d.Location.Path,
+			name:   *d.Name,
+			line:   d.Location.Offset,
+			pinned: *d.Pinned,
+		}
+		if d.PinnedAt != nil {
+			image.tag = *d.PinnedAt
+		}
+		got = append(got, image)
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(containerImage{})); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestContainerImageRemediations(t *testing.T) {
+	t.Parallel()
+	deps := []checker.Dependency{
+		{
+			Name:     stringAsPointer("nginx"),
+			PinnedAt: stringAsPointer("1.25"),
+			Pinned:   boolAsPointer(false),
+			Type:     checker.DependencyUseTypeComposeContainerImage,
+			Location: &checker.File{Path: "docker-compose.yml"},
+		},
+		{
+			Name:     stringAsPointer("unknown"),
+			Pinned:   boolAsPointer(false),
+			Type:     checker.DependencyUseTypeKubernetesContainerImage,
+			Location: &checker.File{Path: "deploy.yaml"},
+		},
+	}
+	applyDockerfilePinningRemediations(deps)
+	if deps[0].Remediation == nil || !strings.Contains(deps[0].Remediation.Text, "nginx:1.25@sha256:") {
+		t.Errorf("unexpected remediation: %v", deps[0].Remediation)
+	}
+	if deps[1].Remediation != nil {
+		t.Errorf("unexpected remediation for an image without digest: %v", deps[1].Remediation)
+	}
+}
+
+func TestCollectWorkflowContainerImages(t *testing.T) {
+	t.Parallel()
+	content := `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    container: node:20
+    services:
+      redis:
+        image: redis@` + pinnedDigest + `
+      db:
+        image: ${{ matrix.db }}
+    steps:
+      - run: npm test
+`
+	workflow, errs := actionlint.Parse([]byte(content))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	var r checker.PinningDependenciesData
+	collectWorkflowContainerImages(workflow.Jobs["test"], ".github/workflows/test.yml", &r)
+	pinned := make(map[string]bool)
+	for _, d := range r.Dependencies {
+		if d.Type != checker.DependencyUseTypeGHActionContainerImage {
+			t.Errorf("unexpected type %s", d.Type)
+		}
+		pinned[*d.Name] = *d.Pinned
+	}
+	want := map[string]bool{"node": false, "redis": true}
+	if diff := cmp.Diff(want, pinned); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -16,7 +16,10 @@ package raw
 
 import (
 	"fmt"
+	"maps"
+	"path"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/rhysd/actionlint"
@@ -69,7 +72,10 @@ func containsUntrustedContextPattern(variable string) bool {
 type triggerName string
 
 var (
+	triggerPullRequest              = triggerName("pull_request")
 	triggerPullRequestTarget        = triggerName("pull_request_target")
+	triggerPullRequestReview        = triggerName("pull_request_review")
+	triggerPullRequestReviewComment = triggerName("pull_request_review_comment")
 	triggerWorkflowRun              = triggerName("workflow_run")
 	checkoutUntrustedPullRequestRef = "github.event.pull_request"
 	checkoutUntrustedWorkflowRunRef = "github.event.workflow_run"
@@ -82,7 +88,7 @@ func DangerousWorkflow(c *checker.CheckRequest) (checker.DangerousWorkflowData,
 	err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
 		Pattern:       ".github/workflows/*",
 		CaseSensitive: false,
-	}, validateGitHubActionWorkflowPatterns, &data)
+	}, validateGitHubActionWorkflowPatterns, &data, newWorkflowCallResolver(c))
 
 	return data, err
 }
@@ -96,7 +102,7 @@ var validateGitHubActionWorkflowPatterns fileparser.DoWhileTrueOnFileContent = f
 		return true, nil
 	}
 
-	if len(args) != 1 {
+	if len(args) != 2 {
 		return false, fmt.Errorf(
 			"validateGitHubActionWorkflowPatterns requires exactly 2 arguments: %w", errInvalidArgLength)
 	}
@@ -107,6 +113,11 @@ var validateGitHubActionWorkflowPatterns fileparser.DoWhileTrueOnFileContent = f
 		return false, fmt.Errorf(
 			"validateGitHubActionWorkflowPatterns expects arg[0] of type *patternCbData: %w", errInvalidArgType)
 	}
+	resolver, ok := args[1].(*workflowCallResolver)
+	if !ok {
+		return false, fmt.Errorf(
+			"validateGitHubActionWorkflowPatterns expects arg[1] of type *workflowCallResolver: %w", errInvalidArgType)
+	}
 
 	if !fileparser.CheckFileContainsCommands(content, "#") {
 		return true, nil
@@ -129,14 +140,81 @@ var validateGitHubActionWorkflowPatterns fileparser.DoWhileTrueOnFileContent = f
 		return false, err
 	}
 
+	// 3. Check for artifact and cache poisoning, and self-hosted runners on pull requests.
+	validateRunnerAndArtifactPatterns(workflow, workflow, path, pdata)
+
+	// 4. Check the reusable workflows and composite actions called by the workflow.
+	for _, called := range resolver.resolve(workflow, path) {
+		if err := validateCalledWorkflow(&called, resolver, pdata); err != nil {
+			return false, err
+		}
+	}
+
 	// TODO: Check other dangerous patterns.
 	return true, nil
 }
 
+func validateCalledWorkflow(called *calledWorkflow, resolver *workflowCallResolver,
+	pdata *checker.DangerousWorkflowData,
+) error {
+	n := len(pdata.Workflows)
+	// Whether a checkout is untrusted depends on what triggered the calling workflow.
+	if hasUntrustedCheckoutTrigger(called.root) && resolver.firstVisit("checkout:"+called.path) {
+		for _, job := range called.workflow.Jobs {
+			if err := checkJobForUntrustedCodeCheckout(job, called.path, pdata); err != nil {
+				return err
+			}
+		}
+	}
+	// Local reusable workflows are checked for script injection on their own.
+	if !(called.kind == reusableWorkflow && called.local) && resolver.firstVisit("injection:"+called.path) {
+		if err := validateScriptInjection(called.workflow, called.path, pdata); err != nil {
+			return err
+		}
+	}
+	if resolver.firstVisit("patterns:" + called.path) {
+		validateRunnerAndArtifactPatterns(called.root, called.workflow, called.path, pdata)
+	}
+	for i := n; i < len(pdata.Workflows); i++ {
+		pdata.Workflows[i].File.CallChain = called.callChain()
+		if called.kind == compositeAction {
+			// The job holding the steps of a composite action is synthetic.
+			pdata.Workflows[i].Job = nil
+		}
+	}
+	return nil
+}
+
+// validateRunnerAndArtifactPatterns checks the jobs of workflow, which may be
+// called by root, for patterns which depend on the events triggering root.
+func validateRunnerAndArtifactPatterns(root, workflow *actionlint.Workflow, path string,
+	pdata *checker.DangerousWorkflowData,
+) {
+	for _, id := range slices.Sorted(maps.Keys(workflow.Jobs)) {
+		job := workflow.Jobs[id]
+		if job == nil {
+			continue
+		}
+		if usesEventTrigger(root, triggerWorkflowRun) {
+			checkJobForArtifactPoisoning(job, path, pdata)
+		}
+		if hasUntrustedCheckoutTrigger(root) {
+			checkJobForCachePoisoning(job, path, pdata)
+		}
+		if hasPullRequestTrigger(root) {
+			checkJobForSelfHostedRunner(job, path, pdata)
+		}
+	}
+}
+
+func hasUntrustedCheckoutTrigger(workflow *actionlint.Workflow) bool {
+	return usesEventTrigger(workflow, triggerPullRequestTarget) || usesEventTrigger(workflow, triggerWorkflowRun)
+}
+
 func validateUntrustedCodeCheckout(workflow *actionlint.Workflow, path string,
 	pdata *checker.DangerousWorkflowData,
 ) error {
-	if !usesEventTrigger(workflow, triggerPullRequestTarget) && !usesEventTrigger(workflow, triggerWorkflowRun) {
+	if !hasUntrustedCheckoutTrigger(workflow) {
 		return nil
 	}
 
@@ -183,44 +261,54 @@ func checkJobForUntrustedCodeCheckout(job *actionlint.Job, path string,
 
 	// Check each step, which is a map, for checkouts with untrusted ref
 	for _, step := range job.Steps {
-		if step == nil || step.Exec == nil {
-			continue
-		}
-		// Check for a step that uses actions/checkout
-		e, ok := step.Exec.(*actionlint.ExecAction)
-		if !ok || e.Uses == nil {
-			continue
-		}
-		if !strings.Contains(e.Uses.Value, "actions/checkout") {
+		ref, ok := untrustedCheckoutRef(step)
+		if !ok {
 			continue
 		}
-		// Check for reference. If not defined for a pull_request_target event, this defaults to
-		// the base branch of the pull request.
-		ref, ok := e.Inputs["ref"]
-		if !ok || ref.Value == nil {
-			continue
-		}
-
-		if strings.Contains(ref.Value.Value, checkoutUntrustedPullRequestRef) ||
-			strings.Contains(ref.Value.Value, checkoutUntrustedWorkflowRunRef) {
-			line := fileparser.GetLineNumber(step.Pos)
-			pdata.Workflows = append(pdata.Workflows,
-				checker.DangerousWorkflow{
-					Type: checker.DangerousWorkflowUntrustedCheckout,
-					File: checker.File{
-						Path:    path,
-						Type:    finding.FileTypeSource,
-						Offset:  line,
-						Snippet: ref.Value.Value,
-					},
-					Job: createJob(job),
+		line := fileparser.GetLineNumber(step.Pos)
+		pdata.Workflows = append(pdata.Workflows,
+			checker.DangerousWorkflow{
+				Type: checker.DangerousWorkflowUntrustedCheckout,
+				File: checker.File{
+					Path:    path,
+					Type:    finding.FileTypeSource,
+					Offset:  line,
+					Snippet: ref,
 				},
-			)
-		}
+				Job: createJob(job),
+			},
+		)
 	}
 	return nil
 }
 
+// untrustedCheckoutRef returns the ref checked out by a step, if it's
+// a checkout of the pull request or run which triggered the workflow.
+func untrustedCheckoutRef(step *actionlint.Step) (string, bool) {
+	if step == nil || step.Exec == nil {
+		return "", false
+	}
+	// Check for a step that uses actions/checkout
+	e, ok := step.Exec.(*actionlint.ExecAction)
+	if !ok || e.Uses == nil {
+		return "", false
+	}
+	if !strings.Contains(e.Uses.Value, "actions/checkout") {
+		return "", false
+	}
+	// Check for reference. If not defined for a pull_request_target event, this defaults to
+	// the base branch of the pull request.
+	ref, ok := e.Inputs["ref"]
+	if !ok || ref.Value == nil {
+		return "", false
+	}
+	if strings.Contains(ref.Value.Value, checkoutUntrustedPullRequestRef) ||
+		strings.Contains(ref.Value.Value, checkoutUntrustedWorkflowRunRef) {
+		return ref.Value.Value, true
+	}
+	return "", false
+}
+
 func validateScriptInjection(workflow *actionlint.Workflow, path string,
 	pdata *checker.DangerousWorkflowData,
 ) error {
@@ -242,6 +330,7 @@ func validateScriptInjection(workflow *actionlint.Workflow, path string,
 			}
 		}
 	}
+	validateScriptTaint(workflow, path, pdata)
 	return nil
 }
 
@@ -281,3 +370,210 @@ func checkVariablesInScript(script string, pos *actionlint.Pos,
 	}
 	return nil
 }
+
+func hasPullRequestTrigger(workflow *actionlint.Workflow) bool {
+	return usesEventTrigger(workflow, triggerPullRequest) ||
+		usesEventTrigger(workflow, triggerPullRequestTarget) ||
+		usesEventTrigger(workflow, triggerPullRequestReview) ||
+		usesEventTrigger(workflow, triggerPullRequestReviewComment)
+}
+
+var (
+	// Commands which unpack an archive or run code that may come from one.
+	reArtifactUse = regexp.MustCompile(
+		`(?m)^.*(?:\b(?:unzip|gunzip|7z\s+x|tar\s+-?[a-zA-Z]*x[a-zA-Z]*)\b|` +
+			`\b(?:bash|sh|source|eval|python3?|node|npm|yarn|make)\b|(?:^|\s)\.{1,2}/).*$`)
+	reGitHubRunDownload = regexp.MustCompile(`\bgh\s+run\s+download\b`)
+	reGitHubRunFlag     = regexp.MustCompile(`(?:-D|--dir|-n|--name)[\s=]+["']?([^\s"']+)`)
+	reWriteFile         = regexp.MustCompile(`writeFileSync\(\s*[\x60'"]([^\x60'"]+)[\x60'"]`)
+)
+
+// triggeringRunArtifacts returns the names and directories of the artifacts
+// a step downloads from the run which triggered a workflow_run workflow, by
+// which the commands using them are recognized. It returns false if the step
+// doesn't download them.
+func triggeringRunArtifacts(step *actionlint.Step) ([]string, bool) {
+	switch e := step.Exec.(type) {
+	case *actionlint.ExecRun:
+		if e.Run == nil || !reGitHubRunDownload.MatchString(e.Run.Value) {
+			return nil, false
+		}
+		var refs []string
+		for _, m := range reGitHubRunFlag.FindAllStringSubmatch(e.Run.Value, -1) {
+			refs = append(refs, m[1])
+		}
+		return refs, true
+	case *actionlint.ExecAction:
+		if e.Uses == nil {
+			return nil, false
+		}
+		input := func(name string) string {
+			if v, ok := e.Inputs[name]; ok && v.Value != nil {
+				return v.Value.Value
+			}
+			return ""
+		}
+		uses := e.Uses.Value
+		switch {
+		case strings.HasPrefix(uses, "dawidd6/action-download-artifact@"):
+			// The action downloads artifacts of the triggering run with its
+			// run_id, and of the latest run of any branch, including forks,
+			// unless a workflow or branch is given.
+			if !strings.Contains(input("run_id"), checkoutUntrustedWorkflowRunRef) &&
+				(input("workflow") != "" || input("branch") != "") {
+				return nil, false
+			}
+			return []string{input("name"), input("path")}, true
+		case strings.HasPrefix(uses, "actions/download-artifact@"):
+			// Without a run-id, artifacts of the current run are downloaded.
+			if !strings.Contains(input("run-id"), checkoutUntrustedWorkflowRunRef) {
+				return nil, false
+			}
+			return []string{input("name"), input("path")}, true
+		case strings.HasPrefix(uses, "actions/github-script@"):
+			script := input("script")
+			if !strings.Contains(script, "downloadArtifact") {
+				return nil, false
+			}
+			var refs []string
+			for _, m := range reWriteFile.FindAllStringSubmatch(script, -1) {
+				refs = append(refs, m[1])
+			}
+			return refs, true
+		}
+	}
+	return nil, false
+}
+
+// artifactRef returns a pattern matching the references to a downloaded
+// artifact name or directory, or nil if it can't be told apart, e.g. the
+// workspace where artifacts are downloaded by default.
+func artifactRef(ref string) *regexp.Regexp {
+	ref = path.Base(strings.TrimRight(ref, "/"))
+	if ref == "." || ref == "/" || strings.Contains(ref, "${{") {
+		return nil
+	}
+	return regexp.MustCompile(`(?:^|[^\w.-])` + regexp.QuoteMeta(ref) + `(?:$|[^\w-])`)
+}
+
+// checkJobForArtifactPoisoning looks for workflow_run jobs which download
+// the artifacts of the triggering run, which may come from a fork, and then
+// unpack or execute them, i.e. run a command referencing their name or
+// directory. Only the first such use is reported for each job.
+func checkJobForArtifactPoisoning(job *actionlint.Job, path string, pdata *checker.DangerousWorkflowData) {
+	var refs []*regexp.Regexp
+	for _, step := range job.Steps {
+		if step == nil || step.Exec == nil {
+			continue
+		}
+		if artifacts, ok := triggeringRunArtifacts(step); ok {
+			for _, a := range artifacts {
+				if ref := artifactRef(a); ref != nil {
+					refs = append(refs, ref)
+				}
+			}
+			continue
+		}
+		run, ok := step.Exec.(*actionlint.ExecRun)
+		if len(refs) == 0 || !ok || run.Run == nil {
+			continue
+		}
+		for _, use := range reArtifactUse.FindAllString(run.Run.Value, -1) {
+			if !slices.ContainsFunc(refs, func(ref *regexp.Regexp) bool { return ref.MatchString(use) }) {
+				continue
+			}
+			pdata.Workflows = append(pdata.Workflows, checker.DangerousWorkflow{
+				Type: checker.DangerousWorkflowArtifactPoisoning,
+				File: checker.File{
+					Path:    path,
+					Type:    finding.FileTypeSource,
+					Offset:  fileparser.GetLineNumber(run.Run.Pos),
+					Snippet: strings.TrimSpace(use),
+				},
+				Job: createJob(job),
+			})
+			return
+		}
+	}
+}
+
+// writesCache reports whether a step saves a cache when the job completes.
+func writesCache(step *actionlint.Step) (string, bool) {
+	e, ok := step.Exec.(*actionlint.ExecAction)
+	if !ok || e.Uses == nil {
+		return "", false
+	}
+	uses := e.Uses.Value
+	if strings.HasPrefix(uses, "actions/cache@") || strings.HasPrefix(uses, "actions/cache/save@") {
+		return uses, true
+	}
+	// Setup actions, e.g. actions/setup-node, cache dependencies with the cache input.
+	if strings.HasPrefix(uses, "actions/setup-") {
+		cache, ok := e.Inputs["cache"]
+		if ok && cache.Value != nil && cache.Value.Value != "" && cache.Value.Value != "false" {
+			return uses, true
+		}
+	}
+	return "", false
+}
+
+// checkJobForCachePoisoning looks for privileged jobs which check out untrusted
+// code and write to a cache. Caches written by pull_request_target and
+// workflow_run runs belong to the default branch, so they are restored by the
+// repository's other privileged workflows, e.g. releases.
+func checkJobForCachePoisoning(job *actionlint.Job, path string, pdata *checker.DangerousWorkflowData) {
+	untrusted := false
+	for _, step := range job.Steps {
+		if _, ok := untrustedCheckoutRef(step); ok {
+			untrusted = true
+			break
+		}
+	}
+	if !untrusted {
+		return
+	}
+	for _, step := range job.Steps {
+		if step == nil {
+			continue
+		}
+		uses, ok := writesCache(step)
+		if !ok {
+			continue
+		}
+		pdata.Workflows = append(pdata.Workflows, checker.DangerousWorkflow{
+			Type: checker.DangerousWorkflowCachePoisoning,
+			File: checker.File{
+				Path:    path,
+				Type:    finding.FileTypeSource,
+				Offset:  fileparser.GetLineNumber(step.Pos),
+				Snippet: uses,
+			},
+			Job: createJob(job),
+		})
+	}
+}
+
+// checkJobForSelfHostedRunner looks for jobs which run pull request code on
+// self-hosted runners. Anyone who can open a pull request from a fork may
+// then persist on the runner or reach the network it's in.
+func checkJobForSelfHostedRunner(job *actionlint.Job, path string, pdata *checker.DangerousWorkflowData) {
+	if job.RunsOn == nil {
+		return
+	}
+	for _, label := range job.RunsOn.Labels {
+		if label == nil || !strings.EqualFold(label.Value, "self-hosted") {
+			continue
+		}
+		pdata.Workflows = append(pdata.Workflows, checker.DangerousWorkflow{
+			Type: checker.DangerousWorkflowSelfHostedRunner,
+			File: checker.File{
+				Path:    path,
+				Type:    finding.FileTypeSource,
+				Offset:  fileparser.GetLineNumber(label.Pos),
+				Snippet: label.Value,
+			},
+			Job: createJob(job),
+		})
+		return
+	}
+}
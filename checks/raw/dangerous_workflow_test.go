@@ -246,3 +246,71 @@ func TestGithubDangerousWorkflow(t *testing.T) {
 		})
 	}
 }
+
+func TestRunnerAndArtifactPatterns(t *testing.T) {
+	t.Parallel()
+	type pattern struct {
+		typ     checker.DangerousWorkflowType
+		snippet string
+		line    uint
+	}
+	tests := []struct {
+		name     string
+		filename string
+		want     []pattern
+	}{
+		{
+			name:     "artifact poisoning",
+			filename: ".github/workflows/github-workflow-dangerous-pattern-artifact-poisoning.yml",
+			want: []pattern{
+				{typ: checker.DangerousWorkflowArtifactPoisoning, line: 29, snippet: "unzip pr.zip -d report"},
+				{typ: checker.DangerousWorkflowArtifactPoisoning, line: 59, snippet: "node bundle-dir/index.js"},
+				{typ: checker.DangerousWorkflowArtifactPoisoning, line: 65, snippet: "tar -xzf results/out.tgz"},
+			},
+		},
+		{
+			name:     "cache poisoning",
+			filename: ".github/workflows/github-workflow-dangerous-pattern-cache-poisoning.yml",
+			want: []pattern{
+				{typ: checker.DangerousWorkflowUntrustedCheckout, line: 20, snippet: "${{ github.event.pull_request.head.sha }}"},
+				{typ: checker.DangerousWorkflowCachePoisoning, line: 23, snippet: "actions/setup-node@v4"},
+			},
+		},
+		{
+			name:     "self-hosted runner",
+			filename: ".github/workflows/github-workflow-dangerous-pattern-self-hosted-runner.yml",
+			want: []pattern{
+				{typ: checker.DangerousWorkflowSelfHostedRunner, line: 18, snippet: "self-hosted"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+			mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return([]string{tt.filename}, nil)
+			mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(file string) (io.ReadCloser, error) {
+				return os.Open("../testdata/" + file)
+			})
+
+			dw, err := DangerousWorkflow(&checker.CheckRequest{
+				Ctx:        t.Context(),
+				RepoClient: mockRepoClient,
+			})
+			if err != nil {
+				t.Fatalf("DangerousWorkflow: %v", err)
+			}
+			var got []pattern
+			for _, w := range dw.Workflows {
+				got = append(got, pattern{typ: w.Type, line: w.File.Offset, snippet: w.File.Snippet})
+			}
+			// Jobs aren't analyzed in order.
+			sortByLine := cmpopts.SortSlices(func(a, b pattern) bool { return a.line < b.line })
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(pattern{}), sortByLine); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
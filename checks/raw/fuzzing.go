@@ -250,6 +250,12 @@ func Fuzzing(c *checker.CheckRequest) (checker.FuzzingData, error) {
 			)
 		}
 	}
+
+	ciFuzzers, err := collectCIConfigFuzzers(c, detectedFuzzers)
+	if err != nil {
+		return checker.FuzzingData{}, err
+	}
+	detectedFuzzers = append(detectedFuzzers, ciFuzzers...)
 	return checker.FuzzingData{Fuzzers: detectedFuzzers}, nil
 }
 
@@ -0,0 +1,336 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"bytes"
+	"io"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/rhysd/actionlint"
+	"go.yaml.in/yaml/v3"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+const (
+	// Calls nested deeper than this are not followed.
+	maxWorkflowCallDepth = 5
+	// Each remote repository is downloaded, so the number followed is capped.
+	maxRemoteWorkflowRepos = 20
+)
+
+const callChainSeparator = " -> "
+
+type calledWorkflowKind int
+
+const (
+	reusableWorkflow calledWorkflowKind = iota
+	compositeAction
+)
+
+// calledWorkflow is a reusable workflow or composite action reached, directly
+// or through other calls, from a workflow of the repository.
+type calledWorkflow struct {
+	// root is the repository workflow the calls start from.
+	root *actionlint.Workflow
+	// workflow holds the jobs of a reusable workflow, or a single job
+	// with the steps of a composite action.
+	workflow *actionlint.Workflow
+	// path is the path of the file in the repository for local files,
+	// and `owner/repo/path@ref` for remote ones.
+	path string
+	// chain lists the files through which this one was reached, starting
+	// with the repository workflow.
+	chain []string
+	kind  calledWorkflowKind
+	local bool
+}
+
+func (w *calledWorkflow) callChain() string {
+	return strings.Join(w.chain, callChainSeparator)
+}
+
+// workflowRef locates a workflow or action metadata file.
+type workflowRef struct {
+	// repo is empty for the analyzed repository.
+	repo string
+	ref  string
+	path string
+}
+
+func (r workflowRef) String() string {
+	if r.repo == "" {
+		return r.path
+	}
+	return r.repo + "/" + r.path + "@" + r.ref
+}
+
+// workflowCallResolver follows `uses:` references from GitHub workflows to
+// the reusable workflows and composite actions they call. Local files are read
+// through the repository client, remote ones through checker.CheckRequest.RemoteRepoClient
+// if it is set. Files which can't be read or parsed are skipped.
+type workflowCallResolver struct {
+	client clients.RepoClient
+	remote func(repo, ref string) (clients.RepoClient, error)
+	// loaded caches parsed files by workflowRef.String(), nil if unavailable.
+	loaded      map[string]*calledWorkflow
+	remoteRepos map[string]bool
+	seen        map[string]bool
+}
+
+func newWorkflowCallResolver(c *checker.CheckRequest) *workflowCallResolver {
+	return &workflowCallResolver{
+		client:      c.RepoClient,
+		remote:      c.RemoteRepoClient,
+		loaded:      make(map[string]*calledWorkflow),
+		remoteRepos: make(map[string]bool),
+		seen:        make(map[string]bool),
+	}
+}
+
+// firstVisit reports whether key is seen for the first time. Callers use it
+// to analyze files called from several workflows only once.
+func (r *workflowCallResolver) firstVisit(key string) bool {
+	if r.seen[key] {
+		return false
+	}
+	r.seen[key] = true
+	return true
+}
+
+// resolve returns the workflows and actions called by the repository workflow
+// at path, in the order they are reached.
+func (r *workflowCallResolver) resolve(workflow *actionlint.Workflow, path string) []calledWorkflow {
+	if r == nil {
+		return nil
+	}
+	var called []calledWorkflow
+	r.walk(workflow, workflow, workflowRef{path: path}, []string{path}, &called)
+	return called
+}
+
+func (r *workflowCallResolver) walk(root, workflow *actionlint.Workflow, from workflowRef,
+	chain []string, called *[]calledWorkflow,
+) {
+	if len(chain) > maxWorkflowCallDepth {
+		return
+	}
+	// Jobs are visited in a stable order, so calls reached several ways are
+	// reported with the same chain on every run.
+	for _, id := range slices.Sorted(maps.Keys(workflow.Jobs)) {
+		job := workflow.Jobs[id]
+		if job == nil {
+			continue
+		}
+		if job.WorkflowCall != nil && job.WorkflowCall.Uses != nil {
+			r.follow(root, job.WorkflowCall.Uses.Value, reusableWorkflow, from, chain, called)
+		}
+		for _, step := range job.Steps {
+			if step == nil {
+				continue
+			}
+			if e, ok := step.Exec.(*actionlint.ExecAction); ok && e.Uses != nil {
+				r.follow(root, e.Uses.Value, compositeAction, from, chain, called)
+			}
+		}
+	}
+}
+
+func (r *workflowCallResolver) follow(root *actionlint.Workflow, uses string, kind calledWorkflowKind,
+	from workflowRef, chain []string, called *[]calledWorkflow,
+) {
+	ref, ok := locateCall(uses, kind, from)
+	if !ok {
+		return
+	}
+	w := r.load(ref, kind)
+	if w == nil || slices.Contains(chain, w.path) {
+		return
+	}
+	c := *w
+	c.root = root
+	c.chain = slices.Clone(chain)
+	*called = append(*called, c)
+	r.walk(root, c.workflow, ref, append(c.chain, c.path), called)
+}
+
+// locateCall returns where the file for a `uses:` value lives.
+func locateCall(uses string, kind calledWorkflowKind, from workflowRef) (workflowRef, bool) {
+	if strings.HasPrefix(uses, "docker://") {
+		return workflowRef{}, false
+	}
+	if strings.HasPrefix(uses, "./") {
+		if kind == reusableWorkflow {
+			// Relative to the repository and commit of the calling workflow.
+			return workflowRef{repo: from.repo, ref: from.ref, path: path.Clean(uses)}, true
+		}
+		// Local actions are relative to the workspace, which is the analyzed
+		// repository for the common case of checking it out.
+		return workflowRef{path: path.Clean(uses)}, true
+	}
+	name, ref, ok := strings.Cut(uses, "@")
+	if !ok || ref == "" {
+		return workflowRef{}, false
+	}
+	parts := strings.SplitN(name, "/", 3)
+	if len(parts) < 2 {
+		return workflowRef{}, false
+	}
+	loc := workflowRef{repo: parts[0] + "/" + parts[1], ref: ref, path: "."}
+	if len(parts) == 3 {
+		loc.path = path.Clean(parts[2])
+	}
+	return loc, true
+}
+
+func (r *workflowCallResolver) load(ref workflowRef, kind calledWorkflowKind) *calledWorkflow {
+	key := ref.String()
+	if w, ok := r.loaded[key]; ok {
+		return w
+	}
+	// Cache misses too, so unavailable files aren't retried.
+	r.loaded[key] = nil
+
+	client := r.clientFor(ref)
+	if client == nil {
+		return nil
+	}
+
+	var w *calledWorkflow
+	switch kind {
+	case reusableWorkflow:
+		content, ok := readWorkflowFile(client, ref.path)
+		if !ok {
+			return nil
+		}
+		workflow, errs := actionlint.Parse(content)
+		if len(errs) > 0 && workflow == nil {
+			return nil
+		}
+		w = &calledWorkflow{workflow: workflow, path: ref.String()}
+	case compositeAction:
+		for _, name := range []string{"action.yml", "action.yaml"} {
+			actionRef := workflowRef{repo: ref.repo, ref: ref.ref, path: path.Join(ref.path, name)}
+			content, ok := readWorkflowFile(client, actionRef.path)
+			if !ok {
+				continue
+			}
+			workflow, ok := parseCompositeAction(content)
+			if !ok {
+				return nil
+			}
+			w = &calledWorkflow{workflow: workflow, path: actionRef.String()}
+			break
+		}
+	}
+	if w == nil {
+		return nil
+	}
+	w.kind = kind
+	w.local = ref.repo == ""
+	r.loaded[key] = w
+	return w
+}
+
+func (r *workflowCallResolver) clientFor(ref workflowRef) clients.RepoClient {
+	if ref.repo == "" {
+		return r.client
+	}
+	if r.remote == nil {
+		return nil
+	}
+	key := ref.repo + "@" + ref.ref
+	if !r.remoteRepos[key] && len(r.remoteRepos) >= maxRemoteWorkflowRepos {
+		return nil
+	}
+	r.remoteRepos[key] = true
+	client, err := r.remote(ref.repo, ref.ref)
+	if err != nil {
+		return nil
+	}
+	return client
+}
+
+func readWorkflowFile(client clients.RepoClient, name string) ([]byte, bool) {
+	reader, err := client.GetFileReader(name)
+	if err != nil {
+		return nil, false
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, false
+	}
+	return content, true
+}
+
+// parseCompositeAction parses the steps of a composite action as the single
+// job of a workflow, so they can be analyzed like workflow steps.
+// The steps are moved under a synthetic `jobs:` header placed on the lines
+// preceding them, which keeps reported line numbers those of the action file.
+func parseCompositeAction(content []byte) (*actionlint.Workflow, bool) {
+	var action struct {
+		Runs struct {
+			Using string    `yaml:"using"`
+			Steps yaml.Node `yaml:"steps"`
+		} `yaml:"runs"`
+	}
+	if err := yaml.Unmarshal(content, &action); err != nil {
+		return nil, false
+	}
+	steps := &action.Runs.Steps
+	if action.Runs.Using != "composite" || steps.Kind != yaml.SequenceNode || steps.Style&yaml.FlowStyle != 0 {
+		return nil, false
+	}
+
+	const header = 3
+	lines := strings.Split(string(content), "\n")
+	first := steps.Line - 1
+	if first < header || first >= len(lines) {
+		return nil, false
+	}
+	itemIndent := steps.Column - 1
+	last := len(lines)
+	for i := first + 1; i < len(lines); i++ {
+		trimmed := strings.TrimLeft(lines[i], " ")
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		indent := len(lines[i]) - len(trimmed)
+		if indent < itemIndent || (indent == itemIndent && !strings.HasPrefix(trimmed, "-")) {
+			last = i
+			break
+		}
+	}
+
+	var buf bytes.Buffer
+	for i := 0; i < first-header; i++ {
+		buf.WriteString("\n")
+	}
+	buf.WriteString("jobs:\n  composite-action:\n    steps:\n")
+	for _, line := range lines[first:last] {
+		buf.WriteString("      " + line + "\n")
+	}
+	workflow, errs := actionlint.Parse(buf.Bytes())
+	if len(errs) > 0 && workflow == nil {
+		return nil, false
+	}
+	return workflow, true
+}
@@ -0,0 +1,302 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/rhysd/actionlint"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+func Test_locateCall(t *testing.T) {
+	t.Parallel()
+	remote := workflowRef{repo: "org/shared", ref: "v1", path: ".github/workflows/build.yml"}
+	tests := []struct {
+		name   string
+		uses   string
+		from   workflowRef
+		want   workflowRef
+		kind   calledWorkflowKind
+		wantOK bool
+	}{
+		{
+			name:   "local action",
+			uses:   "./.github/actions/setup",
+			kind:   compositeAction,
+			from:   remote,
+			want:   workflowRef{path: ".github/actions/setup"},
+			wantOK: true,
+		},
+		{
+			name:   "local reusable workflow in remote repo",
+			uses:   "./.github/workflows/test.yml",
+			kind:   reusableWorkflow,
+			from:   remote,
+			want:   workflowRef{repo: "org/shared", ref: "v1", path: ".github/workflows/test.yml"},
+			wantOK: true,
+		},
+		{
+			name:   "remote action at repo root",
+			uses:   "actions/checkout@v4",
+			kind:   compositeAction,
+			want:   workflowRef{repo: "actions/checkout", ref: "v4", path: "."},
+			wantOK: true,
+		},
+		{
+			name:   "remote action in subdirectory",
+			uses:   "github/codeql-action/init@v3",
+			kind:   compositeAction,
+			want:   workflowRef{repo: "github/codeql-action", ref: "v3", path: "init"},
+			wantOK: true,
+		},
+		{
+			name: "docker action",
+			uses: "docker://alpine:3.20",
+			kind: compositeAction,
+		},
+		{
+			name: "missing ref",
+			uses: "actions/checkout",
+			kind: compositeAction,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := locateCall(tt.uses, tt.kind, tt.from)
+			if ok != tt.wantOK {
+				t.Fatalf("locateCall() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(workflowRef{})); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_parseCompositeAction(t *testing.T) {
+	t.Parallel()
+	content, err := os.ReadFile("testdata/workflow-calls/action.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	workflow, ok := parseCompositeAction(content)
+	if !ok {
+		t.Fatal("parseCompositeAction: not a composite action")
+	}
+	var lines []int
+	for _, job := range workflow.Jobs {
+		for _, step := range job.Steps {
+			lines = append(lines, step.Pos.Line)
+		}
+	}
+	// The step positions are those in the action file.
+	if diff := cmp.Diff([]int{10, 13, 17}, lines); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, ok := parseCompositeAction([]byte("name: x\ndescription: y\nruns:\n  using: node20\n  main: index.js\n")); ok {
+		t.Error("parseCompositeAction: JavaScript action parsed as composite")
+	}
+}
+
+func mockRepoFiles(ctrl *gomock.Controller, files map[string]string) *mockrepo.MockRepoClient {
+	mockRepo := mockrepo.NewMockRepoClient(ctrl)
+	mockRepo.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(file string) (io.ReadCloser, error) {
+		content, ok := files[file]
+		if !ok {
+			return nil, errors.New("file not found")
+		}
+		return io.NopCloser(strings.NewReader(content)), nil
+	}).AnyTimes()
+	return mockRepo
+}
+
+func readTestdata(t *testing.T, names map[string]string) map[string]string {
+	t.Helper()
+	files := make(map[string]string)
+	for name, testdata := range names {
+		content, err := os.ReadFile(testdata)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[name] = string(content)
+	}
+	return files
+}
+
+func newTestWorkflowCallResolver(t *testing.T) *workflowCallResolver {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	local := mockRepoFiles(ctrl, readTestdata(t, map[string]string{
+		".github/actions/setup/action.yml": "testdata/workflow-calls/action.yml",
+	}))
+	shared := mockRepoFiles(ctrl, readTestdata(t, map[string]string{
+		".github/workflows/build.yml": "testdata/workflow-calls/build.yml",
+	}))
+	return newWorkflowCallResolver(&checker.CheckRequest{
+		RepoClient: local,
+		RemoteRepoClient: func(repo, ref string) (clients.RepoClient, error) {
+			if repo == "org/shared" && ref == "v1" {
+				return shared, nil
+			}
+			return nil, errors.New("repo not found")
+		},
+	})
+}
+
+func Test_workflowCallResolver(t *testing.T) {
+	t.Parallel()
+	content, err := os.ReadFile("testdata/workflow-calls/ci.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	workflow, errs := actionlint.Parse(content)
+	if workflow == nil {
+		t.Fatal(errs)
+	}
+	resolver := newTestWorkflowCallResolver(t)
+	type call struct {
+		path  string
+		chain string
+	}
+	var got []call
+	for _, c := range resolver.resolve(workflow, ".github/workflows/ci.yml") {
+		got = append(got, call{path: c.path, chain: c.callChain()})
+	}
+	want := []call{
+		{path: ".github/actions/setup/action.yml", chain: ".github/workflows/ci.yml"},
+		{path: "org/shared/.github/workflows/build.yml@v1", chain: ".github/workflows/ci.yml"},
+		{
+			path:  ".github/actions/setup/action.yml",
+			chain: ".github/workflows/ci.yml -> org/shared/.github/workflows/build.yml@v1",
+		},
+	}
+	less := func(a, b call) bool { return a.path+a.chain < b.path+b.chain }
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(call{}), cmpopts.SortSlices(less)); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestWorkflowCallsPinning(t *testing.T) {
+	t.Parallel()
+	content, err := os.ReadFile("testdata/workflow-calls/ci.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var r checker.PinningDependenciesData
+	if _, err := validateGitHubActionWorkflow(".github/workflows/ci.yml", content, &r,
+		newTestWorkflowCallResolver(t)); err != nil {
+		t.Fatalf("validateGitHubActionWorkflow: %v", err)
+	}
+	unpinned := make(map[string]string)
+	for _, d := range r.Dependencies {
+		if !*d.Pinned {
+			unpinned[d.Location.Path+": "+d.Location.Snippet] = d.Location.CallChain
+		}
+	}
+	want := map[string]string{
+		".github/workflows/ci.yml: org/shared/.github/workflows/build.yml@v1": "",
+		".github/workflows/ci.yml: org/missing/.github/workflows/lint.yml@v1": "",
+		".github/actions/setup/action.yml: actions/checkout@v4": ".github/workflows/ci.yml -> " +
+			"org/shared/.github/workflows/build.yml@v1",
+		"org/shared/.github/workflows/build.yml@v1: some/deploy-action@main": ".github/workflows/ci.yml",
+	}
+	if diff := cmp.Diff(want, unpinned); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestWorkflowCallsDangerousWorkflow(t *testing.T) {
+	t.Parallel()
+	content, err := os.ReadFile("testdata/workflow-calls/ci.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var data checker.DangerousWorkflowData
+	if _, err := validateGitHubActionWorkflowPatterns(".github/workflows/ci.yml", content, &data,
+		newTestWorkflowCallResolver(t)); err != nil {
+		t.Fatalf("validateGitHubActionWorkflowPatterns: %v", err)
+	}
+	want := []checker.DangerousWorkflow{
+		{
+			Type: checker.DangerousWorkflowUntrustedCheckout,
+			File: checker.File{
+				Path:      ".github/actions/setup/action.yml",
+				Type:      finding.FileTypeSource,
+				Offset:    10,
+				Snippet:   "${{ github.event.pull_request.head.sha }}",
+				CallChain: ".github/workflows/ci.yml -> org/shared/.github/workflows/build.yml@v1",
+			},
+		},
+		{
+			Type: checker.DangerousWorkflowScriptInjection,
+			File: checker.File{
+				Path:      ".github/actions/setup/action.yml",
+				Type:      finding.FileTypeSource,
+				Offset:    17,
+				Snippet:   " github.event.pull_request.title ",
+				CallChain: ".github/workflows/ci.yml -> org/shared/.github/workflows/build.yml@v1",
+			},
+		},
+		{
+			Type: checker.DangerousWorkflowCachePoisoning,
+			File: checker.File{
+				Path:      ".github/actions/setup/action.yml",
+				Type:      finding.FileTypeSource,
+				Offset:    13,
+				Snippet:   "actions/cache@0c45773b623bea8c8e75f6c82b208c3cf94ea4f9",
+				CallChain: ".github/workflows/ci.yml -> org/shared/.github/workflows/build.yml@v1",
+			},
+		},
+	}
+	if diff := cmp.Diff(want, data.Workflows); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestWorkflowCallsPermissions(t *testing.T) {
+	t.Parallel()
+	content, err := os.ReadFile("testdata/workflow-calls/ci.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := permissionCbData{calls: newTestWorkflowCallResolver(t)}
+	if _, err := validateGitHubActionTokenPermissions(".github/workflows/ci.yml", content, &data); err != nil {
+		t.Fatalf("validateGitHubActionTokenPermissions: %v", err)
+	}
+	var writes []string
+	for _, p := range data.results.TokenPermissions {
+		if p.Type == checker.PermissionLevelWrite {
+			writes = append(writes, p.File.Path+": "+*p.Name+" via "+p.File.CallChain)
+		}
+	}
+	want := []string{"org/shared/.github/workflows/build.yml@v1: contents via .github/workflows/ci.yml"}
+	if diff := cmp.Diff(want, writes); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,375 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+// lockfileEcosystem describes how the dependencies declared in the
+// manifests of an ecosystem are locked.
+type lockfileEcosystem struct {
+	// hasDependencies reports whether a manifest declares dependencies to lock.
+	hasDependencies func(content []byte) bool
+	// integrity reports whether a lockfile records the integrity hashes of
+	// the packages it resolves.
+	integrity func(name string, content []byte) bool
+	typ       checker.DependencyUseType
+	manifests []string
+	// lockfiles are the names of the lockfiles, by order of preference.
+	lockfiles []string
+	// workspaces is whether the lockfile of a parent directory locks the
+	// manifests of its subdirectories.
+	workspaces bool
+}
+
+var (
+	reTOMLTable        = regexp.MustCompile(`(?m)^\[\[?`)
+	reCargoDeps        = regexp.MustCompile(`(?m)^\[(workspace\.)?(dev-|build-)?dependencies[.\]]`)
+	reGoRequire        = regexp.MustCompile(`(?m)^require\b`)
+	rePythonDeps       = regexp.MustCompile(`(?m)^(dependencies\s*=\s*\[\s*[^\]\s]|\[tool\.poetry\.(dev-)?dependencies\])`)
+	reGemfileDeps      = regexp.MustCompile(`(?m)^\s*(gem|gemspec)\b`)
+	reGradleDeps       = regexp.MustCompile(`(?m)^\s*dependencies\s*\{`)
+	reMavenDeps        = regexp.MustCompile(`<dependency>`)
+	reYarnEntry        = regexp.MustCompile(`(?m)^\s+(resolved|resolution):`)
+	reYarnIntegrity    = regexp.MustCompile(`(?m)^\s+(integrity|checksum):`)
+	rePnpmResolution   = regexp.MustCompile(`resolution: \{([^}]*)\}`)
+	reGradleChecksum   = regexp.MustCompile(`<sha(256|512) `)
+	reMavenLockfileSum = regexp.MustCompile(`"checksum"\s*:\s*"[0-9a-f]+`)
+)
+
+// gradleVerificationMetadata is the file in which Gradle records the
+// checksums of the dependencies of a build.
+const gradleVerificationMetadata = "gradle/verification-metadata.xml"
+
+var lockfileEcosystems = []lockfileEcosystem{
+	{
+		typ:             checker.DependencyUseTypeCargoManifest,
+		manifests:       []string{"Cargo.toml"},
+		lockfiles:       []string{"Cargo.lock"},
+		workspaces:      true,
+		hasDependencies: reCargoDeps.Match,
+		integrity: func(_ string, content []byte) bool {
+			// Packages from registries must have a checksum,
+			// packages from git are pinned to a commit.
+			return tomlPackagesHave(content, `source = "registry+`, "checksum = ")
+		},
+	},
+	{
+		typ:             checker.DependencyUseTypeNpmManifest,
+		manifests:       []string{"package.json"},
+		lockfiles:       []string{"package-lock.json", "npm-shrinkwrap.json", "yarn.lock", "pnpm-lock.yaml"},
+		workspaces:      true,
+		hasDependencies: packageJSONHasDependencies,
+		integrity:       npmLockfileIntegrity,
+	},
+	{
+		typ:             checker.DependencyUseTypePythonManifest,
+		manifests:       []string{"pyproject.toml"},
+		lockfiles:       []string{"poetry.lock", "uv.lock"},
+		workspaces:      true,
+		hasDependencies: rePythonDeps.Match,
+		integrity: func(name string, content []byte) bool {
+			if path.Base(name) == "uv.lock" {
+				return tomlPackagesHave(content, "source = { registry = ", "hash = ")
+			}
+			// poetry.lock lists the files of the packages from registries with their hashes.
+			return tomlPackagesHave(content, "files = [\n", "hash = ")
+		},
+	},
+	{
+		typ:             checker.DependencyUseTypeBundlerManifest,
+		manifests:       []string{"Gemfile"},
+		lockfiles:       []string{"Gemfile.lock"},
+		hasDependencies: reGemfileDeps.Match,
+		integrity: func(_ string, content []byte) bool {
+			// Bundler 2.6 records checksums in a CHECKSUMS section.
+			return bytes.Contains(content, []byte("\nCHECKSUMS\n"))
+		},
+	},
+	{
+		typ:             checker.DependencyUseTypeGoManifest,
+		manifests:       []string{"go.mod"},
+		lockfiles:       []string{"go.sum"},
+		hasDependencies: reGoRequire.Match,
+		integrity: func(_ string, content []byte) bool {
+			return len(bytes.TrimSpace(content)) > 0
+		},
+	},
+	{
+		typ:             checker.DependencyUseTypeGradleManifest,
+		manifests:       []string{"build.gradle", "build.gradle.kts"},
+		lockfiles:       []string{"gradle.lockfile", gradleVerificationMetadata},
+		workspaces:      true,
+		hasDependencies: reGradleDeps.Match,
+		integrity: func(name string, content []byte) bool {
+			return path.Base(name) == path.Base(gradleVerificationMetadata) && reGradleChecksum.Match(content)
+		},
+	},
+	{
+		typ:       checker.DependencyUseTypeMavenManifest,
+		manifests: []string{"pom.xml"},
+		// Maven has no lockfile of its own, the maven-lockfile plugin
+		// records the versions and checksums of the dependencies.
+		lockfiles:       []string{"lockfile.json"},
+		workspaces:      true,
+		hasDependencies: reMavenDeps.Match,
+		integrity: func(_ string, content []byte) bool {
+			return reMavenLockfileSum.Match(content)
+		},
+	},
+}
+
+// collectLockfilePinning reports, for each manifest declaring dependencies,
+// whether a lockfile locks them and records their integrity hashes.
+func collectLockfilePinning(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
+	files, err := c.RepoClient.ListFiles(func(string) (bool, error) { return true, nil })
+	if err != nil {
+		return fmt.Errorf("RepoClient.ListFiles: %w", err)
+	}
+	l := lockfileReader{c: c, files: make(map[string]bool, len(files)), contents: make(map[string][]byte)}
+	for _, f := range files {
+		l.files[f] = true
+	}
+	slices.Sort(files)
+	for _, f := range files {
+		if isVendored(f) || fileparser.IsTestdataFile(f) {
+			continue
+		}
+		for i := range lockfileEcosystems {
+			e := &lockfileEcosystems[i]
+			if !slices.Contains(e.manifests, path.Base(f)) {
+				continue
+			}
+			dep, err := l.lockManifest(e, f)
+			if err != nil {
+				return err
+			}
+			if dep != nil {
+				r.Dependencies = append(r.Dependencies, *dep)
+			}
+		}
+	}
+	return nil
+}
+
+func isVendored(p string) bool {
+	for _, dir := range strings.Split(path.Dir(p), "/") {
+		if dir == "node_modules" || dir == "vendor" {
+			return true
+		}
+	}
+	return false
+}
+
+type lockfileReader struct {
+	c        *checker.CheckRequest
+	files    map[string]bool
+	contents map[string][]byte
+}
+
+func (l *lockfileReader) read(name string) ([]byte, error) {
+	if content, ok := l.contents[name]; ok {
+		return content, nil
+	}
+	reader, err := l.c.RepoClient.GetFileReader(name)
+	if err != nil {
+		return nil, fmt.Errorf("RepoClient.GetFileReader: %w", err)
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", name, err)
+	}
+	l.contents[name] = content
+	return content, nil
+}
+
+// lockManifest returns the dependency use of a manifest, or nil if it
+// declares no dependencies.
+func (l *lockfileReader) lockManifest(e *lockfileEcosystem, manifest string) (*checker.Dependency, error) {
+	content, err := l.read(manifest)
+	if err != nil {
+		return nil, err
+	}
+	if !e.hasDependencies(content) {
+		return nil, nil //nolint:nilnil // the manifest has nothing to lock
+	}
+	dep := &checker.Dependency{
+		Name: asPointer(manifest),
+		Location: &checker.File{
+			Path:   manifest,
+			Type:   finding.FileTypeSource,
+			Offset: checker.OffsetDefault,
+		},
+		Pinned: asBoolPointer(false),
+		Type:   e.typ,
+	}
+	lockfile := l.findLockfile(e, path.Dir(manifest))
+	if lockfile == "" {
+		return dep, nil
+	}
+	lockContent, err := l.read(lockfile)
+	if err != nil {
+		return nil, err
+	}
+	dep.Lockfile = &checker.Lockfile{
+		Path:      lockfile,
+		Integrity: e.integrity(lockfile, lockContent),
+	}
+	// Gradle lockfiles have no hashes, the verification metadata has them.
+	if e.typ == checker.DependencyUseTypeGradleManifest && !dep.Lockfile.Integrity {
+		if metadata := l.findInParents(path.Dir(manifest), gradleVerificationMetadata); metadata != "" {
+			metadataContent, err := l.read(metadata)
+			if err != nil {
+				return nil, err
+			}
+			dep.Lockfile.Integrity = e.integrity(metadata, metadataContent)
+		}
+	}
+	dep.Pinned = asBoolPointer(dep.Lockfile.Integrity)
+	return dep, nil
+}
+
+// findLockfile returns the path of the lockfile closest to dir.
+func (l *lockfileReader) findLockfile(e *lockfileEcosystem, dir string) string {
+	for {
+		for _, name := range e.lockfiles {
+			if p := path.Join(dir, name); l.files[p] {
+				return p
+			}
+		}
+		if !e.workspaces || dir == "." || dir == "/" {
+			return ""
+		}
+		dir = path.Dir(dir)
+	}
+}
+
+// findInParents returns the path of the file with the given name in dir or
+// in the closest parent directory containing one.
+func (l *lockfileReader) findInParents(dir, name string) string {
+	for {
+		if p := path.Join(dir, name); l.files[p] {
+			return p
+		}
+		if dir == "." || dir == "/" {
+			return ""
+		}
+		dir = path.Dir(dir)
+	}
+}
+
+// tomlPackagesHave reports whether the [[package]] tables of a lockfile
+// which contain selector all contain key.
+func tomlPackagesHave(content []byte, selector, key string) bool {
+	tables := bytes.Split(content, []byte("\n[[package]]"))
+	for _, table := range tables[1:] {
+		// The table ends at the next table which isn't one of its subtables, e.g. [metadata].
+		for _, loc := range reTOMLTable.FindAllIndex(table, -1) {
+			if !bytes.HasPrefix(table[loc[1]:], []byte("package.")) {
+				table = table[:loc[0]]
+				break
+			}
+		}
+		if bytes.Contains(table, []byte(selector)) && !bytes.Contains(table, []byte(key)) {
+			return false
+		}
+	}
+	return true
+}
+
+func packageJSONHasDependencies(content []byte) bool {
+	var manifest map[string]json.RawMessage
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return false
+	}
+	for _, field := range []string{"dependencies", "devDependencies", "optionalDependencies"} {
+		var deps map[string]string
+		if err := json.Unmarshal(manifest[field], &deps); err == nil && len(deps) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+type npmLockfilePackage struct {
+	Dependencies map[string]npmLockfilePackage `json:"dependencies"`
+	Resolved     string                        `json:"resolved"`
+	Integrity    string                        `json:"integrity"`
+	Link         bool                          `json:"link"`
+}
+
+// npmLockfileIntegrity reports whether every package resolved from a
+// registry or tarball has an integrity hash.
+func npmLockfileIntegrity(name string, content []byte) bool {
+	switch path.Base(name) {
+	case "yarn.lock":
+		return len(reYarnIntegrity.FindAll(content, -1)) >= len(reYarnEntry.FindAll(content, -1))
+	case "pnpm-lock.yaml":
+		for _, m := range rePnpmResolution.FindAllSubmatch(content, -1) {
+			if !bytes.Contains(m[1], []byte("integrity:")) && !bytes.Contains(m[1], []byte("commit:")) &&
+				!bytes.Contains(m[1], []byte("directory:")) {
+				return false
+			}
+		}
+		return true
+	}
+	var lockfile struct {
+		Packages     map[string]npmLockfilePackage `json:"packages"`
+		Dependencies map[string]npmLockfilePackage `json:"dependencies"`
+	}
+	if err := json.Unmarshal(content, &lockfile); err != nil {
+		return false
+	}
+	for _, p := range lockfile.Packages {
+		if !npmPackageIntegrity(&p) {
+			return false
+		}
+	}
+	for _, p := range lockfile.Dependencies {
+		if !npmPackageIntegrity(&p) {
+			return false
+		}
+	}
+	return true
+}
+
+func npmPackageIntegrity(p *npmLockfilePackage) bool {
+	if p.Link {
+		return true
+	}
+	// git dependencies are resolved to a commit and have no integrity hash.
+	if p.Integrity == "" && (strings.HasPrefix(p.Resolved, "http://") || strings.HasPrefix(p.Resolved, "https://")) {
+		return false
+	}
+	for _, d := range p.Dependencies {
+		if !npmPackageIntegrity(&d) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,200 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ossf/scorecard/v5/checker"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+)
+
+const (
+	cargoManifest = "[package]\nname = \"a\"\n\n[dependencies]\nserde = \"1\"\n"
+	cargoLock     = `version = 3
+
+[[package]]
+name = "a"
+version = "0.1.0"
+
+[[package]]
+name = "serde"
+version = "1.0.0"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "abc"
+`
+	packageJSON = `{"name": "a", "dependencies": {"left-pad": "^1.0.0"}}`
+	packageLock = `{
+  "lockfileVersion": 3,
+  "packages": {
+    "": {"name": "a"},
+    "node_modules/left-pad": {
+      "version": "1.3.0",
+      "resolved": "https://registry.npmjs.org/left-pad/-/left-pad-1.3.0.tgz"
+    }
+  }
+}`
+	uvLock = `version = 1
+
+[[package]]
+name = "a"
+version = "0.1.0"
+source = { virtual = "." }
+
+[[package]]
+name = "requests"
+version = "2.0.0"
+source = { registry = "https://pypi.org/simple" }
+sdist = { url = "https://example.com/requests.tar.gz", hash = "sha256:abc" }
+`
+)
+
+func TestCollectLockfilePinning(t *testing.T) {
+	t.Parallel()
+	//nolint:govet
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  map[string]*checker.Lockfile
+	}{
+		{
+			name: "cargo workspace",
+			files: map[string]string{
+				"Cargo.toml":          "[workspace]\nmembers = [\"crates/a\"]\n",
+				"crates/a/Cargo.toml": cargoManifest,
+				"Cargo.lock":          cargoLock,
+			},
+			want: map[string]*checker.Lockfile{
+				"crates/a/Cargo.toml": {Path: "Cargo.lock", Integrity: true},
+			},
+		},
+		{
+			name: "cargo lockfile without checksums",
+			files: map[string]string{
+				"Cargo.toml": cargoManifest,
+				"Cargo.lock": strings.ReplaceAll(cargoLock, "checksum = \"abc\"\n", ""),
+			},
+			want: map[string]*checker.Lockfile{
+				"Cargo.toml": {Path: "Cargo.lock"},
+			},
+		},
+		{
+			name: "npm",
+			files: map[string]string{
+				"package.json":                       packageJSON,
+				"package-lock.json":                  packageLock,
+				"web/package.json":                   `{"name": "web", "dependencies": {"b": "1"}}`,
+				"web/yarn.lock":                      "b@1:\n  version \"1.0.0\"\n  resolved \"https://x/b.tgz\"\n  integrity sha512-x\n",
+				"tools/package.json":                 `{"name": "tools"}`,
+				"node_modules/left-pad/package.json": packageJSON,
+			},
+			want: map[string]*checker.Lockfile{
+				"package.json":     {Path: "package-lock.json"},
+				"web/package.json": {Path: "web/yarn.lock", Integrity: true},
+			},
+		},
+		{
+			name: "python, ruby and go",
+			files: map[string]string{
+				"pyproject.toml": "[project]\nname = \"a\"\ndependencies = [\n  \"requests\",\n]\n",
+				"uv.lock":        uvLock,
+				"Gemfile":        "source \"https://rubygems.org\"\ngem \"rails\"\n",
+				"Gemfile.lock":   "GEM\n  specs:\n    rails (7.0.0)\n\nCHECKSUMS\n  rails (7.0.0) sha256=abc\n",
+				"go.mod":         "module a\n\nrequire b v1.0.0\n",
+				"tools/go.mod":   "module tools\n\nrequire c v1.0.0\n",
+				"go.sum":         "b v1.0.0 h1:abc=\n",
+			},
+			want: map[string]*checker.Lockfile{
+				"pyproject.toml": {Path: "uv.lock", Integrity: true},
+				"Gemfile":        {Path: "Gemfile.lock", Integrity: true},
+				"go.mod":         {Path: "go.sum", Integrity: true},
+				"tools/go.mod":   nil,
+			},
+		},
+		{
+			name: "gradle and maven",
+			files: map[string]string{
+				"app/build.gradle.kts":             "dependencies {\n  implementation(\"a:b:1.0\")\n}\n",
+				"app/gradle.lockfile":              "a:b:1.0=runtimeClasspath\n",
+				"gradle/verification-metadata.xml": `<component group="a" name="b" version="1.0"><sha256 value="abc"/></component>`,
+				"lib/build.gradle":                 "dependencies {\n  implementation 'a:c:1.0'\n}\n",
+				"pom.xml":                          "<dependencies><dependency></dependency></dependencies>",
+			},
+			want: map[string]*checker.Lockfile{
+				"app/build.gradle.kts": {Path: "app/gradle.lockfile", Integrity: true},
+				"lib/build.gradle":     {Path: "gradle/verification-metadata.xml", Integrity: true},
+				"pom.xml":              nil,
+			},
+		},
+		{
+			name: "testdata and vendored manifests",
+			files: map[string]string{
+				"package.json":                     packageJSON,
+				"package-lock.json":                packageLock,
+				"testdata/app/package.json":        packageJSON,
+				"node_modules/a/package.json":      packageJSON,
+				"internal/testdata/Cargo.toml":     cargoManifest,
+				"vendor/example.com/b/go.mod":      "module b\n\nrequire c v1.0.0\n",
+				"fixtures/testdata/pyproject.toml": "[project]\ndependencies = [\"requests\"]\n",
+			},
+			want: map[string]*checker.Lockfile{
+				"package.json": {Path: "package-lock.json"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+			var files []string
+			for f := range tt.files {
+				files = append(files, f)
+			}
+			mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return(files, nil).AnyTimes()
+			mockRepoClient.EXPECT().GetFileReader(gomock.Any()).AnyTimes().DoAndReturn(
+				func(file string) (io.ReadCloser, error) {
+					content, ok := tt.files[file]
+					if !ok {
+						return nil, os.ErrNotExist
+					}
+					return io.NopCloser(strings.NewReader(content)), nil
+				})
+			req := checker.CheckRequest{RepoClient: mockRepoClient}
+
+			var r checker.PinningDependenciesData
+			if err := collectLockfilePinning(&req, &r); err != nil {
+				t.Fatal(err)
+			}
+			got := make(map[string]*checker.Lockfile)
+			for _, d := range r.Dependencies {
+				got[d.Location.Path] = d.Lockfile
+				pinned := d.Lockfile != nil && d.Lockfile.Integrity
+				if d.Pinned == nil || *d.Pinned != pinned {
+					t.Errorf("%s: Pinned = %v, want %v", d.Location.Path, d.Pinned, pinned)
+				}
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -46,19 +46,24 @@ var permissionsOfInterest = []permission{
 }
 
 type permissionCbData struct {
+	calls   *workflowCallResolver
 	results checker.TokenPermissionsData
 }
 
 // TokenPermissions runs Token-Permissions check.
 func TokenPermissions(c *checker.CheckRequest) (checker.TokenPermissionsData, error) {
 	// data is shared across all GitHub workflows.
-	var data permissionCbData
+	data := permissionCbData{calls: newWorkflowCallResolver(c)}
 
 	err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
 		Pattern:       ".github/workflows/*",
 		CaseSensitive: false,
 	}, validateGitHubActionTokenPermissions, &data)
+	if err != nil {
+		return data.results, err
+	}
 
+	err = collectAzurePipelinesTokenPermissions(c, &data.results)
 	return data.results, err
 }
 
@@ -91,6 +96,7 @@ var validateGitHubActionTokenPermissions fileparser.DoWhileTrueOnFileContent = f
 	if len(errs) > 0 && workflow == nil {
 		return false, fileparser.FormatActionlintError(errs)
 	}
+	n := len(pdata.results.TokenPermissions)
 
 	// 1. Top-level permission definitions.
 	//nolint:lll
@@ -109,6 +115,14 @@ var validateGitHubActionTokenPermissions fileparser.DoWhileTrueOnFileContent = f
 		return false, err
 	}
 
+	// Patches declaring the minimal permissions the workflow's jobs need.
+	addPermissionsRemediations(workflow, path, content, pdata.results.TokenPermissions[n:])
+
+	// 3. Permissions of the remote reusable workflows called by the workflow.
+	if err := validateCalledWorkflowPermissions(workflow, path, pdata); err != nil {
+		return false, err
+	}
+
 	// TODO(laurent): 2. Identify github actions that require write and add checks.
 
 	// TODO(laurent): 3. Read a few runs and ensures they have the same permissions.
@@ -265,9 +279,52 @@ func validatePermissions(permissions *actionlint.Permissions, permLoc checker.Pe
 	return nil
 }
 
+// A reusable workflow runs with the token of the calling job, which it can only
+// restrict further. The permissions it declares therefore apply to the calling job,
+// and are recorded as such.
+func validateCalledWorkflowPermissions(workflow *actionlint.Workflow, path string,
+	pdata *permissionCbData,
+) error {
+	for _, called := range pdata.calls.resolve(workflow, path) {
+		// Local reusable workflows are checked on their own.
+		if called.kind != reusableWorkflow || called.local || !pdata.calls.firstVisit(called.path) {
+			continue
+		}
+		n := len(pdata.results.TokenPermissions)
+		// Without top-level permissions, the called workflow inherits those of the caller.
+		if called.workflow.Permissions != nil {
+			if err := validatePermissions(called.workflow.Permissions, checker.PermissionLocationJob,
+				called.path, pdata, map[permission]bool{}); err != nil {
+				return err
+			}
+		}
+		ignoredPermissions := createIgnoredPermissions(called.workflow, called.path, pdata)
+		if err := validatejobLevelPermissions(called.workflow, called.path, pdata, ignoredPermissions); err != nil {
+			return err
+		}
+		for i := n; i < len(pdata.results.TokenPermissions); i++ {
+			if f := pdata.results.TokenPermissions[i].File; f != nil {
+				f.CallChain = called.callChain()
+			}
+		}
+	}
+	return nil
+}
+
+// isOnlyCalledWorkflow reports whether the workflow can only run when called by another.
+func isOnlyCalledWorkflow(workflow *actionlint.Workflow) bool {
+	return len(workflow.On) == 1 && workflow.On[0].EventName() == "workflow_call"
+}
+
 func validateTopLevelPermissions(workflow *actionlint.Workflow, path string,
 	pdata *permissionCbData,
 ) error {
+	// A reusable workflow without permissions inherits those of its caller,
+	// where they are checked.
+	if workflow.Permissions == nil && isOnlyCalledWorkflow(workflow) {
+		return nil
+	}
+
 	// Check if permissions are set explicitly.
 	if workflow.Permissions == nil {
 		permLoc := checker.PermissionLocationTop
@@ -295,6 +352,7 @@ func validatejobLevelPermissions(workflow *actionlint.Workflow, path string,
 	ignoredPermissions map[permission]bool,
 ) error {
 	for _, job := range workflow.Jobs {
+		n := len(pdata.results.TokenPermissions)
 		// Run-level permissions may be left undefined.
 		// For most workflows, no write permissions are needed,
 		// so only top-level read-only permissions need to be declared.
@@ -310,7 +368,7 @@ func validatejobLevelPermissions(workflow *actionlint.Workflow, path string,
 					LocationType: &permLoc,
 					Type:         checker.PermissionLevelUndeclared,
 					Msg:          github.StringPointer(fmt.Sprintf("no %s permission defined", permLoc)),
-					// TODO: Job
+					Job:          createJob(job),
 				})
 
 			continue
@@ -320,6 +378,9 @@ func validatejobLevelPermissions(workflow *actionlint.Workflow, path string,
 		if err != nil {
 			return err
 		}
+		for i := n; i < len(pdata.results.TokenPermissions); i++ {
+			pdata.results.TokenPermissions[i].Job = createJob(job)
+		}
 	}
 	return nil
 }
@@ -59,6 +59,11 @@ func PinningDependencies(c *checker.CheckRequest) (checker.PinningDependenciesDa
 		return checker.PinningDependenciesData{}, err
 	}
 
+	// Container images of compose files, Kubernetes manifests, Helm charts and devcontainers.
+	if err := collectContainerImagePinning(c, &results); err != nil {
+		return checker.PinningDependenciesData{}, err
+	}
+
 	// Docker downloads.
 	if err := collectDockerfileInsecureDownloads(c, &results); err != nil {
 		return checker.PinningDependenciesData{}, err
@@ -74,6 +79,21 @@ func PinningDependencies(c *checker.CheckRequest) (checker.PinningDependenciesDa
 		return checker.PinningDependenciesData{}, err
 	}
 
+	// Azure Pipelines.
+	if err := collectAzurePipelinesPinning(c, &results); err != nil {
+		return checker.PinningDependenciesData{}, err
+	}
+
+	// CircleCI, Jenkins and Buildkite pipelines.
+	if err := collectCIConfigPinning(c, &results); err != nil {
+		return checker.PinningDependenciesData{}, err
+	}
+
+	// Manifests and their lockfiles.
+	if err := collectLockfilePinning(c, &results); err != nil {
+		return checker.PinningDependenciesData{}, err
+	}
+
 	// Nuget Post Processing
 	if err := postProcessNugetDependencies(c, &results); err != nil {
 		return checker.PinningDependenciesData{}, err
@@ -442,11 +462,14 @@ func collectDockerfilePinning(c *checker.CheckRequest, r *checker.PinningDepende
 	return nil
 }
 
+// applyDockerfilePinningRemediations suggests digests for the unpinned
+// container images, from Dockerfiles and from the other files using images.
 func applyDockerfilePinningRemediations(d []checker.Dependency) {
 	for i := range d {
 		rr := &d[i]
-		if rr.Type == checker.DependencyUseTypeDockerfileContainerImage && !*rr.Pinned {
-			remediate := remediation.CreateDockerfilePinningRemediation(rr, remediation.CraneDigester{})
+		// Remediations only apply to the repository's own files.
+		if rr.Type.IsContainerImage() && !*rr.Pinned && rr.Remediation == nil && rr.Location.CallChain == "" {
+			remediate := remediation.CreateDockerfilePinningRemediation(rr, containerImageDigester)
 			rr.Remediation = remediate
 		}
 	}
@@ -713,7 +736,7 @@ func collectGitHubActionsWorkflowPinning(c *checker.CheckRequest, r *checker.Pin
 	err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
 		Pattern:       ".github/workflows/*",
 		CaseSensitive: true,
-	}, validateGitHubActionWorkflow, r)
+	}, validateGitHubActionWorkflow, r, newWorkflowCallResolver(c))
 	if err != nil {
 		return err
 	}
@@ -727,7 +750,8 @@ func collectGitHubActionsWorkflowPinning(c *checker.CheckRequest, r *checker.Pin
 func applyWorkflowPinningRemediations(rm *remediation.RemediationMetadata, d []checker.Dependency) {
 	for i := range d {
 		rr := &d[i]
-		if rr.Type == checker.DependencyUseTypeGHAction && !*rr.Pinned {
+		// Remediations only apply to the repository's own workflows.
+		if rr.Type == checker.DependencyUseTypeGHAction && !*rr.Pinned && rr.Location.CallChain == "" {
 			remediate := rm.CreateWorkflowPinningRemediation(rr.Location.Path)
 			rr.Remediation = remediate
 		}
@@ -735,7 +759,8 @@ func applyWorkflowPinningRemediations(rm *remediation.RemediationMetadata, d []c
 }
 
 // validateGitHubActionWorkflow checks if the workflow file contains unpinned actions. Returns true if the check
-// should continue executing after this file.
+// should continue executing after this file. An optional *workflowCallResolver argument extends the check
+// to the reusable workflows and composite actions called by the workflow.
 var validateGitHubActionWorkflow fileparser.DoWhileTrueOnFileContent = func(
 	pathfn string,
 	content []byte,
@@ -745,11 +770,20 @@ var validateGitHubActionWorkflow fileparser.DoWhileTrueOnFileContent = func(
 		return true, nil
 	}
 
-	if len(args) != 1 {
+	if len(args) != 1 && len(args) != 2 {
 		return false, fmt.Errorf(
-			"validateGitHubActionWorkflow requires exactly 1 arguments: got %v: %w", len(args), errInvalidArgLength)
+			"validateGitHubActionWorkflow requires 1 or 2 arguments: got %v: %w", len(args), errInvalidArgLength)
 	}
 	pdata := dataAsPinnedDependenciesPointer(args[0])
+	var resolver *workflowCallResolver
+	if len(args) == 2 {
+		var ok bool
+		resolver, ok = args[1].(*workflowCallResolver)
+		if !ok {
+			return false, fmt.Errorf(
+				"validateGitHubActionWorkflow expects arg[1] of type *workflowCallResolver: %w", errInvalidArgType)
+		}
+	}
 
 	if !fileparser.CheckFileContainsCommands(content, "#") {
 		return true, nil
@@ -762,11 +796,37 @@ var validateGitHubActionWorkflow fileparser.DoWhileTrueOnFileContent = func(
 		return false, fileparser.FormatActionlintError(errs)
 	}
 
+	if err := collectWorkflowActionDependencies(workflow, pathfn, pdata); err != nil {
+		return false, err
+	}
+
+	for _, called := range resolver.resolve(workflow, pathfn) {
+		// Local reusable workflows are checked on their own.
+		if (called.kind == reusableWorkflow && called.local) || !resolver.firstVisit(called.path) {
+			continue
+		}
+		n := len(pdata.Dependencies)
+		if err := collectWorkflowActionDependencies(called.workflow, called.path, pdata); err != nil {
+			return false, err
+		}
+		for i := n; i < len(pdata.Dependencies); i++ {
+			pdata.Dependencies[i].Location.CallChain = called.callChain()
+		}
+	}
+
+	return true, nil
+}
+
+func collectWorkflowActionDependencies(workflow *actionlint.Workflow, pathfn string,
+	pdata *checker.PinningDependenciesData,
+) error {
 	for jobName, job := range workflow.Jobs {
 		if len(fileparser.GetJobName(job)) > 0 {
 			jobName = fileparser.GetJobName(job)
 		}
 
+		collectWorkflowContainerImages(job, pathfn, pdata)
+
 		if job.WorkflowCall != nil && job.WorkflowCall.Uses != nil {
 			//nolint:lll
 			// Check whether this is an action defined in the same repo,
@@ -785,7 +845,7 @@ var validateGitHubActionWorkflow fileparser.DoWhileTrueOnFileContent = func(
 			execAction, ok := step.Exec.(*actionlint.ExecAction)
 			if !ok {
 				stepName := fileparser.GetStepName(step)
-				return false, sce.WithMessage(sce.ErrScorecardInternal,
+				return sce.WithMessage(sce.ErrScorecardInternal,
 					fmt.Sprintf("unable to parse step '%v' for job '%v'", jobName, stepName))
 			}
 
@@ -804,8 +864,7 @@ var validateGitHubActionWorkflow fileparser.DoWhileTrueOnFileContent = func(
 			pdata.Dependencies = append(pdata.Dependencies, dep)
 		}
 	}
-
-	return true, nil
+	return nil
 }
 
 func newGHActionDependency(uses, pathfn string, line int) checker.Dependency {
@@ -56,6 +56,8 @@ func SAST(c *checker.CheckRequest) (checker.SASTData, error) {
 		return data, err
 	}
 	data.Commits = commits
+	data.Alerts, data.AlertsAvailable = codeScanningAlerts(c.RepoClient)
+	data.AlertAge = c.SASTAlertAge
 
 	codeQLWorkflows, err := getSastUsesWorkflows(c, "^github/codeql-action/analyze$", checker.CodeQLWorkflow)
 	if err != nil {
@@ -93,9 +95,173 @@ func SAST(c *checker.CheckRequest) (checker.SASTData, error) {
 	}
 	data.Workflows = append(data.Workflows, hadolintWorkflows...)
 
+	for _, a := range sastActions {
+		workflows, err := getSastUsesWorkflows(c, a.usesRegex, a.tool)
+		if err != nil {
+			return data, err
+		}
+		data.Workflows = append(data.Workflows, workflows...)
+	}
+
+	for _, cf := range sastConfigFiles {
+		var workflows []checker.SASTWorkflow
+		err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+			Pattern:       cf.pattern,
+			CaseSensitive: false,
+		}, searchSASTConfig, &workflows, cf.patterns)
+		if err != nil {
+			return data, err
+		}
+		data.Workflows = append(data.Workflows, workflows...)
+	}
+
+	azureWorkflows, err := getAzurePipelinesSASTWorkflows(c)
+	if err != nil {
+		return data, err
+	}
+	data.Workflows = append(data.Workflows, azureWorkflows...)
+
 	return data, nil
 }
 
+// getAzurePipelinesSASTWorkflows searches the Azure Pipelines files, found by
+// their names and locations or as local templates, for SAST tools.
+func getAzurePipelinesSASTWorkflows(c *checker.CheckRequest) ([]checker.SASTWorkflow, error) {
+	pipelines, err := fileparser.ReadAzurePipelines(c)
+	if err != nil {
+		return nil, fmt.Errorf("reading Azure Pipelines: %w", err)
+	}
+	var workflows []checker.SASTWorkflow
+	for _, p := range pipelines {
+		content, err := readFile(c, p.Path)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := searchSASTConfig(p.Path, content, &workflows, sastCIPatterns); err != nil {
+			return nil, err
+		}
+	}
+	return workflows, nil
+}
+
+// sastActions are GitHub Actions running SAST tools that need no special handling.
+var sastActions = []struct {
+	usesRegex string
+	tool      checker.SASTWorkflowType
+}{
+	{usesRegex: "^(semgrep|returntocorp)/semgrep-action$", tool: checker.SemgrepWorkflow},
+	{usesRegex: "^securego/gosec$", tool: checker.GosecWorkflow},
+	{usesRegex: "^PyCQA/bandit-action$", tool: checker.BanditWorkflow},
+	{usesRegex: "^(cpp-linter/cpp-linter-action|ZedThree/clang-tidy-review)$", tool: checker.ClangTidyWorkflow},
+	{usesRegex: "^bearer/bearer-action$", tool: checker.BearerWorkflow},
+}
+
+// sastConfigPattern matches a SAST tool in the content of a configuration file.
+type sastConfigPattern struct {
+	re   *regexp.Regexp
+	tool checker.SASTWorkflowType
+}
+
+// sastCIPatterns match SAST tools in CI configuration. GitHub workflows,
+// GitLab CI, Azure Pipelines, CircleCI, Jenkins and Buildkite all embed shell
+// scripts and container images, so the same patterns apply to each of them.
+var sastCIPatterns = []sastConfigPattern{
+	// `semgrep ci` in a script, or the semgrep/semgrep container image
+	// recommended by the Semgrep docs.
+	{
+		re:   regexp.MustCompile(`\bsemgrep (ci|scan)\b|\b(semgrep|returntocorp)/semgrep([:@\s'"]|$)`),
+		tool: checker.SemgrepWorkflow,
+	},
+	{re: regexp.MustCompile(`(?m)(^|[\s'"])gosec\s`), tool: checker.GosecWorkflow},
+	{re: regexp.MustCompile(`(?m)(^|[\s'"])bandit\s+-r\b`), tool: checker.BanditWorkflow},
+	{re: regexp.MustCompile(`(?m)(^|[\s'"])brakeman\b`), tool: checker.BrakemanWorkflow},
+	{re: regexp.MustCompile(`(?m)(^|[\s'"])(run-)?clang-tidy(-\d+)?\s`), tool: checker.ClangTidyWorkflow},
+	// GitLab's SAST template, included as Jobs/SAST.gitlab-ci.yml or
+	// Security/SAST.gitlab-ci.yml, or the SAST CI/CD component.
+	{re: regexp.MustCompile(`/SAST\.gitlab-ci\.yml\b|components/sast/sast@`), tool: checker.GitLabSASTWorkflow},
+	// Azure Pipelines tasks.
+	{re: regexp.MustCompile(`task:\s*AdvancedSecurity-Codeql-Analyze@`), tool: checker.CodeQLWorkflow},
+	{re: regexp.MustCompile(`task:\s*Sonar(Qube|Cloud)Analyze@`), tool: checker.SonarWorkflow},
+	{re: regexp.MustCompile(`task:\s*SnykSecurityScan@`), tool: checker.SnykWorkflow},
+	{re: regexp.MustCompile(`task:\s*MicrosoftSecurityDevOps@`), tool: checker.MicrosoftSecurityDevOpsWorkflow},
+	// CircleCI orbs and Jenkins steps.
+	{re: regexp.MustCompile(`\bsonarsource/sonarcloud@|\bwithSonarQubeEnv\b`), tool: checker.SonarWorkflow},
+	{re: regexp.MustCompile(`\bsnyk/snyk@|\bsnykSecurity\s*\(`), tool: checker.SnykWorkflow},
+}
+
+// sastPreCommitHooks match SAST tools run as pre-commit hooks.
+var sastPreCommitHooks = []sastConfigPattern{
+	{re: regexp.MustCompile(`\bid:\s*semgrep\b`), tool: checker.SemgrepWorkflow},
+	{re: regexp.MustCompile(`\bid:\s*gosec\b`), tool: checker.GosecWorkflow},
+	{re: regexp.MustCompile(`\bid:\s*bandit\b`), tool: checker.BanditWorkflow},
+	{re: regexp.MustCompile(`\bid:\s*brakeman\b`), tool: checker.BrakemanWorkflow},
+	{re: regexp.MustCompile(`\bid:\s*clang-tidy\b`), tool: checker.ClangTidyWorkflow},
+}
+
+// sastBuildPlugins match SAST tools run as part of the build.
+var sastBuildPlugins = []sastConfigPattern{
+	{re: regexp.MustCompile(`spotbugs-maven-plugin|com\.github\.spotbugs`), tool: checker.SpotBugsWorkflow},
+}
+
+var sastConfigFiles = []struct {
+	pattern  string
+	patterns []sastConfigPattern
+}{
+	{pattern: ".github/workflows/*", patterns: sastCIPatterns},
+	{pattern: ".gitlab-ci.yml", patterns: sastCIPatterns},
+	{pattern: ".circleci/config.y*ml", patterns: sastCIPatterns},
+	{pattern: "Jenkinsfile*", patterns: sastCIPatterns},
+	{pattern: ".buildkite/*.y*ml", patterns: sastCIPatterns},
+	{pattern: ".pre-commit-config.y*ml", patterns: sastPreCommitHooks},
+	{pattern: "pom.xml", patterns: sastBuildPlugins},
+	{pattern: "build.gradle*", patterns: sastBuildPlugins},
+}
+
+// searchSASTConfig reports each tool matched in a file once, at the line of its first match.
+var searchSASTConfig fileparser.DoWhileTrueOnFileContent = func(path string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if len(args) != 2 {
+		return false, fmt.Errorf("searchSASTConfig requires exactly 2 arguments: %w", errInvalid)
+	}
+	workflows, ok := args[0].(*[]checker.SASTWorkflow)
+	if !ok {
+		return false, fmt.Errorf("searchSASTConfig expects arg[0] of type *[]checker.SASTWorkflow: %w", errInvalid)
+	}
+	patterns, ok := args[1].([]sastConfigPattern)
+	if !ok {
+		return false, fmt.Errorf("searchSASTConfig expects arg[1] of type []sastConfigPattern: %w", errInvalid)
+	}
+
+	for _, p := range patterns {
+		loc := p.re.FindIndex(content)
+		if loc == nil {
+			continue
+		}
+		*workflows = append(*workflows, checker.SASTWorkflow{
+			File: checker.File{
+				Path:   path,
+				Offset: uint(bytes.Count(content[:loc[0]], []byte("\n")) + 1),
+				Type:   finding.FileTypeSource,
+			},
+			Type: p.tool,
+		})
+	}
+	return true, nil
+}
+
+// codeScanningAlerts collects the open alerts raised by SAST tools. Listing them
+// usually requires elevated permissions, so failures leave the alerts
+// unavailable rather than failing the check.
+func codeScanningAlerts(c clients.RepoClient) ([]clients.CodeScanningAlert, bool) {
+	alerts, err := c.ListCodeScanningAlerts()
+	if err != nil {
+		return nil, false
+	}
+	return alerts, true
+}
+
 func sastToolInCheckRuns(c *checker.CheckRequest) ([]checker.SASTCommit, error) {
 	var sastCommits []checker.SASTCommit
 	commits, err := c.RepoClient.ListCommits()
@@ -279,6 +279,7 @@ func TestSAST(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
 			mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return(tt.files, nil).AnyTimes()
+			mockRepoClient.EXPECT().ListCodeScanningAlerts().Return(nil, clients.ErrUnsupportedFeature).AnyTimes()
 			mockRepoClient.EXPECT().ListCommits().DoAndReturn(func() ([]clients.Commit, error) {
 				return tt.commits, nil
 			})
@@ -300,3 +301,132 @@ func TestSAST(t *testing.T) {
 		})
 	}
 }
+
+func TestSASTConfigDetection(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		files    []string
+		expected []checker.SASTWorkflow
+	}{
+		{
+			name:  "Semgrep container in GitHub workflow",
+			files: []string{".github/workflows/github-semgrep-workflow.yaml"},
+			expected: []checker.SASTWorkflow{
+				{
+					Type: checker.SemgrepWorkflow,
+					File: checker.File{
+						Path:   ".github/workflows/github-semgrep-workflow.yaml",
+						Offset: 10,
+						Type:   finding.FileTypeSource,
+					},
+				},
+			},
+		},
+		{
+			name:  "GitLab SAST template and gosec job",
+			files: []string{"sast/.gitlab-ci.yml"},
+			expected: []checker.SASTWorkflow{
+				{
+					Type: checker.GosecWorkflow,
+					File: checker.File{
+						Path:   "sast/.gitlab-ci.yml",
+						Offset: 11,
+						Type:   finding.FileTypeSource,
+					},
+				},
+				{
+					Type: checker.GitLabSASTWorkflow,
+					File: checker.File{
+						Path:   "sast/.gitlab-ci.yml",
+						Offset: 5,
+						Type:   finding.FileTypeSource,
+					},
+				},
+			},
+		},
+		{
+			name:  "Azure Pipelines tasks",
+			files: []string{"sast/azure-pipelines.yml"},
+			expected: []checker.SASTWorkflow{
+				{
+					Type: checker.CodeQLWorkflow,
+					File: checker.File{
+						Path:   "sast/azure-pipelines.yml",
+						Offset: 11,
+						Type:   finding.FileTypeSource,
+					},
+				},
+				{
+					Type: checker.MicrosoftSecurityDevOpsWorkflow,
+					File: checker.File{
+						Path:   "sast/azure-pipelines.yml",
+						Offset: 12,
+						Type:   finding.FileTypeSource,
+					},
+				},
+			},
+		},
+		{
+			name:  "Bandit pre-commit hook",
+			files: []string{"sast/.pre-commit-config.yaml"},
+			expected: []checker.SASTWorkflow{
+				{
+					Type: checker.BanditWorkflow,
+					File: checker.File{
+						Path:   "sast/.pre-commit-config.yaml",
+						Offset: 9,
+						Type:   finding.FileTypeSource,
+					},
+				},
+			},
+		},
+		{
+			name:  "SpotBugs Gradle plugin",
+			files: []string{"sast/build.gradle.kts"},
+			expected: []checker.SASTWorkflow{
+				{
+					Type: checker.SpotBugsWorkflow,
+					File: checker.File{
+						Path:   "sast/build.gradle.kts",
+						Offset: 3,
+						Type:   finding.FileTypeSource,
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+			mockRepoClient.EXPECT().ListFiles(gomock.Any()).DoAndReturn(
+				func(predicate func(string) (bool, error)) ([]string, error) {
+					var files []string
+					for _, f := range tt.files {
+						if ok, err := predicate(f); err == nil && ok {
+							files = append(files, f)
+						}
+					}
+					return files, nil
+				}).AnyTimes()
+			mockRepoClient.EXPECT().ListCodeScanningAlerts().Return(nil, clients.ErrUnsupportedFeature).AnyTimes()
+			mockRepoClient.EXPECT().ListCommits().Return(nil, nil)
+			mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(file string) (io.ReadCloser, error) {
+				return os.Open("./testdata/" + file)
+			}).AnyTimes()
+			req := checker.CheckRequest{
+				RepoClient: mockRepoClient,
+			}
+			got, err := SAST(&req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.expected, got.Workflows); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+type secretScanner struct {
+	name string
+	url  string
+	desc string
+	// usesRegex matches the GitHub Action running the scanner, if any.
+	usesRegex string
+	// hookRegex matches the scanner's repository in a pre-commit config.
+	hookRegex *regexp.Regexp
+}
+
+var secretScanners = []secretScanner{
+	{
+		name:      "gitleaks",
+		url:       "https://github.com/gitleaks/gitleaks",
+		desc:      "Detects secrets like passwords, API keys, and tokens in git repos",
+		usesRegex: "^(gitleaks|zricethezav)/gitleaks-action$",
+		hookRegex: regexp.MustCompile(`(?i)github\.com/(gitleaks|zricethezav)/gitleaks`),
+	},
+	{
+		name:      "TruffleHog",
+		url:       "https://github.com/trufflesecurity/trufflehog",
+		desc:      "Finds, verifies, and analyzes leaked credentials",
+		usesRegex: "^trufflesecurity/trufflehog$",
+		hookRegex: regexp.MustCompile(`(?i)github\.com/trufflesecurity/trufflehog`),
+	},
+	{
+		name:      "detect-secrets",
+		url:       "https://github.com/Yelp/detect-secrets",
+		desc:      "An enterprise friendly way of detecting and preventing secrets in code",
+		hookRegex: regexp.MustCompile(`(?i)github\.com/Yelp/detect-secrets`),
+	},
+}
+
+// Matches both the legacy `Security/Secret-Detection.gitlab-ci.yml` template
+// and the `components/secret-detection` CI/CD component.
+var reGitLabSecretDetection = regexp.MustCompile(`(?i)secret[-_]detection`)
+
+// SecretScanning retrieves the raw data for the Secret-Scanning check.
+func SecretScanning(c *checker.CheckRequest) (checker.SecretScanningData, error) {
+	var data checker.SecretScanningData
+
+	settings, err := c.RepoClient.GetSecretScanning()
+	switch {
+	case err == nil:
+		data.Enabled = settings.Enabled
+		data.PushProtection = settings.PushProtection
+	case errors.Is(err, clients.ErrUnsupportedFeature):
+		// Fall back to the repository's own configuration below.
+	default:
+		return data, fmt.Errorf("RepoClient.GetSecretScanning: %w", err)
+	}
+
+	for i := range secretScanners {
+		tool, err := secretScannerFiles(c.RepoClient, &secretScanners[i])
+		if err != nil {
+			return data, err
+		}
+		if len(tool.Files) > 0 {
+			data.Tools = append(data.Tools, tool)
+		}
+	}
+
+	var ciFiles []string
+	err = fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+		Pattern:       ".gitlab-ci.yml",
+		CaseSensitive: false,
+	}, searchContentRegex, &ciFiles, reGitLabSecretDetection)
+	if err != nil {
+		return data, err
+	}
+	if len(ciFiles) > 0 {
+		data.Tools = append(data.Tools, checker.Tool{
+			Name:  "GitLab Secret Detection",
+			URL:   asPointer("https://docs.gitlab.com/user/application_security/secret_detection/"),
+			Desc:  asPointer("Scans the repository for secrets as part of GitLab CI/CD"),
+			Files: sourceFiles(ciFiles),
+		})
+	}
+
+	data.CommittedSecrets, err = committedSecrets(c.RepoClient)
+	if err != nil {
+		return data, err
+	}
+
+	return data, nil
+}
+
+func secretScannerFiles(c clients.RepoClient, s *secretScanner) (checker.Tool, error) {
+	tool := checker.Tool{
+		Name: s.name,
+		URL:  asPointer(s.url),
+		Desc: asPointer(s.desc),
+	}
+
+	var paths []string
+	if s.usesRegex != "" {
+		err := fileparser.OnMatchingFileContentDo(c, fileparser.PathMatcher{
+			Pattern:       ".github/workflows/*",
+			CaseSensitive: false,
+		}, searchGitHubActionWorkflowUseRegex, &paths, s.usesRegex)
+		if err != nil {
+			return tool, err
+		}
+	}
+
+	err := fileparser.OnMatchingFileContentDo(c, fileparser.PathMatcher{
+		Pattern:       ".pre-commit-config.y*ml",
+		CaseSensitive: false,
+	}, searchContentRegex, &paths, s.hookRegex)
+	if err != nil {
+		return tool, err
+	}
+
+	// A workflow running the same scanner in several steps is reported once.
+	slices.Sort(paths)
+	tool.Files = sourceFiles(slices.Compact(paths))
+	return tool, nil
+}
+
+var searchContentRegex fileparser.DoWhileTrueOnFileContent = func(path string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if len(args) != 2 {
+		return false, fmt.Errorf("searchContentRegex requires exactly 2 arguments: %w", errInvalid)
+	}
+	paths, ok := args[0].(*[]string)
+	if !ok {
+		return false, fmt.Errorf("searchContentRegex expects arg[0] of type *[]string: %w", errInvalid)
+	}
+	re, ok := args[1].(*regexp.Regexp)
+	if !ok {
+		return false, fmt.Errorf("searchContentRegex expects arg[1] of type *regexp.Regexp: %w", errInvalid)
+	}
+	if re.Match(content) {
+		*paths = append(*paths, path)
+	}
+	return true, nil
+}
+
+func sourceFiles(paths []string) []checker.File {
+	files := make([]checker.File, 0, len(paths))
+	for _, p := range paths {
+		files = append(files, checker.File{
+			Path:   p,
+			Offset: checker.OffsetDefault,
+			Type:   finding.FileTypeSource,
+		})
+	}
+	return files
+}
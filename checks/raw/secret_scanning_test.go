@@ -0,0 +1,160 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+	"github.com/ossf/scorecard/v5/finding"
+	scut "github.com/ossf/scorecard/v5/utests"
+)
+
+const gitleaksWorkflow = `
+name: gitleaks
+on: [push]
+jobs:
+  scan:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v4
+      - uses: gitleaks/gitleaks-action@v2
+      - uses: gitleaks/gitleaks-action@v2
+`
+
+const preCommitConfig = `
+repos:
+  - repo: https://github.com/Yelp/detect-secrets
+    rev: v1.5.0
+    hooks:
+      - id: detect-secrets
+`
+
+const gitlabCI = `
+include:
+  - template: Jobs/Secret-Detection.gitlab-ci.yml
+`
+
+func TestSecretScanning(t *testing.T) {
+	t.Parallel()
+	enabled := true
+	tests := []struct {
+		files     map[string]string
+		settings  clients.SecretScanning
+		clientErr error
+		name      string
+		want      checker.SecretScanningData
+		wantErr   bool
+	}{
+		{
+			name:     "forge settings only",
+			settings: clients.SecretScanning{Enabled: &enabled, PushProtection: &enabled},
+			want:     checker.SecretScanningData{Enabled: &enabled, PushProtection: &enabled},
+		},
+		{
+			name:      "unsupported client, nothing configured",
+			clientErr: clients.ErrUnsupportedFeature,
+			want:      checker.SecretScanningData{},
+		},
+		{
+			name:      "client error",
+			clientErr: errors.New("some error"),
+			wantErr:   true,
+		},
+		{
+			name:      "tools configured in repository",
+			clientErr: clients.ErrUnsupportedFeature,
+			files: map[string]string{
+				".github/workflows/gitleaks.yml": gitleaksWorkflow,
+				".pre-commit-config.yaml":        preCommitConfig,
+				".gitlab-ci.yml":                 gitlabCI,
+				"main.go":                        "package main",
+			},
+			want: checker.SecretScanningData{
+				Tools: []checker.Tool{
+					{
+						Name: "gitleaks",
+						URL:  asPointer("https://github.com/gitleaks/gitleaks"),
+						Desc: asPointer("Detects secrets like passwords, API keys, and tokens in git repos"),
+						Files: []checker.File{
+							{Path: ".github/workflows/gitleaks.yml", Offset: checker.OffsetDefault, Type: finding.FileTypeSource},
+						},
+					},
+					{
+						Name: "detect-secrets",
+						URL:  asPointer("https://github.com/Yelp/detect-secrets"),
+						Desc: asPointer("An enterprise friendly way of detecting and preventing secrets in code"),
+						Files: []checker.File{
+							{Path: ".pre-commit-config.yaml", Offset: checker.OffsetDefault, Type: finding.FileTypeSource},
+						},
+					},
+					{
+						Name: "GitLab Secret Detection",
+						URL:  asPointer("https://docs.gitlab.com/user/application_security/secret_detection/"),
+						Desc: asPointer("Scans the repository for secrets as part of GitLab CI/CD"),
+						Files: []checker.File{
+							{Path: ".gitlab-ci.yml", Offset: checker.OffsetDefault, Type: finding.FileTypeSource},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockRepo := mockrepo.NewMockRepoClient(ctrl)
+			mockRepo.EXPECT().GetSecretScanning().Return(tt.settings, tt.clientErr)
+			mockRepo.EXPECT().ListFiles(gomock.Any()).DoAndReturn(
+				func(predicate func(string) (bool, error)) ([]string, error) {
+					var files []string
+					for f := range tt.files {
+						if ok, err := predicate(f); err == nil && ok {
+							files = append(files, f)
+						}
+					}
+					return files, nil
+				}).AnyTimes()
+			mockRepo.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(file string) (io.ReadCloser, error) {
+				return io.NopCloser(strings.NewReader(tt.files[file])), nil
+			}).AnyTimes()
+
+			req := checker.CheckRequest{
+				RepoClient: mockRepo,
+				Ctx:        t.Context(),
+				Dlogger:    &scut.TestDetailLogger{},
+			}
+			got, err := SecretScanning(&req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SecretScanning() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -55,7 +55,10 @@ func SecurityPolicy(c *checker.CheckRequest) (checker.SecurityPolicyData, error)
 				return checker.SecurityPolicyData{}, err
 			}
 		}
-		return checker.SecurityPolicyData{PolicyFiles: data.files}, nil
+		return checker.SecurityPolicyData{
+			PolicyFiles:            data.files,
+			VulnerabilityReporting: vulnerabilityReporting(c.RepoClient),
+		}, nil
 	}
 
 	// Check if present in parent org.
@@ -95,7 +98,25 @@ func SecurityPolicy(c *checker.CheckRequest) (checker.SecurityPolicyData, error)
 			}
 		}
 	}
-	return checker.SecurityPolicyData{PolicyFiles: data.files}, nil
+	return checker.SecurityPolicyData{
+		PolicyFiles:            data.files,
+		VulnerabilityReporting: vulnerabilityReporting(c.RepoClient),
+	}, nil
+}
+
+// vulnerabilityReporting collects the forge settings for receiving vulnerability
+// reports. Not every forge (or token) exposes them, so failures leave the
+// corresponding data unavailable rather than failing the check.
+func vulnerabilityReporting(c clients.RepoClient) checker.VulnerabilityReportingData {
+	var ret checker.VulnerabilityReportingData
+	if enabled, err := c.IsPrivateVulnerabilityReportingEnabled(); err == nil {
+		ret.PrivateReportingEnabled = &enabled
+	}
+	if advisories, err := c.ListSecurityAdvisories(); err == nil {
+		ret.Advisories = advisories
+		ret.AdvisoriesAvailable = true
+	}
+	return ret
 }
 
 // Check repository for repository-specific policy.
@@ -15,14 +15,17 @@
 package raw
 
 import (
+	"errors"
 	"io"
 	"os"
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"go.uber.org/mock/gomock"
 
 	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
 	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
 	scut "github.com/ossf/scorecard/v5/utests"
 )
@@ -137,6 +140,9 @@ func TestSecurityPolicy(t *testing.T) {
 			mockRepo := mockrepo.NewMockRepo(ctrl)
 
 			mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return(tt.files, nil).AnyTimes()
+			mockRepoClient.EXPECT().IsPrivateVulnerabilityReportingEnabled().
+				Return(false, clients.ErrUnsupportedFeature).AnyTimes()
+			mockRepoClient.EXPECT().ListSecurityAdvisories().Return(nil, clients.ErrUnsupportedFeature).AnyTimes()
 			// the revised Security Policy will immediate go for the
 			// file contents once found. This test will return that
 			// mock file, but this specific unit test is not testing
@@ -174,6 +180,61 @@ func TestSecurityPolicy(t *testing.T) {
 	}
 }
 
+func Test_vulnerabilityReporting(t *testing.T) {
+	t.Parallel()
+	enabled := true
+	advisories := []clients.SecurityAdvisory{
+		{ID: "GHSA-abcd-1234-efgh", CVEID: "CVE-2025-12345"},
+	}
+	tests := []struct {
+		reportingErr  error
+		advisoriesErr error
+		name          string
+		advisories    []clients.SecurityAdvisory
+		want          checker.VulnerabilityReportingData
+		reporting     bool
+	}{
+		{
+			name:       "settings available",
+			reporting:  true,
+			advisories: advisories,
+			want: checker.VulnerabilityReportingData{
+				PrivateReportingEnabled: &enabled,
+				Advisories:              advisories,
+				AdvisoriesAvailable:     true,
+			},
+		},
+		{
+			name:          "unsupported forge",
+			reportingErr:  clients.ErrUnsupportedFeature,
+			advisoriesErr: clients.ErrUnsupportedFeature,
+			want:          checker.VulnerabilityReportingData{},
+		},
+		{
+			name:          "no advisories permission",
+			reporting:     true,
+			advisoriesErr: errors.New("403 Forbidden"),
+			want: checker.VulnerabilityReportingData{
+				PrivateReportingEnabled: &enabled,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+			mockRepoClient.EXPECT().IsPrivateVulnerabilityReportingEnabled().Return(tt.reporting, tt.reportingErr)
+			mockRepoClient.EXPECT().ListSecurityAdvisories().Return(tt.advisories, tt.advisoriesErr)
+
+			got := vulnerabilityReporting(mockRepoClient)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 // Test_collectPolicyHits tests the regexes in collectPolicyHits for positive and negative cases.
 func Test_collectPolicyHits(t *testing.T) {
 	t.Parallel()
@@ -0,0 +1,4 @@
+plugins {
+    java
+    id("com.github.spotbugs") version "6.0.18"
+}
@@ -0,0 +1,453 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/rhysd/actionlint"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+const (
+	permissionLevelRead  = "read"
+	permissionLevelWrite = "write"
+)
+
+// requiredPermissions maps GITHUB_TOKEN permission scopes to the level
+// needed, "read" or "write". A nil value stands for read-all.
+type requiredPermissions map[string]string
+
+func (r requiredPermissions) add(scope, level string) {
+	if r[scope] != permissionLevelWrite {
+		r[scope] = level
+	}
+}
+
+func (r requiredPermissions) merge(other requiredPermissions) {
+	for scope, level := range other {
+		r.add(scope, level)
+	}
+}
+
+// String formats the permissions like a flow-style permissions: block.
+func (r requiredPermissions) String() string {
+	if r == nil {
+		return "read-all"
+	}
+	if len(r) == 0 {
+		return "{}"
+	}
+	scopes := make([]string, 0, len(r))
+	for _, scope := range slices.Sorted(maps.Keys(r)) {
+		scopes = append(scopes, scope+": "+r[scope])
+	}
+	return strings.Join(scopes, ", ")
+}
+
+// actionPermissions holds the GITHUB_TOKEN permissions needed by commonly
+// used actions with their default inputs, keyed by "owner/repo" or
+// "owner/repo/path". Listed actions with no entries don't use the token.
+// Many actions use the token implicitly through their default inputs, so the
+// permissions of jobs using actions which aren't listed can't be inferred.
+var actionPermissions = map[string]requiredPermissions{
+	"actions/checkout":                  {"contents": permissionLevelRead},
+	"actions/cache":                     {},
+	"actions/upload-artifact":           {},
+	"actions/download-artifact":         {},
+	"actions/setup-go":                  {},
+	"actions/setup-java":                {},
+	"actions/setup-node":                {},
+	"actions/setup-python":              {},
+	"actions/setup-dotnet":              {},
+	"actions/configure-pages":           {"pages": permissionLevelRead},
+	"actions/upload-pages-artifact":     {},
+	"actions/deploy-pages":              {"pages": permissionLevelWrite, "id-token": permissionLevelWrite},
+	"actions/labeler":                   {"contents": permissionLevelRead, "pull-requests": permissionLevelWrite},
+	"actions/stale":                     {"issues": permissionLevelWrite, "pull-requests": permissionLevelWrite},
+	"actions/dependency-review-action":  {"contents": permissionLevelRead},
+	"actions/attest-build-provenance":   {"id-token": permissionLevelWrite, "attestations": permissionLevelWrite},
+	"actions/attest-sbom":               {"id-token": permissionLevelWrite, "attestations": permissionLevelWrite},
+	"github/codeql-action/init":         {},
+	"github/codeql-action/autobuild":    {},
+	"github/codeql-action/analyze":      {"security-events": permissionLevelWrite},
+	"github/codeql-action/upload-sarif": {"security-events": permissionLevelWrite},
+	"ossf/scorecard-action":             {"security-events": permissionLevelWrite, "id-token": permissionLevelWrite},
+	"codecov/codecov-action":            {},
+	"docker/setup-buildx-action":        {},
+	"docker/setup-qemu-action":          {},
+	"docker/metadata-action":            {},
+	"docker/build-push-action":          {},
+	"golangci/golangci-lint-action":     {},
+	"sigstore/cosign-installer":         {},
+	"step-security/harden-runner":       {},
+	"softprops/action-gh-release":       {"contents": permissionLevelWrite},
+	"goreleaser/goreleaser-action":      {"contents": permissionLevelWrite},
+	"peter-evans/create-pull-request": {
+		"contents": permissionLevelWrite, "pull-requests": permissionLevelWrite,
+	},
+	"release-drafter/release-drafter": {
+		"contents": permissionLevelWrite, "pull-requests": permissionLevelWrite,
+	},
+	"stefanzweifel/git-auto-commit-action":   {"contents": permissionLevelWrite},
+	"google-github-actions/auth":             {"id-token": permissionLevelWrite},
+	"aws-actions/configure-aws-credentials":  {"id-token": permissionLevelWrite},
+	"azure/login":                            {"id-token": permissionLevelWrite},
+	"amannn/action-semantic-pull-request":    {"pull-requests": permissionLevelRead},
+	"dorny/paths-filter":                     {"contents": permissionLevelRead, "pull-requests": permissionLevelRead},
+	"dependabot/fetch-metadata":              {"pull-requests": permissionLevelRead},
+	"marocchino/sticky-pull-request-comment": {"pull-requests": permissionLevelWrite},
+}
+
+var (
+	reGitHubScriptCall = regexp.MustCompile(`\bgithub\.(?:rest\.)?([a-zA-Z]+)\.([a-zA-Z]+)\(`)
+	reGitHubCLI        = regexp.MustCompile(`\bgh\s+([a-z]+)(?:\s+([a-z-]+))?`)
+	reGitPush          = regexp.MustCompile(`\bgit\s+push\b`)
+	reReadOnlyMethod   = regexp.MustCompile(`^(?:get|list|check|compare|download)`)
+)
+
+// Scopes of the REST API namespaces of actions/github-script's github object.
+var apiNamespaceScopes = map[string]string{
+	"actions":      "actions",
+	"checks":       "checks",
+	"codeScanning": "security-events",
+	"git":          "contents",
+	"issues":       "issues",
+	"packages":     "packages",
+	"pulls":        "pull-requests",
+	"repos":        "contents",
+}
+
+// Scopes of repos methods which don't need the contents scope.
+var reposMethodScopes = map[string]string{
+	"createCommitStatus":     "statuses",
+	"createDeployment":       "deployments",
+	"createDeploymentStatus": "deployments",
+}
+
+// Scopes of gh subcommands, and their read-only commands.
+var cliScopes = map[string]struct {
+	scope    string
+	readOnly []string
+}{
+	"cache":    {scope: "actions", readOnly: []string{"list"}},
+	"issue":    {scope: "issues", readOnly: []string{"list", "status", "view"}},
+	"label":    {scope: "issues", readOnly: []string{"list"}},
+	"pr":       {scope: "pull-requests", readOnly: []string{"checks", "diff", "list", "status", "view"}},
+	"release":  {scope: "contents", readOnly: []string{"download", "list", "view"}},
+	"run":      {scope: "actions", readOnly: []string{"download", "list", "view", "watch"}},
+	"workflow": {scope: "actions", readOnly: []string{"list", "view"}},
+}
+
+// jobRequiredPermissions infers the minimal GITHUB_TOKEN permissions a job
+// needs from the actions, API calls and gh commands in its steps. It returns
+// false when they can't be inferred, e.g. for jobs calling reusable workflows,
+// local actions or actions which aren't in actionPermissions.
+func jobRequiredPermissions(job *actionlint.Job) (requiredPermissions, bool) {
+	if job == nil || job.WorkflowCall != nil {
+		return nil, false
+	}
+	required := requiredPermissions{}
+	for _, step := range job.Steps {
+		if step == nil {
+			continue
+		}
+		var ok bool
+		switch exec := step.Exec.(type) {
+		case *actionlint.ExecAction:
+			ok = addActionPermissions(exec, required)
+		case *actionlint.ExecRun:
+			ok = exec.Run == nil || addScriptPermissions(exec.Run.Value, required)
+		default:
+			ok = true
+		}
+		if !ok {
+			return nil, false
+		}
+	}
+	return required, true
+}
+
+func addActionPermissions(exec *actionlint.ExecAction, required requiredPermissions) bool {
+	if exec.Uses == nil {
+		return true
+	}
+	uses := exec.Uses.Value
+	if strings.HasPrefix(uses, "docker://") {
+		return true
+	}
+	if strings.HasPrefix(uses, "./") {
+		return false
+	}
+	action, _, _ := strings.Cut(strings.ToLower(uses), "@")
+	if action == "actions/github-script" {
+		script, ok := exec.Inputs["script"]
+		return ok && script.Value != nil && addGitHubScriptPermissions(script.Value.Value, required)
+	}
+	// Actions in subdirectories may have their own entry, e.g. github/codeql-action/analyze.
+	perms, ok := actionPermissions[action]
+	if !ok {
+		owner, rest, _ := strings.Cut(action, "/")
+		repo, _, _ := strings.Cut(rest, "/")
+		perms, ok = actionPermissions[owner+"/"+repo]
+	}
+	if !ok {
+		return false
+	}
+	required.merge(perms)
+	return true
+}
+
+func addGitHubScriptPermissions(script string, required requiredPermissions) bool {
+	if strings.Contains(script, "github.graphql") || strings.Contains(script, "github.request") {
+		return false
+	}
+	for _, m := range reGitHubScriptCall.FindAllStringSubmatch(script, -1) {
+		namespace, method := m[1], m[2]
+		scope, ok := apiNamespaceScopes[namespace]
+		if !ok {
+			return false
+		}
+		if namespace == "repos" {
+			if s, ok := reposMethodScopes[method]; ok {
+				scope = s
+			}
+		}
+		required.add(scope, methodLevel(reReadOnlyMethod.MatchString(method)))
+	}
+	return true
+}
+
+func addScriptPermissions(script string, required requiredPermissions) bool {
+	if reGitPush.MatchString(script) {
+		required.add("contents", permissionLevelWrite)
+	}
+	for _, m := range reGitHubCLI.FindAllStringSubmatch(script, -1) {
+		command, sub := m[1], m[2]
+		cli, ok := cliScopes[command]
+		if !ok {
+			// E.g. gh api, whose permissions depend on the endpoint.
+			return false
+		}
+		required.add(cli.scope, methodLevel(slices.Contains(cli.readOnly, sub)))
+		if command == "pr" && sub == "merge" {
+			required.add("contents", permissionLevelWrite)
+		}
+	}
+	return true
+}
+
+func methodLevel(readOnly bool) string {
+	if readOnly {
+		return permissionLevelRead
+	}
+	return permissionLevelWrite
+}
+
+// addPermissionsRemediations sets a patch declaring the minimal permissions
+// on the results of the workflow at path which declare write permissions
+// or none at all.
+func addPermissionsRemediations(workflow *actionlint.Workflow, path string, content []byte,
+	results []checker.TokenPermission,
+) {
+	lines := strings.Split(string(content), "\n")
+	patches := make(map[string]*finding.Remediation)
+	for i := range results {
+		r := &results[i]
+		if r.File == nil || r.File.CallChain != "" || r.LocationType == nil ||
+			(r.Type != checker.PermissionLevelWrite && r.Type != checker.PermissionLevelUndeclared) {
+			continue
+		}
+		var key string
+		switch {
+		case *r.LocationType == checker.PermissionLocationTop:
+			key = ""
+		case *r.LocationType == checker.PermissionLocationJob && r.Job != nil && r.Job.ID != nil:
+			key = strings.ToLower(*r.Job.ID)
+		default:
+			continue
+		}
+		remediation, ok := patches[key]
+		if !ok {
+			remediation = permissionsRemediation(workflow, key, path, lines)
+			patches[key] = remediation
+		}
+		r.Remediation = remediation
+	}
+}
+
+// topLevelRequiredPermissions returns the scopes needed by the jobs of the
+// workflow which don't declare their own permissions, as they get the
+// top-level ones, all set to read: write scopes are granted by the patches of
+// the jobs needing them. It returns false if the permissions of one of the
+// jobs can't be inferred.
+func topLevelRequiredPermissions(workflow *actionlint.Workflow) (requiredPermissions, bool) {
+	required := requiredPermissions{}
+	for _, job := range workflow.Jobs {
+		if job != nil && job.Permissions != nil {
+			continue
+		}
+		perms, ok := jobRequiredPermissions(job)
+		if !ok {
+			return nil, false
+		}
+		required.merge(perms)
+	}
+	for scope := range required {
+		required[scope] = permissionLevelRead
+	}
+	return required, true
+}
+
+// permissionsRemediation returns a patch declaring the permissions of the job
+// with the given ID, or the read-only top-level permissions if the ID is
+// empty. Top-level permissions are set to read-all if the needs of the jobs
+// can't be inferred. It returns nil if the patch can't be generated.
+func permissionsRemediation(workflow *actionlint.Workflow, jobID, path string,
+	lines []string,
+) *finding.Remediation {
+	var required requiredPermissions
+	var permissions *actionlint.Permissions
+	var insertAt, indent int
+	if jobID == "" {
+		var ok bool
+		if required, ok = topLevelRequiredPermissions(workflow); !ok {
+			required = nil
+		}
+		permissions = workflow.Permissions
+		insertAt = slices.IndexFunc(lines, func(l string) bool { return strings.HasPrefix(l, "jobs:") })
+	} else {
+		job := workflow.Jobs[jobID]
+		var ok bool
+		if required, ok = jobRequiredPermissions(job); !ok || job.Pos == nil {
+			return nil
+		}
+		permissions = job.Permissions
+		// New permissions go right after the line with the job ID.
+		insertAt = job.Pos.Line
+		indent = nextIndent(lines, insertAt)
+	}
+	start, end := insertAt, insertAt
+	if permissions != nil && permissions.Pos != nil {
+		start = permissions.Pos.Line - 1
+		indent = permissions.Pos.Col - 1
+		end = blockEnd(lines, start)
+	}
+	if start < 0 || start > len(lines) || indent < 0 {
+		return nil
+	}
+	block := permissionsBlock(required, strings.Repeat(" ", indent), indentUnit(lines))
+	if jobID == "" && permissions == nil {
+		block = append(block, "")
+	}
+	patch := unifiedDiff(path, lines, start, end, block)
+	return &finding.Remediation{
+		Text:  fmt.Sprintf("set permissions to %s", required),
+		Patch: &patch,
+	}
+}
+
+func permissionsBlock(required requiredPermissions, indent, unit string) []string {
+	if len(required) == 0 {
+		return []string{indent + "permissions: " + required.String()}
+	}
+	block := []string{indent + "permissions:"}
+	for _, scope := range slices.Sorted(maps.Keys(required)) {
+		block = append(block, indent+unit+scope+": "+required[scope])
+	}
+	return block
+}
+
+func lineIndent(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+func isBlankOrComment(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return trimmed == "" || strings.HasPrefix(trimmed, "#")
+}
+
+// nextIndent returns the indentation of the first line from index i with content.
+func nextIndent(lines []string, i int) int {
+	for ; i < len(lines); i++ {
+		if !isBlankOrComment(lines[i]) {
+			return lineIndent(lines[i])
+		}
+	}
+	return -1
+}
+
+// blockEnd returns the index following the last line of the YAML block
+// whose key is at index start.
+func blockEnd(lines []string, start int) int {
+	end := start + 1
+	for i := start + 1; i < len(lines); i++ {
+		if isBlankOrComment(lines[i]) {
+			continue
+		}
+		if lineIndent(lines[i]) <= lineIndent(lines[start]) {
+			break
+		}
+		end = i + 1
+	}
+	return end
+}
+
+// indentUnit returns the indentation used by the workflow, taken from the
+// first indented line, defaulting to two spaces.
+func indentUnit(lines []string) string {
+	for _, l := range lines {
+		if n := lineIndent(l); n > 0 && !isBlankOrComment(l) {
+			return strings.Repeat(" ", n)
+		}
+	}
+	return "  "
+}
+
+// unifiedDiff returns a single-hunk unified diff replacing lines[start:end]
+// with replacement.
+func unifiedDiff(path string, lines []string, start, end int, replacement []string) string {
+	const context = 3
+	from := max(0, start-context)
+	to := min(len(lines), end+context)
+	// A trailing newline splits into an empty last line, which isn't a line of the file.
+	if to == len(lines) && to > end && lines[to-1] == "" {
+		to--
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- a/%s\n+++ b/%s\n", path, path)
+	oldLen := to - from
+	newLen := oldLen - (end - start) + len(replacement)
+	fmt.Fprintf(&b, "@@ -%d,%d +%d,%d @@\n", from+1, oldLen, from+1, newLen)
+	for _, l := range lines[from:start] {
+		b.WriteString(" " + l + "\n")
+	}
+	for _, l := range lines[start:end] {
+		b.WriteString("-" + l + "\n")
+	}
+	for _, l := range replacement {
+		b.WriteString("+" + l + "\n")
+	}
+	for _, l := range lines[end:to] {
+		b.WriteString(" " + l + "\n")
+	}
+	return b.String()
+}
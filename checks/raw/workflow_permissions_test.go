@@ -0,0 +1,288 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/rhysd/actionlint"
+
+	"github.com/ossf/scorecard/v5/checker"
+)
+
+func Test_jobRequiredPermissions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		steps string
+		want  string
+		ok    bool
+	}{
+		{
+			name:  "no token use",
+			steps: "      - run: make test\n",
+			want:  "{}",
+			ok:    true,
+		},
+		{
+			name: "checkout and release",
+			steps: "      - uses: actions/checkout@v4\n" +
+				"      - uses: softprops/action-gh-release@v2\n",
+			want: "contents: write",
+			ok:   true,
+		},
+		{
+			name: "action in a subdirectory",
+			steps: "      - uses: actions/checkout@v4\n" +
+				"      - uses: github/codeql-action/upload-sarif@v3\n",
+			want: "contents: read, security-events: write",
+			ok:   true,
+		},
+		{
+			name: "github-script api calls",
+			steps: "      - uses: actions/github-script@v7\n" +
+				"        with:\n" +
+				"          script: |\n" +
+				"            const pr = await github.rest.pulls.get({})\n" +
+				"            await github.rest.issues.createComment({})\n",
+			want: "issues: write, pull-requests: read",
+			ok:   true,
+		},
+		{
+			name: "gh commands",
+			steps: "      - run: |\n" +
+				"          gh pr view 1\n" +
+				"          gh release create v1\n",
+			want: "contents: write, pull-requests: read",
+			ok:   true,
+		},
+		{
+			name:  "gh api",
+			steps: "      - run: gh api repos/o/r/issues\n",
+		},
+		{
+			name: "token passed to unknown action",
+			steps: "      - uses: some/action@v1\n" +
+				"        with:\n" +
+				"          token: ${{ secrets.GITHUB_TOKEN }}\n",
+		},
+		{
+			// The token may be an implicit default input of the action.
+			name: "unknown action without the token",
+			steps: "      - uses: some/action@v1\n" +
+				"        with:\n" +
+				"          name: value\n",
+		},
+		{
+			name:  "local action",
+			steps: "      - uses: ./.github/actions/setup\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			content := "on: push\njobs:\n  build:\n    runs-on: ubuntu-latest\n    steps:\n" + tt.steps
+			workflow, errs := actionlint.Parse([]byte(content))
+			if workflow == nil {
+				t.Fatalf("parse: %v", errs)
+			}
+			got, ok := jobRequiredPermissions(workflow.Jobs["build"])
+			if ok != tt.ok {
+				t.Fatalf("ok = %v, want %v", ok, tt.ok)
+			}
+			if ok && got.String() != tt.want {
+				t.Errorf("got %q, want %q", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestPermissionsRemediations(t *testing.T) {
+	t.Parallel()
+	content := `on: push
+
+jobs:
+  release:
+    runs-on: ubuntu-latest
+    permissions: write-all
+    steps:
+      - uses: actions/checkout@v4
+      - run: gh release create v1
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v4
+`
+	data := permissionCbData{calls: &workflowCallResolver{}}
+	if _, err := validateGitHubActionTokenPermissions(".github/workflows/ci.yml", []byte(content), &data); err != nil {
+		t.Fatalf("validateGitHubActionTokenPermissions: %v", err)
+	}
+	patches := make(map[string]string)
+	for _, r := range data.results.TokenPermissions {
+		if r.Remediation == nil {
+			continue
+		}
+		key := string(*r.LocationType)
+		if r.Job != nil {
+			key += " " + *r.Job.ID
+		}
+		patches[key] = *r.Remediation.Patch
+	}
+	want := map[string]string{
+		string(checker.PermissionLocationTop): `--- a/.github/workflows/ci.yml
++++ b/.github/workflows/ci.yml
+@@ -1,5 +1,8 @@
+ on: push
+ 
++permissions:
++  contents: read
++
+ jobs:
+   release:
+     runs-on: ubuntu-latest
+`,
+		string(checker.PermissionLocationJob) + " release": `--- a/.github/workflows/ci.yml
++++ b/.github/workflows/ci.yml
+@@ -3,7 +3,8 @@
+ jobs:
+   release:
+     runs-on: ubuntu-latest
+-    permissions: write-all
++    permissions:
++      contents: write
+     steps:
+       - uses: actions/checkout@v4
+       - run: gh release create v1
+`,
+		string(checker.PermissionLocationJob) + " test": `--- a/.github/workflows/ci.yml
++++ b/.github/workflows/ci.yml
+@@ -8,6 +8,8 @@
+       - uses: actions/checkout@v4
+       - run: gh release create v1
+   test:
++    permissions:
++      contents: read
+     runs-on: ubuntu-latest
+     steps:
+       - uses: actions/checkout@v4
+`,
+	}
+	if diff := cmp.Diff(want, patches); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_topLevelRequiredPermissions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		jobs string
+		want string
+		ok   bool
+	}{
+		{
+			name: "jobs without permissions",
+			jobs: "  test:\n    runs-on: ubuntu-latest\n    steps:\n      - uses: actions/checkout@v4\n" +
+				"  release:\n    runs-on: ubuntu-latest\n    steps:\n      - run: gh release create v1\n",
+			want: "contents: read",
+			ok:   true,
+		},
+		{
+			name: "jobs declaring permissions",
+			jobs: "  release:\n    runs-on: ubuntu-latest\n    permissions:\n      contents: write\n" +
+				"    steps:\n      - run: gh release create v1\n",
+			want: "{}",
+			ok:   true,
+		},
+		{
+			name: "unknown action",
+			jobs: "  test:\n    runs-on: ubuntu-latest\n    steps:\n      - uses: some/action@v1\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			workflow, errs := actionlint.Parse([]byte("on: push\njobs:\n" + tt.jobs))
+			if workflow == nil {
+				t.Fatalf("parse: %v", errs)
+			}
+			got, ok := topLevelRequiredPermissions(workflow)
+			if ok != tt.ok {
+				t.Fatalf("ok = %v, want %v", ok, tt.ok)
+			}
+			if ok && got.String() != tt.want {
+				t.Errorf("got %q, want %q", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestPermissionsRemediations_readAll(t *testing.T) {
+	t.Parallel()
+	content := `on: push
+jobs:
+  lint:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: dorny/paths-filter@v3
+      - uses: some/action@v1
+`
+	data := permissionCbData{calls: &workflowCallResolver{}}
+	if _, err := validateGitHubActionTokenPermissions(".github/workflows/ci.yml", []byte(content), &data); err != nil {
+		t.Fatalf("validateGitHubActionTokenPermissions: %v", err)
+	}
+	var got []string
+	for _, r := range data.results.TokenPermissions {
+		if r.Remediation != nil {
+			got = append(got, string(*r.LocationType)+": "+r.Remediation.Text)
+		}
+	}
+	// The job's needs can't be inferred, so only the top-level permissions get a patch.
+	want := []string{string(checker.PermissionLocationTop) + ": set permissions to read-all"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPermissionsRemediations_writeInJobs(t *testing.T) {
+	t.Parallel()
+	content := `on: push
+jobs:
+  release:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v4
+      - run: gh release create v1
+`
+	data := permissionCbData{calls: &workflowCallResolver{}}
+	if _, err := validateGitHubActionTokenPermissions(".github/workflows/ci.yml", []byte(content), &data); err != nil {
+		t.Fatalf("validateGitHubActionTokenPermissions: %v", err)
+	}
+	var got []string
+	for _, r := range data.results.TokenPermissions {
+		if r.Remediation != nil {
+			got = append(got, string(*r.LocationType)+": "+r.Remediation.Text)
+		}
+	}
+	// The top-level permissions stay read-only, the job gets the write scope.
+	want := []string{
+		string(checker.PermissionLocationTop) + ": set permissions to contents: read",
+		string(checker.PermissionLocationJob) + ": set permissions to contents: write",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/rhysd/actionlint"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+var (
+	// References to the contexts through which a workflow passes values around.
+	reContextReference = regexp.MustCompile(
+		`(?i)\b(?:env\.[a-z_][a-z0-9_]*|(?:steps|needs)\.[a-z0-9_-]+\.outputs\.[a-z0-9_-]+)\b`)
+	reShellVariable = regexp.MustCompile(`\$\{?([A-Za-z_][A-Za-z0-9_]*)`)
+	// Lines like: echo "name=value" >> "$GITHUB_OUTPUT".
+	reGitHubFileWrite = regexp.MustCompile(
+		`^\s*(?:echo|printf)\s+(?:-[a-z]+\s+)*["']?([A-Za-z_][A-Za-z0-9_-]*)=(.*?)["']?\s*>>\s*` +
+			`["']?\$\{?(GITHUB_ENV|GITHUB_OUTPUT)\}?["']?\s*$`)
+)
+
+// taintedValues maps context references, such as "env.TITLE" or
+// "steps.meta.outputs.title", to the chain of references through which
+// they derive from an untrusted input, ending with the reference itself.
+// Keys are in lower case since contexts are case-insensitive.
+type taintedValues map[string][]string
+
+func (t taintedValues) derive(ref string, from []string) {
+	t[strings.ToLower(ref)] = append(slices.Clone(from), ref)
+}
+
+func (t taintedValues) addEnv(env *actionlint.Env) {
+	if env == nil {
+		return
+	}
+	for _, v := range env.Vars {
+		if v == nil || v.Name == nil || v.Value == nil {
+			continue
+		}
+		if from := t.valueTaint(v.Value.Value, false); from != nil {
+			t.derive("env."+v.Name.Value, from)
+		}
+	}
+}
+
+// expressionTaint returns the taint path of the contents of a ${{ }} expression,
+// or nil if it doesn't depend on untrusted input.
+func (t taintedValues) expressionTaint(expr string) []string {
+	if containsUntrustedContextPattern(expr) {
+		return []string{strings.TrimSpace(expr)}
+	}
+	for _, ref := range reContextReference.FindAllString(expr, -1) {
+		if from, ok := t[strings.ToLower(ref)]; ok {
+			return from
+		}
+	}
+	return nil
+}
+
+// valueTaint returns the taint path of a value holding expressions and,
+// for shell scripts, environment variables.
+func (t taintedValues) valueTaint(value string, shell bool) []string {
+	for _, expr := range workflowExpressions(value) {
+		if from := t.expressionTaint(expr); from != nil {
+			return from
+		}
+	}
+	if !shell {
+		return nil
+	}
+	for _, m := range reShellVariable.FindAllStringSubmatch(value, -1) {
+		if from, ok := t[strings.ToLower("env."+m[1])]; ok {
+			return from
+		}
+	}
+	return nil
+}
+
+// workflowExpressions returns the contents of the ${{ }} expressions in s.
+// Unterminated expressions are reported by checkVariablesInScript.
+func workflowExpressions(s string) []string {
+	var exprs []string
+	for {
+		start := strings.Index(s, "${{")
+		if start == -1 {
+			return exprs
+		}
+		end := strings.Index(s[start:], "}}")
+		if end == -1 {
+			return exprs
+		}
+		exprs = append(exprs, s[start+3:start+end])
+		s = s[start+end:]
+	}
+}
+
+// validateScriptTaint finds script injections of untrusted input which reaches
+// a script through env variables, step outputs, $GITHUB_ENV and $GITHUB_OUTPUT
+// writes, or job outputs. Untrusted input used directly in a run: script is
+// reported by checkVariablesInScript. Scripts of actions/github-script are
+// checked for both.
+func validateScriptTaint(workflow *actionlint.Workflow, path string, pdata *checker.DangerousWorkflowData) {
+	global := taintedValues{}
+	global.addEnv(workflow.Env)
+	for _, id := range jobsInRunOrder(workflow) {
+		job := workflow.Jobs[id]
+		tainted := maps.Clone(global)
+		tainted.addEnv(job.Env)
+		for _, step := range job.Steps {
+			if step != nil {
+				checkStepTaint(step, job, path, tainted, pdata)
+			}
+		}
+		for _, name := range slices.Sorted(maps.Keys(job.Outputs)) {
+			out := job.Outputs[name]
+			if out == nil || out.Name == nil || out.Value == nil {
+				continue
+			}
+			if from := tainted.valueTaint(out.Value.Value, false); from != nil {
+				global.derive("needs."+jobID(id, job)+".outputs."+out.Name.Value, from)
+			}
+		}
+	}
+}
+
+func checkStepTaint(step *actionlint.Step, job *actionlint.Job, path string,
+	tainted taintedValues, pdata *checker.DangerousWorkflowData,
+) {
+	scope := maps.Clone(tainted)
+	scope.addEnv(step.Env)
+	switch exec := step.Exec.(type) {
+	case *actionlint.ExecRun:
+		if exec.Run == nil {
+			return
+		}
+		for _, expr := range workflowExpressions(exec.Run.Value) {
+			from := scope.expressionTaint(expr)
+			// Direct uses are reported by checkVariablesInScript.
+			if len(from) > 1 {
+				addTaintedInjection(pdata, path, job, exec.Run.Pos, expr, from)
+			}
+		}
+		// Writes to $GITHUB_ENV and $GITHUB_OUTPUT taint the following steps.
+		for _, line := range strings.Split(exec.Run.Value, "\n") {
+			m := reGitHubFileWrite.FindStringSubmatch(line)
+			if m == nil {
+				continue
+			}
+			from := scope.valueTaint(m[2], true)
+			if from == nil {
+				continue
+			}
+			switch {
+			case m[3] == "GITHUB_ENV":
+				tainted.derive("env."+m[1], from)
+			case step.ID != nil:
+				tainted.derive("steps."+step.ID.Value+".outputs."+m[1], from)
+			}
+		}
+	case *actionlint.ExecAction:
+		if exec.Uses == nil || !strings.HasPrefix(exec.Uses.Value, "actions/github-script@") {
+			return
+		}
+		script, ok := exec.Inputs["script"]
+		if !ok || script.Value == nil {
+			return
+		}
+		for _, expr := range workflowExpressions(script.Value.Value) {
+			if from := scope.expressionTaint(expr); from != nil {
+				addTaintedInjection(pdata, path, job, script.Value.Pos, expr, from)
+			}
+		}
+	}
+}
+
+func addTaintedInjection(pdata *checker.DangerousWorkflowData, path string, job *actionlint.Job,
+	pos *actionlint.Pos, expr string, from []string,
+) {
+	w := checker.DangerousWorkflow{
+		File: checker.File{
+			Path:    path,
+			Type:    finding.FileTypeSource,
+			Offset:  fileparser.GetLineNumber(pos),
+			Snippet: expr,
+		},
+		Job:  createJob(job),
+		Type: checker.DangerousWorkflowScriptInjection,
+	}
+	if len(from) > 1 {
+		w.TaintPath = from
+	}
+	pdata.Workflows = append(pdata.Workflows, w)
+}
+
+// jobID returns the ID of a job as written in the workflow.
+func jobID(key string, job *actionlint.Job) string {
+	if job.ID != nil {
+		return job.ID.Value
+	}
+	return key
+}
+
+// jobsInRunOrder returns the IDs of the workflow's jobs, with every job
+// after the jobs it needs.
+func jobsInRunOrder(workflow *actionlint.Workflow) []string {
+	var order []string
+	visited := make(map[string]bool)
+	var visit func(id string)
+	visit = func(id string) {
+		job, ok := workflow.Jobs[id]
+		if !ok || job == nil || visited[id] {
+			return
+		}
+		visited[id] = true
+		for _, need := range job.Needs {
+			if need != nil {
+				visit(strings.ToLower(need.Value))
+			}
+		}
+		order = append(order, id)
+	}
+	for _, id := range slices.Sorted(maps.Keys(workflow.Jobs)) {
+		visit(id)
+	}
+	return order
+}
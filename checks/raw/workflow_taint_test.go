@@ -0,0 +1,80 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ossf/scorecard/v5/checker"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+)
+
+func TestScriptInjectionTaint(t *testing.T) {
+	t.Parallel()
+	filename := ".github/workflows/github-workflow-dangerous-pattern-tainted-script-injection.yml"
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return([]string{filename}, nil)
+	mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(file string) (io.ReadCloser, error) {
+		return os.Open("../testdata/" + file)
+	})
+
+	dw, err := DangerousWorkflow(&checker.CheckRequest{
+		Ctx:        t.Context(),
+		RepoClient: mockRepoClient,
+	})
+	if err != nil {
+		t.Fatalf("DangerousWorkflow: %v", err)
+	}
+
+	type injection struct {
+		snippet   string
+		taintPath []string
+		line      uint
+	}
+	want := []injection{
+		{
+			line:      32,
+			snippet:   " env.SAVED_TITLE ",
+			taintPath: []string{"github.event.issue.title", "env.TITLE", "env.SAVED_TITLE"},
+		},
+		{
+			line:    37,
+			snippet: " needs.prepare.outputs.title ",
+			taintPath: []string{
+				"github.event.issue.title", "env.TITLE", "steps.meta.outputs.title", "needs.prepare.outputs.title",
+			},
+		},
+		{
+			line:      40,
+			snippet:   " env.ISSUE_BODY ",
+			taintPath: []string{"github.event.issue.body", "env.ISSUE_BODY"},
+		},
+	}
+	var got []injection
+	for _, w := range dw.Workflows {
+		if w.Type == checker.DangerousWorkflowScriptInjection {
+			got = append(got, injection{line: w.File.Offset, snippet: w.File.Snippet, taintPath: w.TaintPath})
+		}
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(injection{})); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -327,6 +327,7 @@ func Test_SAST(t *testing.T) {
 			t.Parallel()
 			ctrl := gomock.NewController(t)
 			mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+			mockRepoClient.EXPECT().ListCodeScanningAlerts().Return(nil, clients.ErrUnsupportedFeature).AnyTimes()
 			mockRepoClient.EXPECT().ListCommits().DoAndReturn(func() ([]clients.Commit, error) {
 				if tt.err != nil {
 					return nil, tt.err
@@ -0,0 +1,71 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checks
+
+import (
+	"os"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/evaluation"
+	"github.com/ossf/scorecard/v5/checks/raw"
+	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/probes"
+	"github.com/ossf/scorecard/v5/probes/zrunner"
+)
+
+// CheckSecretScanning is the registered name for SecretScanning.
+const CheckSecretScanning = "Secret-Scanning"
+
+//nolint:gochecknoinits
+func init() {
+	if err := registerCheck(CheckSecretScanning, SecretScanning, nil); err != nil {
+		// this should never happen
+		panic(err)
+	}
+}
+
+// SecretScanning runs the Secret-Scanning check.
+func SecretScanning(c *checker.CheckRequest) checker.CheckResult {
+	_, enabled := os.LookupEnv("SCORECARD_EXPERIMENTAL")
+	if !enabled {
+		c.Dlogger.Warn(&checker.LogMessage{
+			Text: "SCORECARD_EXPERIMENTAL is not set, not running the Secret-Scanning check",
+		})
+
+		e := sce.WithMessage(sce.ErrUnsupportedCheck, "SCORECARD_EXPERIMENTAL is not set, not running the Secret-Scanning check")
+		return checker.CreateRuntimeErrorResult(CheckSecretScanning, e)
+	}
+
+	rawData, err := raw.SecretScanning(c)
+	if err != nil {
+		e := sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+		return checker.CreateRuntimeErrorResult(CheckSecretScanning, e)
+	}
+
+	// Set the raw results.
+	pRawResults := getRawResults(c)
+	pRawResults.SecretScanningResults = rawData
+
+	// Evaluate the probes.
+	findings, err := zrunner.Run(pRawResults, probes.SecretScanning)
+	if err != nil {
+		e := sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+		return checker.CreateRuntimeErrorResult(CheckSecretScanning, e)
+	}
+
+	ret := evaluation.SecretScanning(CheckSecretScanning, findings, c.Dlogger)
+	ret.Findings = findings
+	return ret
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checks
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+	scut "github.com/ossf/scorecard/v5/utests"
+)
+
+func TestSecretScanning(t *testing.T) {
+	enabled, disabled := true, false
+	tests := []struct {
+		files     map[string]string
+		settings  clients.SecretScanning
+		clientErr error
+		name      string
+		expected  scut.TestReturn
+	}{
+		{
+			name:     "Scanning and push protection enabled",
+			settings: clients.SecretScanning{Enabled: &enabled, PushProtection: &enabled},
+			expected: scut.TestReturn{
+				Score:        checker.MaxResultScore,
+				NumberOfInfo: 3,
+				NumberOfWarn: 1,
+			},
+		},
+		{
+			name:     "Nothing enabled",
+			settings: clients.SecretScanning{Enabled: &disabled, PushProtection: &disabled},
+			expected: scut.TestReturn{
+				Score:        checker.MinResultScore,
+				NumberOfInfo: 1,
+				NumberOfWarn: 3,
+			},
+		},
+		{
+			name:      "Scanner in pre-commit config",
+			clientErr: clients.ErrUnsupportedFeature,
+			files: map[string]string{
+				".pre-commit-config.yaml": "repos:\n  - repo: https://github.com/gitleaks/gitleaks\n",
+			},
+			expected: scut.TestReturn{
+				Score:         7,
+				NumberOfInfo:  2,
+				NumberOfDebug: 2,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("SCORECARD_EXPERIMENTAL", "true")
+			ctrl := gomock.NewController(t)
+			mockRepo := mockrepo.NewMockRepoClient(ctrl)
+			mockRepo.EXPECT().GetSecretScanning().Return(tt.settings, tt.clientErr)
+			mockRepo.EXPECT().ListFiles(gomock.Any()).DoAndReturn(
+				func(predicate func(string) (bool, error)) ([]string, error) {
+					var files []string
+					for f := range tt.files {
+						if ok, err := predicate(f); err == nil && ok {
+							files = append(files, f)
+						}
+					}
+					return files, nil
+				}).AnyTimes()
+			mockRepo.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(file string) (io.ReadCloser, error) {
+				return io.NopCloser(strings.NewReader(tt.files[file])), nil
+			}).AnyTimes()
+
+			dl := scut.TestDetailLogger{}
+			req := checker.CheckRequest{
+				RepoClient: mockRepo,
+				Ctx:        t.Context(),
+				Dlogger:    &dl,
+			}
+			res := SecretScanning(&req)
+			scut.ValidateTestReturn(t, tt.name, &tt.expected, &res, &dl)
+		})
+	}
+}
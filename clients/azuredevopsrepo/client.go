@@ -222,6 +222,25 @@ func (c *Client) ListWebhooks() ([]clients.Webhook, error) {
 	return c.servicehooks.listWebhooks()
 }
 
+// Azure DevOps has no private vulnerability reporting or advisory database.
+func (c *Client) IsPrivateVulnerabilityReportingEnabled() (bool, error) {
+	return false, clients.ErrUnsupportedFeature
+}
+
+func (c *Client) ListSecurityAdvisories() ([]clients.SecurityAdvisory, error) {
+	return nil, clients.ErrUnsupportedFeature
+}
+
+// Secret scanning is part of GitHub Advanced Security for Azure DevOps,
+// which isn't exposed through the Azure DevOps API client.
+func (c *Client) GetSecretScanning() (clients.SecretScanning, error) {
+	return clients.SecretScanning{}, clients.ErrUnsupportedFeature
+}
+
+func (c *Client) ListCodeScanningAlerts() ([]clients.CodeScanningAlert, error) {
+	return nil, clients.ErrUnsupportedFeature
+}
+
 func (c *Client) ListProgrammingLanguages() ([]clients.Language, error) {
 	return c.languages.listProgrammingLanguages()
 }
@@ -0,0 +1,32 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clients
+
+import "time"
+
+// CodeScanningAlert represents an open alert raised by a static analysis tool.
+type CodeScanningAlert struct {
+	// CreatedAt is zero if the source doesn't track when the alert was raised,
+	// e.g. a SARIF file.
+	CreatedAt time.Time
+	Tool      string
+	RuleID    string
+	// Severity is the security severity ("critical", "high", "medium" or "low")
+	// for security rules, and the SARIF level ("error", "warning" or "note") otherwise.
+	Severity string
+	URL      string
+	Path     string
+	Line     uint
+}
@@ -48,6 +48,8 @@ var (
 )
 
 type Client struct {
+	// Progress, if set, receives the progress of clones instead of os.Stdout.
+	Progress       io.Writer
 	repo           clients.Repo
 	errListCommits error
 	gitRepo        *git.Repository
@@ -65,6 +67,7 @@ func (c *Client) InitRepo(repo clients.Repo, commitSHA string, commitDepth int)
 	c.commits = nil
 
 	// init
+	c.repo = repo
 	c.commitDepth = commitDepth
 	tempDir, err := os.MkdirTemp("", repoDir)
 	if err != nil {
@@ -90,7 +93,7 @@ func (c *Client) InitRepo(repo clients.Repo, commitSHA string, commitDepth int)
 		}
 		c.gitRepo, err = git.PlainClone(tempDir, false /*isBare*/, &git.CloneOptions{
 			URL:      uri,
-			Progress: os.Stdout,
+			Progress: c.progress(),
 		})
 	}
 	if err != nil {
@@ -104,17 +107,34 @@ func (c *Client) InitRepo(repo clients.Repo, commitSHA string, commitDepth int)
 
 	// git checkout
 	if commitSHA != clients.HeadSHA {
-		if err := c.worktree.Checkout(&git.CheckoutOptions{
-			Hash:  plumbing.NewHash(commitSHA),
-			Force: true, // throw away any unsaved changes.
-		}); err != nil {
-			return fmt.Errorf("git.Worktree: %w", err)
-		}
+		return c.Checkout(commitSHA)
 	}
 
 	return nil
 }
 
+func (c *Client) progress() io.Writer {
+	if c.Progress != nil {
+		return c.Progress
+	}
+	return os.Stdout
+}
+
+// Checkout checks out commitSHA in the clone made by InitRepo, so that another
+// commit can be analyzed without cloning the repository again.
+func (c *Client) Checkout(commitSHA string) error {
+	if err := c.worktree.Checkout(&git.CheckoutOptions{
+		Hash:  plumbing.NewHash(commitSHA),
+		Force: true, // throw away any unsaved changes.
+	}); err != nil {
+		return fmt.Errorf("git.Worktree: %w", err)
+	}
+	c.listCommits = new(sync.Once)
+	c.commits = nil
+	c.errListCommits = nil
+	return nil
+}
+
 func (c *Client) ListCommits() ([]clients.Commit, error) {
 	c.listCommits.Do(func() {
 		commitIter, err := c.gitRepo.Log(&git.LogOptions{
@@ -370,6 +390,22 @@ func (c *Client) ListWebhooks() ([]clients.Webhook, error) {
 	return nil, clients.ErrUnsupportedFeature
 }
 
+func (c *Client) IsPrivateVulnerabilityReportingEnabled() (bool, error) {
+	return false, clients.ErrUnsupportedFeature
+}
+
+func (c *Client) ListSecurityAdvisories() ([]clients.SecurityAdvisory, error) {
+	return nil, clients.ErrUnsupportedFeature
+}
+
+func (c *Client) GetSecretScanning() (clients.SecretScanning, error) {
+	return clients.SecretScanning{}, clients.ErrUnsupportedFeature
+}
+
+func (c *Client) ListCodeScanningAlerts() ([]clients.CodeScanningAlert, error) {
+	return nil, clients.ErrUnsupportedFeature
+}
+
 func (c *Client) ListProgrammingLanguages() ([]clients.Language, error) {
 	return nil, clients.ErrUnsupportedFeature
 }
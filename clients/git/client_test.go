@@ -140,6 +140,73 @@ func TestListCommits(t *testing.T) {
 	}
 }
 
+func TestCheckout(t *testing.T) {
+	t.Parallel()
+	repoPath := createTestRepo(t)
+	r, err := gitV5.PlainOpen(repoPath)
+	if err != nil {
+		t.Fatalf("Failed to open git repo: %v", err)
+	}
+	first, err := r.Head()
+	if err != nil {
+		t.Fatalf("Failed to get HEAD: %v", err)
+	}
+	w, err := r.Worktree()
+	if err != nil {
+		t.Fatalf("Failed to get worktree: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(repoPath, "file"), []byte("Goodbye, World!"), 0o600); err != nil {
+		t.Fatalf("Failed to write a file: %v", err)
+	}
+	if _, err := w.Add("file"); err != nil {
+		t.Fatalf("Failed to add a file to staging area: %v", err)
+	}
+	if _, err := w.Commit("Second commit", &gitV5.CommitOptions{
+		Author: &object.Signature{Name: "Test Author", Email: "author@example.com", When: time.Now()},
+	}); err != nil {
+		t.Fatalf("Failed to make second commit: %v", err)
+	}
+
+	repo, err := localdir.MakeLocalDirRepo(repoPath)
+	if err != nil {
+		t.Fatalf("MakeLocalDirRepo(%s) failed: %v", repoPath, err)
+	}
+	client := &Client{}
+	if err := client.InitRepo(repo, clients.HeadSHA, 10); err != nil {
+		t.Fatalf("InitRepo(%s) failed: %v", repoPath, err)
+	}
+	defer client.Close()
+	commits, err := client.ListCommits()
+	if err != nil {
+		t.Fatalf("ListCommits() failed: %v", err)
+	}
+	if len(commits) != 2 {
+		t.Errorf("ListCommits() returned %d commits, want 2", len(commits))
+	}
+
+	if err := client.Checkout(first.Hash().String()); err != nil {
+		t.Fatalf("Checkout() failed: %v", err)
+	}
+	commits, err = client.ListCommits()
+	if err != nil {
+		t.Fatalf("ListCommits() failed: %v", err)
+	}
+	if len(commits) != 1 || commits[0].SHA != first.Hash().String() {
+		t.Errorf("ListCommits() after Checkout returned %v, want the first commit", commits)
+	}
+	path, err := client.LocalPath()
+	if err != nil {
+		t.Fatalf("LocalPath() failed: %v", err)
+	}
+	content, err := os.ReadFile(filepath.Join(path, "file"))
+	if err != nil {
+		t.Fatalf("Failed to read file: %v", err)
+	}
+	if string(content) != "Hello, World!" {
+		t.Errorf("file has %q after Checkout, want the content of the first commit", content)
+	}
+}
+
 func TestSearch(t *testing.T) {
 	t.Parallel()
 	testCases := []struct {
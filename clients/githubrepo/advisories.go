@@ -0,0 +1,118 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/google/go-github/v82/github"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+type advisoriesHandler struct {
+	ghClient         *github.Client
+	reportingOnce    *sync.Once
+	advisoriesOnce   *sync.Once
+	ctx              context.Context
+	errReporting     error
+	errAdvisories    error
+	repourl          *Repo
+	advisories       []clients.SecurityAdvisory
+	privateReporting bool
+}
+
+func (handler *advisoriesHandler) init(ctx context.Context, repourl *Repo) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.errReporting = nil
+	handler.errAdvisories = nil
+	handler.reportingOnce = new(sync.Once)
+	handler.advisoriesOnce = new(sync.Once)
+	handler.advisories = nil
+	handler.privateReporting = false
+}
+
+func (handler *advisoriesHandler) setupReporting() error {
+	handler.reportingOnce.Do(func() {
+		if !strings.EqualFold(handler.repourl.commitSHA, clients.HeadSHA) {
+			handler.errReporting = fmt.Errorf("%w: private vulnerability reporting only supported for HEAD queries",
+				clients.ErrUnsupportedFeature)
+			return
+		}
+		enabled, _, err := handler.ghClient.Repositories.IsPrivateReportingEnabled(
+			handler.ctx, handler.repourl.owner, handler.repourl.repo)
+		if err != nil {
+			handler.errReporting = fmt.Errorf("error during IsPrivateReportingEnabled: %w", err)
+			return
+		}
+		handler.privateReporting = enabled
+	})
+	return handler.errReporting
+}
+
+func (handler *advisoriesHandler) setupAdvisories() error {
+	handler.advisoriesOnce.Do(func() {
+		opts := &github.ListRepositorySecurityAdvisoriesOptions{
+			ListCursorOptions: github.ListCursorOptions{PerPage: 100},
+			State:             "published",
+		}
+		for {
+			advisories, resp, err := handler.ghClient.SecurityAdvisories.ListRepositorySecurityAdvisories(
+				handler.ctx, handler.repourl.owner, handler.repourl.repo, opts)
+			if err != nil {
+				handler.errAdvisories = fmt.Errorf("error during ListRepositorySecurityAdvisories: %w", err)
+				return
+			}
+			handler.advisories = append(handler.advisories, advisoriesFrom(advisories)...)
+			if resp == nil || resp.After == "" {
+				break
+			}
+			opts.After = resp.After
+		}
+	})
+	return handler.errAdvisories
+}
+
+func (handler *advisoriesHandler) isPrivateReportingEnabled() (bool, error) {
+	if err := handler.setupReporting(); err != nil {
+		return false, fmt.Errorf("error during advisoriesHandler.setupReporting: %w", err)
+	}
+	return handler.privateReporting, nil
+}
+
+func (handler *advisoriesHandler) listSecurityAdvisories() ([]clients.SecurityAdvisory, error) {
+	if err := handler.setupAdvisories(); err != nil {
+		return nil, fmt.Errorf("error during advisoriesHandler.setupAdvisories: %w", err)
+	}
+	return handler.advisories, nil
+}
+
+func advisoriesFrom(data []*github.SecurityAdvisory) []clients.SecurityAdvisory {
+	var advisories []clients.SecurityAdvisory
+	for _, a := range data {
+		advisories = append(advisories, clients.SecurityAdvisory{
+			ID:          a.GetGHSAID(),
+			CVEID:       a.GetCVEID(),
+			Severity:    a.GetSeverity(),
+			URL:         a.GetHTMLURL(),
+			PublishedAt: a.GetPublishedAt().Time,
+		})
+	}
+	return advisories
+}
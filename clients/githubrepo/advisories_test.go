@@ -0,0 +1,135 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v82/github"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+func Test_listSecurityAdvisories(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		responsePath string
+		want         []clients.SecurityAdvisory
+		wantErr      bool
+	}{
+		{
+			name:         "published advisories",
+			responsePath: "./testdata/security-advisories.json",
+			want: []clients.SecurityAdvisory{
+				{
+					ID:          "GHSA-abcd-1234-efgh",
+					CVEID:       "CVE-2025-12345",
+					Severity:    "high",
+					URL:         "https://github.com/ossf-tests/foo/security/advisories/GHSA-abcd-1234-efgh",
+					PublishedAt: time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC),
+				},
+				{
+					ID:          "GHSA-wxyz-5678-ijkl",
+					Severity:    "low",
+					URL:         "https://github.com/ossf-tests/foo/security/advisories/GHSA-wxyz-5678-ijkl",
+					PublishedAt: time.Date(2025, 6, 7, 8, 9, 10, 0, time.UTC),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			httpClient := &http.Client{
+				Transport: stubTripper{
+					responsePath: tt.responsePath,
+				},
+			}
+			handler := &advisoriesHandler{
+				ghClient: github.NewClient(httpClient),
+			}
+			repoURL := Repo{
+				owner:     "ossf-tests",
+				repo:      "foo",
+				commitSHA: clients.HeadSHA,
+			}
+			handler.init(ctx, &repoURL)
+			got, err := handler.listSecurityAdvisories()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("listSecurityAdvisories error: %v, wantedErr: %t", err, tt.wantErr)
+			}
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("listSecurityAdvisories() = %v, want %v", got, cmp.Diff(got, tt.want))
+			}
+		})
+	}
+}
+
+func Test_isPrivateReportingEnabled(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		wantErr      error
+		name         string
+		responsePath string
+		commitSHA    string
+		want         bool
+	}{
+		{
+			name:         "enabled",
+			responsePath: "./testdata/private-reporting-enabled.json",
+			commitSHA:    clients.HeadSHA,
+			want:         true,
+		},
+		{
+			name:         "non-HEAD commit",
+			responsePath: "./testdata/private-reporting-enabled.json",
+			commitSHA:    "8fb42c2bcc2f9ad3df1a7a0f3a3d3b1b8ad4c7f6",
+			wantErr:      clients.ErrUnsupportedFeature,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			httpClient := &http.Client{
+				Transport: stubTripper{
+					responsePath: tt.responsePath,
+				},
+			}
+			handler := &advisoriesHandler{
+				ghClient: github.NewClient(httpClient),
+			}
+			repoURL := Repo{
+				owner:     "ossf-tests",
+				repo:      "foo",
+				commitSHA: tt.commitSHA,
+			}
+			handler.init(ctx, &repoURL)
+			got, err := handler.isPrivateReportingEnabled()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("isPrivateReportingEnabled error: %v, wantErr: %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("isPrivateReportingEnabled() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -58,6 +58,8 @@ type Client struct {
 	search        *searchHandler
 	searchCommits *searchCommitsHandler
 	webhook       *webhookHandler
+	advisories    *advisoriesHandler
+	codeScanning  *codeScanningHandler
 	languages     *languagesHandler
 	licenses      *licensesHandler
 	git           *gitfile.Handler
@@ -151,6 +153,12 @@ func (client *Client) InitRepo(inputRepo clients.Repo, commitSHA string, commitD
 	// Setup webhookHandler.
 	client.webhook.init(client.ctx, client.repourl)
 
+	// Setup advisoriesHandler.
+	client.advisories.init(client.ctx, client.repourl)
+
+	// Setup codeScanningHandler.
+	client.codeScanning.init(client.ctx, client.repourl)
+
 	// Setup languagesHandler.
 	client.languages.init(client.ctx, client.repourl)
 
@@ -289,6 +297,26 @@ func (client *Client) ListWebhooks() ([]clients.Webhook, error) {
 	return client.webhook.listWebhooks()
 }
 
+// IsPrivateVulnerabilityReportingEnabled implements RepoClient.IsPrivateVulnerabilityReportingEnabled.
+func (client *Client) IsPrivateVulnerabilityReportingEnabled() (bool, error) {
+	return client.advisories.isPrivateReportingEnabled()
+}
+
+// ListSecurityAdvisories implements RepoClient.ListSecurityAdvisories.
+func (client *Client) ListSecurityAdvisories() ([]clients.SecurityAdvisory, error) {
+	return client.advisories.listSecurityAdvisories()
+}
+
+// GetSecretScanning implements RepoClient.GetSecretScanning.
+func (client *Client) GetSecretScanning() (clients.SecretScanning, error) {
+	return secretScanningFrom(client.repo.GetSecurityAndAnalysis()), nil
+}
+
+// ListCodeScanningAlerts implements RepoClient.ListCodeScanningAlerts.
+func (client *Client) ListCodeScanningAlerts() ([]clients.CodeScanningAlert, error) {
+	return client.codeScanning.listAlerts()
+}
+
 // ListSuccessfulWorkflowRuns implements RepoClient.WorkflowRunsByFilename.
 func (client *Client) ListSuccessfulWorkflowRuns(filename string) ([]clients.WorkflowRun, error) {
 	return client.workflows.listSuccessfulWorkflowRuns(filename)
@@ -417,6 +445,12 @@ func NewRepoClient(ctx context.Context, opts ...Option) (clients.RepoClient, err
 		webhook: &webhookHandler{
 			ghClient: client,
 		},
+		advisories: &advisoriesHandler{
+			ghClient: client,
+		},
+		codeScanning: &codeScanningHandler{
+			ghClient: client,
+		},
 		languages: &languagesHandler{
 			ghclient: client,
 		},
@@ -0,0 +1,101 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/google/go-github/v82/github"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+type codeScanningHandler struct {
+	ghClient *github.Client
+	once     *sync.Once
+	ctx      context.Context
+	errSetup error
+	repourl  *Repo
+	alerts   []clients.CodeScanningAlert
+}
+
+func (handler *codeScanningHandler) init(ctx context.Context, repourl *Repo) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.alerts = nil
+}
+
+func (handler *codeScanningHandler) setup() error {
+	handler.once.Do(func() {
+		// Alerts are tracked for the default branch, not arbitrary commits.
+		if !strings.EqualFold(handler.repourl.commitSHA, clients.HeadSHA) {
+			handler.errSetup = fmt.Errorf("%w: code scanning alerts only supported for HEAD queries",
+				clients.ErrUnsupportedFeature)
+			return
+		}
+		opts := &github.AlertListOptions{
+			State:       "open",
+			ListOptions: github.ListOptions{PerPage: 100},
+		}
+		for {
+			alerts, resp, err := handler.ghClient.CodeScanning.ListAlertsForRepo(
+				handler.ctx, handler.repourl.owner, handler.repourl.repo, opts)
+			if err != nil {
+				handler.errSetup = fmt.Errorf("error during ListAlertsForRepo: %w", err)
+				return
+			}
+			handler.alerts = append(handler.alerts, codeScanningAlertsFrom(alerts)...)
+			if resp == nil || resp.NextPage == 0 {
+				break
+			}
+			opts.ListOptions.Page = resp.NextPage
+		}
+	})
+	return handler.errSetup
+}
+
+func (handler *codeScanningHandler) listAlerts() ([]clients.CodeScanningAlert, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during codeScanningHandler.setup: %w", err)
+	}
+	return handler.alerts, nil
+}
+
+func codeScanningAlertsFrom(data []*github.Alert) []clients.CodeScanningAlert {
+	var alerts []clients.CodeScanningAlert
+	for _, a := range data {
+		rule := a.GetRule()
+		severity := rule.GetSecuritySeverityLevel()
+		if severity == "" {
+			severity = rule.GetSeverity()
+		}
+		loc := a.GetMostRecentInstance().GetLocation()
+		alerts = append(alerts, clients.CodeScanningAlert{
+			CreatedAt: a.GetCreatedAt().Time,
+			Tool:      a.GetTool().GetName(),
+			RuleID:    rule.GetID(),
+			Severity:  severity,
+			URL:       a.GetHTMLURL(),
+			Path:      loc.GetPath(),
+			Line:      uint(max(loc.GetStartLine(), 0)),
+		})
+	}
+	return alerts
+}
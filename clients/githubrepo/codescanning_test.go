@@ -0,0 +1,95 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v82/github"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+func Test_listCodeScanningAlerts(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		responsePath string
+		commitSHA    string
+		want         []clients.CodeScanningAlert
+		wantErr      bool
+	}{
+		{
+			name:         "open alerts",
+			responsePath: "./testdata/code-scanning-alerts.json",
+			commitSHA:    clients.HeadSHA,
+			want: []clients.CodeScanningAlert{
+				{
+					CreatedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+					Tool:      "CodeQL",
+					RuleID:    "go/sql-injection",
+					Severity:  "high",
+					URL:       "https://github.com/ossf-tests/foo/security/code-scanning/42",
+					Path:      "internal/db/query.go",
+					Line:      17,
+				},
+				{
+					CreatedAt: time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC),
+					Tool:      "CodeQL",
+					RuleID:    "go/unused-variable",
+					Severity:  "warning",
+					URL:       "https://github.com/ossf-tests/foo/security/code-scanning/43",
+					Path:      "main.go",
+					Line:      3,
+				},
+			},
+		},
+		{
+			name:         "non-HEAD commit",
+			responsePath: "./testdata/code-scanning-alerts.json",
+			commitSHA:    "0123456789abcdef0123456789abcdef01234567",
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			httpClient := &http.Client{
+				Transport: stubTripper{
+					responsePath: tt.responsePath,
+				},
+			}
+			handler := &codeScanningHandler{
+				ghClient: github.NewClient(httpClient),
+			}
+			repoURL := Repo{
+				owner:     "ossf-tests",
+				repo:      "foo",
+				commitSHA: tt.commitSHA,
+			}
+			handler.init(t.Context(), &repoURL)
+			got, err := handler.listAlerts()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("listAlerts error: %v, wantedErr: %t", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
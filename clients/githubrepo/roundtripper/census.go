@@ -22,6 +22,7 @@ import (
 	opencensusstats "go.opencensus.io/stats"
 	"go.opencensus.io/tag"
 
+	"github.com/ossf/scorecard/v5/clients/internal/httpstats"
 	sce "github.com/ossf/scorecard/v5/errors"
 	"github.com/ossf/scorecard/v5/stats"
 )
@@ -51,6 +52,7 @@ func (ct *censusTransport) RoundTrip(r *http.Request) (*http.Response, error) {
 
 	r = r.WithContext(ctx)
 	resp, err := ct.innerTransport.RoundTrip(r)
+	httpstats.RecordErrors(ctx, r, resp, err)
 	if err != nil {
 		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("innerTransport.RoundTrip: %v", err))
 	}
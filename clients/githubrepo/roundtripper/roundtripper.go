@@ -23,6 +23,7 @@ import (
 	"strconv"
 
 	"github.com/bradleyfalzon/ghinstallation/v2"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
 	"github.com/ossf/scorecard/v5/clients/githubrepo/roundtripper/tokens"
 	"github.com/ossf/scorecard/v5/log"
@@ -41,7 +42,13 @@ var errGithubCredentials = errors.New("an error occurred while getting GitHub cr
 
 // NewTransport returns a configured http.Transport for use with GitHub.
 func NewTransport(ctx context.Context, logger *log.Logger) http.RoundTripper {
-	transport := http.DefaultTransport
+	return NewTransportWithBase(ctx, logger, http.DefaultTransport)
+}
+
+// NewTransportWithBase is like NewTransport, but sends the authenticated
+// requests with base.
+func NewTransportWithBase(ctx context.Context, logger *log.Logger, base http.RoundTripper) http.RoundTripper {
+	transport := base
 
 	//nolint:nestif
 	if tokenAccessor := tokens.MakeTokenAccessor(); tokenAccessor != nil {
@@ -66,5 +73,5 @@ func NewTransport(ctx context.Context, logger *log.Logger) http.RoundTripper {
 		logger.Error(errGithubCredentials, "GitHub token env var is not set. Please read https://github.com/ossf/scorecard#authentication")
 	}
 
-	return MakeCensusTransport(MakeRateLimitedTransport(transport, logger))
+	return otelhttp.NewTransport(MakeCensusTransport(MakeRateLimitedTransport(transport, logger)))
 }
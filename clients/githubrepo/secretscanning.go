@@ -0,0 +1,39 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"github.com/google/go-github/v82/github"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// secretScanningFrom converts the security_and_analysis repository field,
+// which GitHub only includes when the token is allowed to see it.
+func secretScanningFrom(sa *github.SecurityAndAnalysis) clients.SecretScanning {
+	var ret clients.SecretScanning
+	if sa == nil {
+		return ret
+	}
+	if sa.SecretScanning != nil && sa.SecretScanning.Status != nil {
+		enabled := sa.SecretScanning.GetStatus() == "enabled"
+		ret.Enabled = &enabled
+	}
+	if sa.SecretScanningPushProtection != nil && sa.SecretScanningPushProtection.Status != nil {
+		enabled := sa.SecretScanningPushProtection.GetStatus() == "enabled"
+		ret.PushProtection = &enabled
+	}
+	return ret
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v82/github"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+func Test_secretScanningFrom(t *testing.T) {
+	t.Parallel()
+	enabled, disabled := true, false
+	tests := []struct {
+		sa   *github.SecurityAndAnalysis
+		want clients.SecretScanning
+		name string
+	}{
+		{
+			name: "not visible to token",
+			sa:   nil,
+			want: clients.SecretScanning{},
+		},
+		{
+			name: "scanning and push protection enabled",
+			sa: &github.SecurityAndAnalysis{
+				SecretScanning:               &github.SecretScanning{Status: github.Ptr("enabled")},
+				SecretScanningPushProtection: &github.SecretScanningPushProtection{Status: github.Ptr("enabled")},
+			},
+			want: clients.SecretScanning{
+				Enabled:        &enabled,
+				PushProtection: &enabled,
+			},
+		},
+		{
+			name: "scanning enabled, push protection disabled",
+			sa: &github.SecurityAndAnalysis{
+				SecretScanning:               &github.SecretScanning{Status: github.Ptr("enabled")},
+				SecretScanningPushProtection: &github.SecretScanningPushProtection{Status: github.Ptr("disabled")},
+			},
+			want: clients.SecretScanning{
+				Enabled:        &enabled,
+				PushProtection: &disabled,
+			},
+		},
+		{
+			name: "only scanning reported",
+			sa: &github.SecurityAndAnalysis{
+				SecretScanning: &github.SecretScanning{Status: github.Ptr("disabled")},
+			},
+			want: clients.SecretScanning{
+				Enabled: &disabled,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := secretScanningFrom(tt.sa)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
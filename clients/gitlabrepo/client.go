@@ -21,12 +21,14 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"net/http"
 	"os"
 	"time"
 
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
 	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/internal/httpstats"
 	sce "github.com/ossf/scorecard/v5/errors"
 )
 
@@ -244,6 +246,28 @@ func (client *Client) ListWebhooks() ([]clients.Webhook, error) {
 	return client.webhook.listWebhooks()
 }
 
+// IsPrivateVulnerabilityReportingEnabled maps to confidential issues, which is how
+// GitLab projects privately receive vulnerability reports.
+func (client *Client) IsPrivateVulnerabilityReportingEnabled() (bool, error) {
+	return client.project.isConfidentialIssuesEnabled()
+}
+
+func (client *Client) ListSecurityAdvisories() ([]clients.SecurityAdvisory, error) {
+	return nil, fmt.Errorf("ListSecurityAdvisories (GitLab): %w", clients.ErrUnsupportedFeature)
+}
+
+// GetSecretScanning only reports secret push protection. Secret detection itself
+// runs as a CI job on GitLab, so it's detected from the CI configuration instead.
+func (client *Client) GetSecretScanning() (clients.SecretScanning, error) {
+	return client.project.getSecretScanning()
+}
+
+// ListCodeScanningAlerts isn't supported: GitLab only exposes SAST findings
+// through the vulnerability report, which requires GitLab Ultimate.
+func (client *Client) ListCodeScanningAlerts() ([]clients.CodeScanningAlert, error) {
+	return nil, fmt.Errorf("ListCodeScanningAlerts (GitLab): %w", clients.ErrUnsupportedFeature)
+}
+
 func (client *Client) ListSuccessfulWorkflowRuns(filename string) ([]clients.WorkflowRun, error) {
 	return client.workflows.listSuccessfulWorkflowRuns(filename)
 }
@@ -284,7 +308,8 @@ func CreateGitlabClient(ctx context.Context, host string) (clients.RepoClient, e
 
 func CreateGitlabClientWithToken(ctx context.Context, token, host string) (clients.RepoClient, error) {
 	url := "https://" + host
-	client, err := gitlab.NewClient(token, gitlab.WithBaseURL(url))
+	client, err := gitlab.NewClient(token, gitlab.WithBaseURL(url),
+		gitlab.WithHTTPClient(&http.Client{Transport: httpstats.NewTransport(http.DefaultTransport)}))
 	if err != nil {
 		return nil, fmt.Errorf("could not create gitlab client with error: %w", err)
 	}
@@ -20,6 +20,8 @@ import (
 	"time"
 
 	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/ossf/scorecard/v5/clients"
 )
 
 type projectHandler struct {
@@ -29,6 +31,10 @@ type projectHandler struct {
 	repourl   *Repo
 	createdAt time.Time
 	archived  bool
+	// GitLab has no dedicated private reporting feature, but confidential
+	// issues serve the same purpose and are available whenever issues are.
+	confidentialIssues bool
+	pushProtection     bool
 }
 
 func (handler *projectHandler) init(repourl *Repo) {
@@ -47,6 +53,9 @@ func (handler *projectHandler) setup() error {
 
 		handler.createdAt = *proj.CreatedAt
 		handler.archived = proj.Archived
+		handler.confidentialIssues = proj.IssuesAccessLevel != "" &&
+			proj.IssuesAccessLevel != gitlab.DisabledAccessControl
+		handler.pushProtection = proj.PreReceiveSecretDetectionEnabled
 	})
 
 	return handler.errSetup
@@ -67,3 +76,20 @@ func (handler *projectHandler) getCreatedAt() (time.Time, error) {
 
 	return handler.createdAt, nil
 }
+
+func (handler *projectHandler) isConfidentialIssuesEnabled() (bool, error) {
+	if err := handler.setup(); err != nil {
+		return false, fmt.Errorf("error during projectHandler.setup: %w", err)
+	}
+
+	return handler.confidentialIssues, nil
+}
+
+func (handler *projectHandler) getSecretScanning() (clients.SecretScanning, error) {
+	if err := handler.setup(); err != nil {
+		return clients.SecretScanning{}, fmt.Errorf("error during projectHandler.setup: %w", err)
+	}
+
+	pushProtection := handler.pushProtection
+	return clients.SecretScanning{PushProtection: &pushProtection}, nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package httpstats instruments the HTTP clients of forge APIs.
+package httpstats
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	opencensusstats "go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	"github.com/ossf/scorecard/v5/stats"
+)
+
+// RecordErrors records a failed request, which either failed or got an error
+// response. Successful requests aren't recorded.
+func RecordErrors(ctx context.Context, r *http.Request, resp *http.Response, err error) {
+	var name string
+	switch {
+	case err != nil:
+		name = "transport"
+	case resp.StatusCode >= http.StatusBadRequest:
+		name = strconv.Itoa(resp.StatusCode)
+	default:
+		return
+	}
+	ctx, tagErr := tag.New(ctx, tag.Insert(stats.RepoHost, r.URL.Host), tag.Upsert(stats.ErrorName, name))
+	if tagErr != nil {
+		return
+	}
+	opencensusstats.Record(ctx, stats.HTTPRequestErrors.M(1))
+}
+
+// NewTransport wraps a transport to trace its requests and count them and
+// their errors.
+func NewTransport(base http.RoundTripper) http.RoundTripper {
+	return otelhttp.NewTransport(&transport{base: base})
+}
+
+type transport struct {
+	base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx, err := tag.New(r.Context(), tag.Insert(stats.RepoHost, r.URL.Host), tag.Upsert(stats.RequestTag, "requested"))
+	if err == nil {
+		opencensusstats.Record(ctx, stats.HTTPRequests.M(1))
+	}
+	resp, err := t.base.RoundTrip(r)
+	RecordErrors(r.Context(), r, resp, err)
+	//nolint:wrapcheck // The transport is transparent.
+	return resp, err
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpstats
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"github.com/ossf/scorecard/v5/stats"
+)
+
+func TestTransport(t *testing.T) {
+	t.Parallel()
+	if err := view.Register(&stats.OutgoingHTTPRequests, &stats.OutgoingHTTPRequestErrors); err != nil {
+		t.Fatal(err)
+	}
+	defer view.Unregister(&stats.OutgoingHTTPRequests, &stats.OutgoingHTTPRequestErrors)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewTransport(http.DefaultTransport)}
+	for _, path := range []string{"/", "/missing", "/"} {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL+path, http.NoBody)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	rows, err := view.RetrieveData(stats.OutgoingHTTPRequests.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var requests int64
+	for _, row := range rows {
+		requests += count(row)
+	}
+	if requests != 3 {
+		t.Errorf("requests = %d, want 3", requests)
+	}
+
+	rows, err = view.RetrieveData(stats.OutgoingHTTPRequestErrors.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 || !hasTag(rows[0].Tags, stats.ErrorName, "404") || count(rows[0]) != 1 {
+		t.Errorf("unexpected errors: %v", rows)
+	}
+}
+
+func hasTag(tags []tag.Tag, key tag.Key, value string) bool {
+	for _, tg := range tags {
+		if tg.Key == key && tg.Value == value {
+			return true
+		}
+	}
+	return false
+}
+
+func count(row *view.Row) int64 {
+	if data, ok := row.Data.(*view.CountData); ok {
+		return data.Value
+	}
+	return 0
+}
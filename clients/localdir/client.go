@@ -238,6 +238,26 @@ func (client *Client) ListWebhooks() ([]clients.Webhook, error) {
 	return nil, fmt.Errorf("ListWebhooks: %w", clients.ErrUnsupportedFeature)
 }
 
+// IsPrivateVulnerabilityReportingEnabled implements RepoClient.IsPrivateVulnerabilityReportingEnabled.
+func (client *Client) IsPrivateVulnerabilityReportingEnabled() (bool, error) {
+	return false, fmt.Errorf("IsPrivateVulnerabilityReportingEnabled: %w", clients.ErrUnsupportedFeature)
+}
+
+// ListSecurityAdvisories implements RepoClient.ListSecurityAdvisories.
+func (client *Client) ListSecurityAdvisories() ([]clients.SecurityAdvisory, error) {
+	return nil, fmt.Errorf("ListSecurityAdvisories: %w", clients.ErrUnsupportedFeature)
+}
+
+// GetSecretScanning implements RepoClient.GetSecretScanning.
+func (client *Client) GetSecretScanning() (clients.SecretScanning, error) {
+	return clients.SecretScanning{}, fmt.Errorf("GetSecretScanning: %w", clients.ErrUnsupportedFeature)
+}
+
+// ListCodeScanningAlerts implements RepoClient.ListCodeScanningAlerts.
+func (client *Client) ListCodeScanningAlerts() ([]clients.CodeScanningAlert, error) {
+	return nil, fmt.Errorf("ListCodeScanningAlerts: %w", clients.ErrUnsupportedFeature)
+}
+
 // Search implements RepoClient.Search.
 func (client *Client) Search(request clients.SearchRequest) (clients.SearchResponse, error) {
 	return clients.SearchResponse{}, fmt.Errorf("Search: %w", clients.ErrUnsupportedFeature)
@@ -162,6 +162,21 @@ func (mr *MockRepoClientMockRecorder) GetOrgRepoClient(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgRepoClient", reflect.TypeOf((*MockRepoClient)(nil).GetOrgRepoClient), arg0)
 }
 
+// GetSecretScanning mocks base method.
+func (m *MockRepoClient) GetSecretScanning() (clients.SecretScanning, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretScanning")
+	ret0, _ := ret[0].(clients.SecretScanning)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretScanning indicates an expected call of GetSecretScanning.
+func (mr *MockRepoClientMockRecorder) GetSecretScanning() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretScanning", reflect.TypeOf((*MockRepoClient)(nil).GetSecretScanning))
+}
+
 // InitRepo mocks base method.
 func (m *MockRepoClient) InitRepo(repo clients.Repo, commitSHA string, commitDepth int) error {
 	m.ctrl.T.Helper()
@@ -191,6 +206,21 @@ func (mr *MockRepoClientMockRecorder) IsArchived() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsArchived", reflect.TypeOf((*MockRepoClient)(nil).IsArchived))
 }
 
+// IsPrivateVulnerabilityReportingEnabled mocks base method.
+func (m *MockRepoClient) IsPrivateVulnerabilityReportingEnabled() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPrivateVulnerabilityReportingEnabled")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsPrivateVulnerabilityReportingEnabled indicates an expected call of IsPrivateVulnerabilityReportingEnabled.
+func (mr *MockRepoClientMockRecorder) IsPrivateVulnerabilityReportingEnabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPrivateVulnerabilityReportingEnabled", reflect.TypeOf((*MockRepoClient)(nil).IsPrivateVulnerabilityReportingEnabled))
+}
+
 // ListCheckRunsForRef mocks base method.
 func (m *MockRepoClient) ListCheckRunsForRef(ref string) ([]clients.CheckRun, error) {
 	m.ctrl.T.Helper()
@@ -206,6 +236,21 @@ func (mr *MockRepoClientMockRecorder) ListCheckRunsForRef(ref any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCheckRunsForRef", reflect.TypeOf((*MockRepoClient)(nil).ListCheckRunsForRef), ref)
 }
 
+// ListCodeScanningAlerts mocks base method.
+func (m *MockRepoClient) ListCodeScanningAlerts() ([]clients.CodeScanningAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCodeScanningAlerts")
+	ret0, _ := ret[0].([]clients.CodeScanningAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCodeScanningAlerts indicates an expected call of ListCodeScanningAlerts.
+func (mr *MockRepoClientMockRecorder) ListCodeScanningAlerts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCodeScanningAlerts", reflect.TypeOf((*MockRepoClient)(nil).ListCodeScanningAlerts))
+}
+
 // ListCommits mocks base method.
 func (m *MockRepoClient) ListCommits() ([]clients.Commit, error) {
 	m.ctrl.T.Helper()
@@ -311,6 +356,21 @@ func (mr *MockRepoClientMockRecorder) ListReleases() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReleases", reflect.TypeOf((*MockRepoClient)(nil).ListReleases))
 }
 
+// ListSecurityAdvisories mocks base method.
+func (m *MockRepoClient) ListSecurityAdvisories() ([]clients.SecurityAdvisory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecurityAdvisories")
+	ret0, _ := ret[0].([]clients.SecurityAdvisory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecurityAdvisories indicates an expected call of ListSecurityAdvisories.
+func (mr *MockRepoClientMockRecorder) ListSecurityAdvisories() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecurityAdvisories", reflect.TypeOf((*MockRepoClient)(nil).ListSecurityAdvisories))
+}
+
 // ListStatuses mocks base method.
 func (m *MockRepoClient) ListStatuses(ref string) ([]clients.Status, error) {
 	m.ctrl.T.Helper()
@@ -247,6 +247,26 @@ func (c *client) ListWebhooks() ([]clients.Webhook, error) {
 	return nil, fmt.Errorf("ListWebhooks: %w", clients.ErrUnsupportedFeature)
 }
 
+// IsPrivateVulnerabilityReportingEnabled implements RepoClient.IsPrivateVulnerabilityReportingEnabled.
+func (c *client) IsPrivateVulnerabilityReportingEnabled() (bool, error) {
+	return false, fmt.Errorf("IsPrivateVulnerabilityReportingEnabled: %w", clients.ErrUnsupportedFeature)
+}
+
+// ListSecurityAdvisories implements RepoClient.ListSecurityAdvisories.
+func (c *client) ListSecurityAdvisories() ([]clients.SecurityAdvisory, error) {
+	return nil, fmt.Errorf("ListSecurityAdvisories: %w", clients.ErrUnsupportedFeature)
+}
+
+// GetSecretScanning implements RepoClient.GetSecretScanning.
+func (c *client) GetSecretScanning() (clients.SecretScanning, error) {
+	return clients.SecretScanning{}, fmt.Errorf("GetSecretScanning: %w", clients.ErrUnsupportedFeature)
+}
+
+// ListCodeScanningAlerts implements RepoClient.ListCodeScanningAlerts.
+func (c *client) ListCodeScanningAlerts() ([]clients.CodeScanningAlert, error) {
+	return nil, fmt.Errorf("ListCodeScanningAlerts: %w", clients.ErrUnsupportedFeature)
+}
+
 // SearchCommits implements RepoClient.SearchCommits.
 func (c *client) SearchCommits(request clients.SearchCommitsOptions) ([]clients.Commit, error) {
 	return nil, fmt.Errorf("SearchCommits: %w", clients.ErrUnsupportedFeature)
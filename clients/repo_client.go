@@ -54,8 +54,29 @@ type RepoClient interface {
 	ListCheckRunsForRef(ref string) ([]CheckRun, error)
 	ListStatuses(ref string) ([]Status, error)
 	ListWebhooks() ([]Webhook, error)
+	// IsPrivateVulnerabilityReportingEnabled reports whether the forge accepts
+	// vulnerability reports privately, without disclosing them publicly.
+	IsPrivateVulnerabilityReportingEnabled() (bool, error)
+	// ListSecurityAdvisories returns the published security advisories of the repo.
+	ListSecurityAdvisories() ([]SecurityAdvisory, error)
+	GetSecretScanning() (SecretScanning, error)
+	// ListCodeScanningAlerts returns the open alerts raised by static analysis tools.
+	ListCodeScanningAlerts() ([]CodeScanningAlert, error)
 	ListProgrammingLanguages() ([]Language, error)
 	Search(request SearchRequest) (SearchResponse, error)
 	SearchCommits(request SearchCommitsOptions) ([]Commit, error)
 	Close() error
 }
+
+// Unwrap returns the RepoClient wrapped by c, for RepoClients adding behavior
+// to another one with an `Unwrap() RepoClient` method, or c itself. Callers
+// depending on the concrete type of a RepoClient should unwrap it first.
+func Unwrap(c RepoClient) RepoClient {
+	for {
+		w, ok := c.(interface{ Unwrap() RepoClient })
+		if !ok {
+			return c
+		}
+		c = w.Unwrap()
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clients
+
+// SecretScanning represents the forge's secret scanning settings for a repo.
+// A nil field means the forge didn't report the setting.
+type SecretScanning struct {
+	// Enabled is whether the forge scans the repo for committed secrets.
+	Enabled *bool
+	// PushProtection is whether the forge blocks pushes containing secrets.
+	PushProtection *bool
+}
@@ -0,0 +1,187 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+
+	"github.com/ossf/scorecard/v5/clients/githubrepo/roundtripper"
+	"github.com/ossf/scorecard/v5/cmd/internal/batch"
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	sclog "github.com/ossf/scorecard/v5/log"
+	"github.com/ossf/scorecard/v5/options"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+	"github.com/ossf/scorecard/v5/policy"
+)
+
+var errReportChanged = errors.New("report changed since the checkpoint")
+
+// batchCmd scans repoURLs concurrently and writes their results to a
+// single report, as configured by --report, --parallelism,
+// --requests-per-hour and --checkpoint.
+func batchCmd(
+	ctx context.Context,
+	o *options.Options,
+	repoURLs []string,
+	opts []scorecard.Option,
+	checks []string,
+	checkDocs docs.Doc,
+	pol *policy.ScorecardPolicy,
+) error {
+	// All the GitHub clients share the budget, which sits below the token
+	// rotation so that each token has its own.
+	logger := sclog.NewLogger(sclog.ParseLevel(o.LogLevel))
+	budget := batch.NewBudget(http.DefaultTransport, o.RequestsPerHour)
+	transport := roundtripper.NewTransportWithBase(ctx, logger, budget)
+	opts = append(opts[:len(opts):len(opts)], scorecard.WithGitHubTransport(transport))
+
+	var checkpoint *batch.Checkpoint
+	if o.Checkpoint != "" {
+		var err error
+		checkpoint, err = batch.OpenCheckpoint(o.Checkpoint)
+		if err != nil {
+			return err //nolint:wrapcheck // The error names the file.
+		}
+		defer checkpoint.Close()
+	}
+
+	output, appending, err := openReport(o, checkpoint)
+	if err != nil {
+		return err
+	}
+	defer output.Close()
+
+	sort.Strings(checks)
+	report, err := batch.NewReport(o.Report, output, &batch.ReportOptions{
+		Options: o,
+		Policy: func(result *scorecard.Result) *policy.ScorecardPolicy {
+			if pol != nil {
+				return pol
+			}
+			return enforceAllChecks(result)
+		},
+		Docs:   checkDocs,
+		Checks: checks,
+		Append: appending,
+	})
+	if err != nil {
+		return fmt.Errorf("creating report: %w", err)
+	}
+
+	scanner := &batch.Scanner{
+		Scan: func(ctx context.Context, uri string) (*scorecard.Result, error) {
+			repo, err := repoFromURI(o, uri)
+			if err != nil {
+				return nil, err
+			}
+			return scanRepo(ctx, repo, o, opts)
+		},
+		Report:      report,
+		Checkpoint:  checkpoint,
+		ReportSize:  output.Size,
+		Parallelism: o.Parallelism,
+		OnDone: func(uri string, result *scorecard.Result, err error) {
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Skipping %s: %v\n", uri, err)
+				return
+			}
+			fmt.Fprintf(os.Stderr, "Finished (%s)\n", uri)
+			for _, c := range result.Checks {
+				if c.Error != nil {
+					fmt.Fprintf(os.Stderr, "Check %s failed for %s: %v\n", c.Name, uri, c.Error)
+				}
+			}
+		},
+	}
+	summary, err := scanner.Run(ctx, repoURLs)
+	fmt.Fprintf(os.Stderr, "Scanned %d repositories: %d failed, %d skipped as already scanned\n",
+		summary.Scanned+summary.Failed, summary.Failed, summary.Skipped)
+	if err != nil {
+		return fmt.Errorf("scanning repositories: %w", err)
+	}
+	if summary.CheckErrors > 0 {
+		return errChecksFailed
+	}
+	return nil
+}
+
+// openReport opens the output of the report. When resuming from a
+// checkpoint, JSON lines and CSV reports are appended to, so that they cover
+// the repositories scanned before. The rows reported after the last entry of
+// the checkpoint are dropped first, since their scans run again.
+func openReport(o *options.Options, checkpoint *batch.Checkpoint) (output *reportFile, appending bool, err error) {
+	if o.ResultsFile == "" {
+		return &reportFile{Writer: os.Stdout}, false, nil
+	}
+	fmt.Fprintln(os.Stderr, "Writing results to", o.ResultsFile)
+	if checkpoint == nil {
+		f, err := os.Create(o.ResultsFile)
+		if err != nil {
+			return nil, false, fmt.Errorf("unable to create output file: %w", err)
+		}
+		return &reportFile{Writer: f, closer: f}, false, nil
+	}
+	f, err := os.OpenFile(o.ResultsFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, false, fmt.Errorf("unable to open output file: %w", err)
+	}
+	size := checkpoint.ReportSize()
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, false, fmt.Errorf("unable to open output file: %w", err)
+	}
+	if info.Size() < size {
+		f.Close()
+		return nil, false, fmt.Errorf("%w: %s is smaller than recorded by the checkpoint", errReportChanged, o.ResultsFile)
+	}
+	if err := f.Truncate(size); err != nil {
+		f.Close()
+		return nil, false, fmt.Errorf("unable to truncate output file: %w", err)
+	}
+	return &reportFile{Writer: f, closer: f, size: size}, size > 0, nil
+}
+
+// reportFile is the output of a report, counting its size.
+type reportFile struct {
+	io.Writer
+	closer io.Closer
+	size   int64
+}
+
+func (r *reportFile) Write(p []byte) (int, error) {
+	n, err := r.Writer.Write(p)
+	r.size += int64(n)
+	return n, err //nolint:wrapcheck // Writers return the errors of the underlying writer as is.
+}
+
+// Size returns the size of the report, including what was written before it
+// was opened.
+func (r *reportFile) Size() int64 {
+	return r.size
+}
+
+func (r *reportFile) Close() error {
+	if r.closer == nil {
+		return nil
+	}
+	return r.closer.Close() //nolint:wrapcheck // The caller doesn't check the error.
+}
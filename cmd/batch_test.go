@@ -0,0 +1,82 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ossf/scorecard/v5/cmd/internal/batch"
+	"github.com/ossf/scorecard/v5/options"
+)
+
+func TestOpenReportResume(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	checkpoint, err := batch.OpenCheckpoint(filepath.Join(dir, "checkpoint.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer checkpoint.Close()
+	o := &options.Options{ResultsFile: filepath.Join(dir, "report.jsonl"), Checkpoint: "checkpoint.jsonl"}
+
+	// Rows reported before the first checkpoint entry are dropped.
+	if err := os.WriteFile(o.ResultsFile, []byte("row1\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	output, appending, err := openReport(o, checkpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if appending || output.Size() != 0 {
+		t.Errorf("openReport() appending = %v, size = %d, want a new report", appending, output.Size())
+	}
+	if _, err := output.Write([]byte("row1\n")); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkpoint.Record("github.com/ossf/scorecard", "", output.Size(), nil); err != nil {
+		t.Fatal(err)
+	}
+	// The batch is killed before recording the second scan.
+	if _, err := output.Write([]byte("row2\n")); err != nil {
+		t.Fatal(err)
+	}
+	output.Close()
+
+	output, appending, err = openReport(o, checkpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	output.Close()
+	if !appending || output.Size() != 5 {
+		t.Errorf("openReport() appending = %v, size = %d, want to append after 5 bytes", appending, output.Size())
+	}
+	got, err := os.ReadFile(o.ResultsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "row1\n" {
+		t.Errorf("report = %q, want %q", got, "row1\n")
+	}
+
+	if err := os.WriteFile(o.ResultsFile, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := openReport(o, checkpoint); !errors.Is(err, errReportChanged) {
+		t.Errorf("openReport() with a truncated report error = %v, want %v", err, errReportChanged)
+	}
+}
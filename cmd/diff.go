@@ -0,0 +1,137 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ossf/scorecard/v5/cmd/internal/diff"
+	"github.com/ossf/scorecard/v5/cmd/internal/trend"
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	"github.com/ossf/scorecard/v5/options"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+	"github.com/ossf/scorecard/v5/policy"
+)
+
+// errRegressed is returned when the head has new findings or violates the
+// policy, so that CI jobs fail.
+var errRegressed = errors.New("new findings or policy violations")
+
+func diffCmd(o *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff (--repo=<repo> | --local=<folder>) --base=<ref> --head=<ref> [--checks=check1,...] [--policy=<file>]",
+		Short: "Compare the results of two commits of a repository",
+		Long: `Analyze two commits of a repository, e.g. the base and head of a pull request,
+in a local clone and report the differences: the findings of the head which the
+base doesn't have, the findings of the base which the head fixed, the changed
+check scores and, with --policy, the checks which violate the policy at the
+head but not at the base.
+
+Findings are matched by probe, path and snippet, so that those which only
+moved to other lines aren't reported. Only the checks which can run on files
+are run.
+
+The default format is markdown, which can be posted as a pull request comment.
+The command fails if the head has new findings or policy violations.`,
+		PreRunE: validateOptions(o),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return rootCmd(o)
+		},
+	}
+
+	o.AddFlags(cmd)
+	o.AddDiffFlags(cmd)
+	//nolint:errcheck
+	cmd.MarkFlagRequired(options.FlagBaseRef)
+	//nolint:errcheck
+	cmd.MarkFlagRequired(options.FlagHeadRef)
+	return cmd
+}
+
+// diffRefs analyzes the commits of --base and --head in a clone of the
+// repository, and writes their diff.
+func diffRefs(
+	ctx context.Context,
+	o *options.Options,
+	uri string,
+	opts []scorecard.Option,
+	checkDocs docs.Doc,
+	pol *policy.ScorecardPolicy,
+) error {
+	repo, client, gitRepo, err := cloneRepo(o, uri)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	opts = append(opts[:len(opts):len(opts)], scorecard.WithRepoClient(trend.CheckoutClient{Client: client}))
+	refs := []diff.Ref{{Name: o.BaseRef}, {Name: o.HeadRef}}
+	results := make([]*scorecard.Result, len(refs))
+	var sawRuntimeErr bool
+	for i := range refs {
+		c, err := trend.Resolve(gitRepo, refs[i].Name)
+		if err != nil {
+			return err //nolint:wrapcheck // Resolve names the ref.
+		}
+		refs[i].Commit = c.SHA
+		fmt.Fprintf(os.Stderr, "Analyzing %s %s\n", refs[i].Name, c.SHA)
+		results[i], err = scanRepo(ctx, repo, o, append(opts, scorecard.WithCommitSHA(c.SHA)))
+		if err != nil {
+			return fmt.Errorf("analyzing %s: %w", refs[i].Name, err)
+		}
+		for _, r := range results[i].Checks {
+			if r.Error != nil {
+				sawRuntimeErr = true
+				fmt.Fprintf(os.Stderr, "Check %s failed for %s: %v\n", r.Name, refs[i].Name, r.Error)
+			}
+		}
+	}
+
+	d, err := diff.Compare(refs[0], refs[1], results[0], results[1], pol, checkDocs)
+	if err != nil {
+		return fmt.Errorf("comparing results: %w", err)
+	}
+
+	output := os.Stdout
+	if o.ResultsFile != "" {
+		output, err = os.Create(o.ResultsFile)
+		if err != nil {
+			return fmt.Errorf("unable to create output file: %w", err)
+		}
+		fmt.Fprintln(os.Stderr, "Writing results to", o.ResultsFile)
+		defer output.Close()
+	}
+	if o.Format == options.FormatJSON {
+		err = d.WriteJSON(output)
+	} else {
+		err = d.WriteMarkdown(output)
+	}
+	if err != nil {
+		return fmt.Errorf("writing diff: %w", err)
+	}
+
+	if sawRuntimeErr {
+		return errChecksFailed
+	}
+	if d.Regressed() {
+		return errRegressed
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/ossf/scorecard/v5/clients/localdir"
+	sclog "github.com/ossf/scorecard/v5/log"
+	"github.com/ossf/scorecard/v5/options"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+	"github.com/ossf/scorecard/v5/remediation"
+	"github.com/ossf/scorecard/v5/remediation/autofix"
+)
+
+// fixCmd applies the supported remediations to the --local repository,
+// or prints them as a unified diff with --dry-run.
+func fixCmd(ctx context.Context, o *options.Options) error {
+	repo, err := localdir.MakeLocalDirRepo(o.Local)
+	if err != nil {
+		return fmt.Errorf("localdir: %w", err)
+	}
+	result, err := scorecard.Run(ctx, repo,
+		scorecard.WithLogLevel(sclog.ParseLevel(o.LogLevel)),
+		scorecard.WithProbes(autofix.Probes),
+	)
+	if err != nil {
+		return fmt.Errorf("run: %w", err)
+	}
+
+	plan, err := autofix.NewPlan(ctx, o.Local, &result.RawResults, result.Findings, autofix.Options{
+		Resolver: autofix.GitResolver{},
+		Digester: remediation.CraneDigester{},
+	})
+	if err != nil {
+		return fmt.Errorf("planning fixes: %w", err)
+	}
+	for _, s := range plan.Skipped {
+		fmt.Fprintf(os.Stderr, "Skipping %s\n", s)
+	}
+
+	if o.DryRun {
+		fmt.Fprint(os.Stdout, plan.Diff())
+		return nil
+	}
+	if err := plan.Apply(); err != nil {
+		return fmt.Errorf("applying fixes: %w", err)
+	}
+	for _, path := range plan.Files() {
+		fmt.Fprintf(os.Stderr, "Fixed %s\n", path)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"path"
+	"strings"
+)
+
+// Allowlist lists the repositories which can be analyzed, as patterns matching
+// the leading segments of repository URIs: "gitlab.example.com" allows a host,
+// "github.com/ossf" an organization, "github.com/ossf/scorecard" a repository,
+// and the segments can be shell patterns, as in "github.com/ossf-*".
+type Allowlist []string
+
+// Allows returns true if the repository can be analyzed. An empty list allows
+// every repository.
+func (a Allowlist) Allows(uri string) bool {
+	if len(a) == 0 {
+		return true
+	}
+	segments := strings.Split(strings.ToLower(strings.Trim(uri, "/")), "/")
+	for _, pattern := range a {
+		patterns := strings.Split(strings.ToLower(strings.Trim(pattern, "/")), "/")
+		if len(patterns) > len(segments) {
+			continue
+		}
+		matched, err := path.Match(strings.Join(patterns, "/"), strings.Join(segments[:len(patterns)], "/"))
+		if err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import "testing"
+
+func TestAllowlist(t *testing.T) {
+	t.Parallel()
+	allowlist := Allowlist{"github.com/ossf", "gitlab.example.com", "github.com/google/oss-*", "github.com/a/b"}
+	tests := []struct {
+		allowlist Allowlist
+		uri       string
+		want      bool
+	}{
+		{allowlist: allowlist, uri: "github.com/ossf/scorecard", want: true},
+		{allowlist: allowlist, uri: "github.com/OSSF/Scorecard", want: true},
+		{allowlist: allowlist, uri: "gitlab.example.com/group/subgroup/project", want: true},
+		{allowlist: allowlist, uri: "github.com/google/oss-fuzz", want: true},
+		{allowlist: allowlist, uri: "github.com/a/b", want: true},
+		{allowlist: allowlist, uri: "github.com/a/bc", want: false},
+		{allowlist: allowlist, uri: "github.com/ossf-fork/scorecard", want: false},
+		{allowlist: allowlist, uri: "github.com/google/go-github", want: false},
+		{allowlist: allowlist, uri: "gitlab.com/ossf/scorecard", want: false},
+		{allowlist: nil, uri: "gitlab.com/anything/goes", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.allowlist.Allows(tt.uri); got != tt.want {
+				t.Errorf("Allows(%q) = %v, want %v", tt.uri, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package auth authenticates the clients of the HTTP server and limits what
+// they can do: how often they call it, how many analyses they run at once and
+// which repositories they can analyze.
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+var (
+	// ErrNoCredentials indicates a request has no credentials for an authentication method.
+	ErrNoCredentials = errors.New("no credentials")
+	// ErrInvalidCredentials indicates a request has credentials which can't be verified.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// Client is an authenticated caller of the server.
+type Client struct {
+	// ID identifies the client for limits and job ownership.
+	ID string
+	// Method is the authentication method: "token", "mtls" or "oidc".
+	Method string
+}
+
+// Key identifies the client across authentication methods, e.g. "token:ci",
+// so that clients of different methods with the same ID don't share limits
+// or jobs.
+func (c *Client) Key() string {
+	return c.Method + ":" + c.ID
+}
+
+// Authenticator authenticates the client of a request.
+type Authenticator interface {
+	// Authenticate returns the client making a request, ErrNoCredentials if
+	// the request has no credentials for this method, or an error if its
+	// credentials are invalid.
+	Authenticate(r *http.Request) (*Client, error)
+}
+
+// Chain tries each authentication method in turn, until one finds credentials.
+type Chain []Authenticator
+
+// Authenticate implements Authenticator.
+func (c Chain) Authenticate(r *http.Request) (*Client, error) {
+	for _, a := range c {
+		client, err := a.Authenticate(r)
+		if !errors.Is(err, ErrNoCredentials) {
+			return client, err
+		}
+	}
+	return nil, ErrNoCredentials
+}
+
+type clientKey struct{}
+
+// WithClient returns a copy of ctx carrying the client.
+func WithClient(ctx context.Context, c *Client) context.Context {
+	return context.WithValue(ctx, clientKey{}, c)
+}
+
+// ClientFrom returns the client carried by ctx, or nil.
+func ClientFrom(ctx context.Context) *Client {
+	c, _ := ctx.Value(clientKey{}).(*Client)
+	return c
+}
+
+// bearerToken returns the token of the Authorization header of a request.
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// Tokens authenticates clients with static bearer tokens. Only the hashes of
+// the tokens are kept.
+type Tokens struct {
+	clients map[[sha256.Size]byte]string
+}
+
+// NewTokens returns an authenticator for the tokens hashed with SHA-256,
+// mapped to the IDs of their clients.
+func NewTokens(hashes map[[sha256.Size]byte]string) *Tokens {
+	return &Tokens{clients: hashes}
+}
+
+// Authenticate implements Authenticator. Unknown tokens are left to the other
+// methods, since they can be JWTs.
+func (t *Tokens) Authenticate(r *http.Request) (*Client, error) {
+	token, ok := bearerToken(r)
+	if !ok {
+		return nil, ErrNoCredentials
+	}
+	// Looking up the hash doesn't leak the tokens through timing.
+	id, ok := t.clients[sha256.Sum256([]byte(token))]
+	if !ok {
+		return nil, ErrNoCredentials
+	}
+	return &Client{ID: id, Method: "token"}, nil
+}
+
+// MTLS authenticates clients with the TLS client certificates verified by the
+// server. Clients are identified by the common name of their certificate, or
+// by its first DNS or URI name.
+type MTLS struct{}
+
+// Authenticate implements Authenticator.
+func (MTLS) Authenticate(r *http.Request) (*Client, error) {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return nil, ErrNoCredentials
+	}
+	cert := r.TLS.VerifiedChains[0][0]
+	id := cert.Subject.CommonName
+	switch {
+	case id != "":
+	case len(cert.DNSNames) > 0:
+		id = cert.DNSNames[0]
+	case len(cert.URIs) > 0:
+		id = cert.URIs[0].String()
+	default:
+		return nil, ErrInvalidCredentials
+	}
+	return &Client{ID: id, Method: "mtls"}, nil
+}
@@ -0,0 +1,149 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func requestWithToken(token string) *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	return r
+}
+
+func TestTokens(t *testing.T) {
+	t.Parallel()
+	tokens := NewTokens(map[[sha256.Size]byte]string{sha256.Sum256([]byte("s3cret")): "ci"})
+	tests := []struct {
+		wantErr error
+		name    string
+		token   string
+		want    string
+	}{
+		{name: "known token", token: "s3cret", want: "ci"},
+		{name: "unknown token", token: "other", wantErr: ErrNoCredentials},
+		{name: "no token", wantErr: ErrNoCredentials},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			client, err := tokens.Authenticate(requestWithToken(tt.token))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Authenticate() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.want != "" && (client == nil || client.ID != tt.want || client.Method != "token") {
+				t.Errorf("Authenticate() = %+v, want client %s", client, tt.want)
+			}
+		})
+	}
+}
+
+type fixedAuthenticator struct {
+	client *Client
+	err    error
+}
+
+func (f fixedAuthenticator) Authenticate(*http.Request) (*Client, error) {
+	return f.client, f.err
+}
+
+func TestChain(t *testing.T) {
+	t.Parallel()
+	none := fixedAuthenticator{err: ErrNoCredentials}
+	invalid := fixedAuthenticator{err: ErrInvalidCredentials}
+	valid := fixedAuthenticator{client: &Client{ID: "ci"}}
+	tests := []struct {
+		wantErr error
+		name    string
+		chain   Chain
+		want    string
+	}{
+		{name: "first method with credentials", chain: Chain{none, valid, invalid}, want: "ci"},
+		{name: "invalid credentials stop the chain", chain: Chain{invalid, valid}, wantErr: ErrInvalidCredentials},
+		{name: "no credentials", chain: Chain{none, none}, wantErr: ErrNoCredentials},
+		{name: "empty chain", wantErr: ErrNoCredentials},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			client, err := tt.chain.Authenticate(requestWithToken(""))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Authenticate() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.want != "" && client.ID != tt.want {
+				t.Errorf("Authenticate() = %+v, want client %s", client, tt.want)
+			}
+		})
+	}
+}
+
+func TestMTLS(t *testing.T) {
+	t.Parallel()
+	spiffe, err := url.Parse("spiffe://example.com/ci")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		cert    *x509.Certificate
+		wantErr error
+		name    string
+		want    string
+	}{
+		{name: "no certificate", wantErr: ErrNoCredentials},
+		{name: "common name", cert: &x509.Certificate{Subject: pkix.Name{CommonName: "ci"}}, want: "ci"},
+		{name: "DNS name", cert: &x509.Certificate{DNSNames: []string{"ci.example.com"}}, want: "ci.example.com"},
+		{name: "URI", cert: &x509.Certificate{URIs: []*url.URL{spiffe}}, want: "spiffe://example.com/ci"},
+		{name: "no name", cert: &x509.Certificate{}, wantErr: ErrInvalidCredentials},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.cert != nil {
+				r.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{tt.cert}}}
+			}
+			client, err := MTLS{}.Authenticate(r)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Authenticate() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.want != "" && (client.ID != tt.want || client.Method != "mtls") {
+				t.Errorf("Authenticate() = %+v, want client %s", client, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientContext(t *testing.T) {
+	t.Parallel()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	if c := ClientFrom(r.Context()); c != nil {
+		t.Errorf("ClientFrom() = %+v, want nil", c)
+	}
+	want := &Client{ID: "ci", Method: "token"}
+	if c := ClientFrom(WithClient(r.Context(), want)); c != want {
+		t.Errorf("ClientFrom() = %+v, want %+v", c, want)
+	}
+}
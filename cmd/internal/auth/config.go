@@ -0,0 +1,157 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+var errInvalidConfig = errors.New("invalid auth config")
+
+// Config configures the authentication, limits and allowed repositories of
+// the server. For example:
+//
+//	tokens:
+//	  - client: ci
+//	    env: SCORECARD_CI_TOKEN
+//	  - client: portal
+//	    sha256: 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08
+//	oidc:
+//	  issuer: https://token.actions.githubusercontent.com
+//	  audience: scorecard
+//	  client_claim: repository_owner
+//	mtls: true
+//	limits:
+//	  requests_per_minute: 60
+//	  burst: 10
+//	  max_concurrent_scans: 2
+//	  clients:
+//	    token:portal:
+//	      max_concurrent_scans: 10
+//	allowed_repos:
+//	  - github.com/ossf
+//	  - gitlab.example.com
+type Config struct {
+	OIDC         *OIDCConfig   `yaml:"oidc"`
+	Limits       LimitsConfig  `yaml:"limits"`
+	Tokens       []TokenConfig `yaml:"tokens"`
+	AllowedRepos Allowlist     `yaml:"allowed_repos"`
+	// MTLS authenticates clients with their TLS certificates, which requires
+	// the server to verify them.
+	MTLS bool `yaml:"mtls"`
+}
+
+// TokenConfig is a static bearer token, given by its hex-encoded SHA-256 hash
+// or by an environment variable holding it.
+type TokenConfig struct {
+	Client string `yaml:"client"`
+	SHA256 string `yaml:"sha256"`
+	Env    string `yaml:"env"`
+}
+
+// OIDCConfig configures the validation of the JWTs of an OpenID Connect issuer.
+type OIDCConfig struct {
+	Issuer   string `yaml:"issuer"`
+	Audience string `yaml:"audience"`
+	// ClientClaim identifies clients, "sub" by default.
+	ClientClaim string `yaml:"client_claim"`
+}
+
+// LimitsConfig are the default limits of clients, and those of some clients
+// given by their Key, e.g. token:portal or oidc:ossf.
+type LimitsConfig struct {
+	Clients map[string]Limits `yaml:"clients"`
+	Limits  `yaml:",inline"`
+}
+
+// LoadConfig reads a YAML configuration file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("os.ReadFile: %w", err)
+	}
+	var c Config
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&c); err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidConfig, err)
+	}
+	for client := range c.Limits.Clients {
+		switch method, _, _ := strings.Cut(client, ":"); method {
+		case "token", "mtls", "oidc":
+		default:
+			return nil, fmt.Errorf("%w: limits of client %s need a token:, mtls: or oidc: prefix",
+				errInvalidConfig, client)
+		}
+	}
+	return &c, nil
+}
+
+// Authenticator returns the configured authentication methods, or nil if
+// clients don't need to authenticate.
+func (c *Config) Authenticator() (Authenticator, error) {
+	var chain Chain
+	if len(c.Tokens) > 0 {
+		hashes := make(map[[sha256.Size]byte]string, len(c.Tokens))
+		for _, t := range c.Tokens {
+			if t.Client == "" {
+				return nil, fmt.Errorf("%w: token without client", errInvalidConfig)
+			}
+			var hash [sha256.Size]byte
+			switch {
+			case t.Env != "":
+				token := os.Getenv(t.Env)
+				if token == "" {
+					return nil, fmt.Errorf("%w: %s is empty for client %s", errInvalidConfig, t.Env, t.Client)
+				}
+				hash = sha256.Sum256([]byte(token))
+			default:
+				b, err := hex.DecodeString(t.SHA256)
+				if err != nil || len(b) != sha256.Size {
+					return nil, fmt.Errorf("%w: invalid sha256 for client %s", errInvalidConfig, t.Client)
+				}
+				copy(hash[:], b)
+			}
+			hashes[hash] = t.Client
+		}
+		chain = append(chain, NewTokens(hashes))
+	}
+	if c.MTLS {
+		chain = append(chain, MTLS{})
+	}
+	if c.OIDC != nil {
+		if c.OIDC.Issuer == "" || c.OIDC.Audience == "" {
+			return nil, fmt.Errorf("%w: oidc needs an issuer and an audience", errInvalidConfig)
+		}
+		chain = append(chain, NewOIDC(c.OIDC.Issuer, c.OIDC.Audience, c.OIDC.ClientClaim))
+	}
+	if len(chain) == 0 {
+		return nil, nil
+	}
+	return chain, nil
+}
+
+// Limiter returns the configured limits.
+func (c *Config) Limiter() *Limiter {
+	return NewLimiter(c.Limits.Limits, c.Limits.Clients)
+}
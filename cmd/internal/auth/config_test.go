@@ -0,0 +1,123 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "auth.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+//nolint:paralleltest // Uses t.Setenv.
+func TestLoadConfig(t *testing.T) {
+	t.Setenv("TEST_SCORECARD_TOKEN", "from-env")
+	hash := sha256.Sum256([]byte("hashed"))
+	path := writeConfig(t, `
+tokens:
+  - client: ci
+    env: TEST_SCORECARD_TOKEN
+  - client: portal
+    sha256: `+hex.EncodeToString(hash[:])+`
+mtls: true
+limits:
+  requests_per_minute: 60
+  max_concurrent_scans: 2
+  clients:
+    token:portal:
+      max_concurrent_scans: 10
+allowed_repos:
+  - github.com/ossf
+`)
+	c, err := LoadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Limits.RequestsPerMinute != 60 || c.Limits.MaxConcurrentScans != 2 ||
+		c.Limits.Clients["token:portal"].MaxConcurrentScans != 10 {
+		t.Errorf("unexpected limits: %+v", c.Limits)
+	}
+	if !c.AllowedRepos.Allows("github.com/ossf/scorecard") || c.AllowedRepos.Allows("github.com/other/repo") {
+		t.Errorf("unexpected allowlist: %v", c.AllowedRepos)
+	}
+
+	a, err := c.Authenticator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain, ok := a.(Chain)
+	if !ok || len(chain) != 2 {
+		t.Fatalf("Authenticator() = %#v, want tokens and mTLS", a)
+	}
+	for token, want := range map[string]string{"from-env": "ci", "hashed": "portal"} {
+		client, err := a.Authenticate(requestWithToken(token))
+		if err != nil || client.Key() != "token:"+want {
+			t.Errorf("Authenticate(%s) = %+v, %v, want %s", token, client, err, want)
+		}
+	}
+	l := c.Limiter()
+	for i := range 10 {
+		if _, ok := l.Acquire("token:portal"); !ok {
+			t.Fatalf("scan %d denied within the client's quota", i)
+		}
+	}
+}
+
+func TestConfigErrors(t *testing.T) {
+	t.Parallel()
+	if _, err := LoadConfig(writeConfig(t, "token: []\n")); !errors.Is(err, errInvalidConfig) {
+		t.Errorf("LoadConfig() with unknown field error = %v, want %v", err, errInvalidConfig)
+	}
+	config := "limits:\n  clients:\n    portal:\n      burst: 1\n"
+	if _, err := LoadConfig(writeConfig(t, config)); !errors.Is(err, errInvalidConfig) {
+		t.Errorf("LoadConfig() with client limits without method error = %v, want %v", err, errInvalidConfig)
+	}
+	tests := []struct {
+		config Config
+		name   string
+	}{
+		{name: "token without client", config: Config{Tokens: []TokenConfig{{SHA256: "00"}}}},
+		{name: "invalid hash", config: Config{Tokens: []TokenConfig{{Client: "ci", SHA256: "00"}}}},
+		{name: "empty env", config: Config{Tokens: []TokenConfig{{Client: "ci", Env: "TEST_SCORECARD_UNSET"}}}},
+		{name: "oidc without audience", config: Config{OIDC: &OIDCConfig{Issuer: "https://issuer.example.com"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if _, err := tt.config.Authenticator(); !errors.Is(err, errInvalidConfig) {
+				t.Errorf("Authenticator() error = %v, want %v", err, errInvalidConfig)
+			}
+		})
+	}
+}
+
+func TestConfigWithoutAuthentication(t *testing.T) {
+	t.Parallel()
+	a, err := (&Config{}).Authenticator()
+	if err != nil || a != nil {
+		t.Errorf("Authenticator() = %v, %v, want no authentication", a, err)
+	}
+}
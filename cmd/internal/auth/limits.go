@@ -0,0 +1,129 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Limits are the limits of a client. Zero values mean no limit.
+type Limits struct {
+	// RequestsPerMinute limits the rate of the requests of the client.
+	RequestsPerMinute float64 `yaml:"requests_per_minute"`
+	// Burst is how many requests can exceed the rate at once.
+	Burst int `yaml:"burst"`
+	// MaxConcurrentScans limits the analyses the client runs at once, including
+	// its queued jobs.
+	MaxConcurrentScans int `yaml:"max_concurrent_scans"`
+}
+
+// idleSweep is how often the rate limiters of idle clients are evicted.
+const idleSweep = time.Minute
+
+// Limiter enforces the limits of each client, identified by their Key.
+type Limiter struct {
+	swept    time.Time
+	clients  map[string]Limits
+	limiters map[string]*rate.Limiter
+	// active only holds the clients running analyses.
+	active   map[string]int
+	now      func() time.Time
+	defaults Limits
+	mu       sync.Mutex
+}
+
+// NewLimiter returns a limiter applying defaults to the clients without
+// limits of their own.
+func NewLimiter(defaults Limits, clients map[string]Limits) *Limiter {
+	return &Limiter{
+		clients:  clients,
+		limiters: make(map[string]*rate.Limiter),
+		active:   make(map[string]int),
+		now:      time.Now,
+		defaults: defaults,
+	}
+}
+
+func (l *Limiter) limits(client string) Limits {
+	if limits, ok := l.clients[client]; ok {
+		return limits
+	}
+	return l.defaults
+}
+
+// Allow returns true if the client can make a request now, or else how long
+// it should wait before retrying.
+func (l *Limiter) Allow(client string) (retryAfter time.Duration, ok bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	if now.Sub(l.swept) > idleSweep {
+		l.evictIdle(now)
+	}
+	limits := l.limits(client)
+	if limits.RequestsPerMinute <= 0 {
+		return 0, true
+	}
+	limiter, ok := l.limiters[client]
+	if !ok {
+		burst := max(limits.Burst, 1)
+		limiter = rate.NewLimiter(rate.Limit(limits.RequestsPerMinute/60), burst)
+		l.limiters[client] = limiter
+	}
+	r := limiter.ReserveN(now, 1)
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return delay, false
+	}
+	return 0, true
+}
+
+// evictIdle forgets the rate limiters which are full again, since they are
+// the same as new ones. l.mu must be held.
+func (l *Limiter) evictIdle(now time.Time) {
+	for client, limiter := range l.limiters {
+		if limiter.TokensAt(now) >= float64(limiter.Burst()) {
+			delete(l.limiters, client)
+		}
+	}
+	l.swept = now
+}
+
+// Acquire reserves an analysis for the client. It returns false if the client
+// already runs as many analyses as it can, or a function to call once the
+// analysis is over.
+func (l *Limiter) Acquire(client string) (release func(), ok bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	limits := l.limits(client)
+	if limits.MaxConcurrentScans > 0 && l.active[client] >= limits.MaxConcurrentScans {
+		return nil, false
+	}
+	l.active[client]++
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			l.active[client]--
+			if l.active[client] == 0 {
+				delete(l.active, client)
+			}
+		})
+	}, true
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLimiterAllow(t *testing.T) {
+	t.Parallel()
+	l := NewLimiter(Limits{RequestsPerMinute: 1, Burst: 2}, map[string]Limits{"unlimited": {}})
+	for i := range 2 {
+		if _, ok := l.Allow("ci"); !ok {
+			t.Fatalf("request %d denied within the burst", i)
+		}
+	}
+	retryAfter, ok := l.Allow("ci")
+	if ok || retryAfter <= 0 {
+		t.Errorf("Allow() = %v, %v, want a denial with a delay", retryAfter, ok)
+	}
+	// Clients have their own budget.
+	if _, ok := l.Allow("other"); !ok {
+		t.Error("other client denied")
+	}
+	for range 10 {
+		if _, ok := l.Allow("unlimited"); !ok {
+			t.Fatal("client without limits denied")
+		}
+	}
+}
+
+func TestLimiterEvictIdle(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	l := NewLimiter(Limits{RequestsPerMinute: 60, Burst: 2}, nil)
+	l.now = func() time.Time { return now }
+	for range 2 {
+		l.Allow("token:ci")
+	}
+	if _, ok := l.Allow("token:ci"); ok {
+		t.Fatal("request over the burst allowed")
+	}
+
+	// Once their budget is full again, idle clients are forgotten.
+	now = now.Add(2 * time.Minute)
+	if _, ok := l.Allow("token:other"); !ok {
+		t.Fatal("other client denied")
+	}
+	if _, ok := l.limiters["token:ci"]; ok || len(l.limiters) != 1 {
+		t.Errorf("limiters = %v, want only the active client", l.limiters)
+	}
+	if _, ok := l.Allow("token:ci"); !ok {
+		t.Error("evicted client denied")
+	}
+}
+
+func TestLimiterAcquire(t *testing.T) {
+	t.Parallel()
+	l := NewLimiter(Limits{MaxConcurrentScans: 1}, map[string]Limits{"batch": {MaxConcurrentScans: 2}})
+	release, ok := l.Acquire("ci")
+	if !ok {
+		t.Fatal("first scan denied")
+	}
+	if _, ok := l.Acquire("ci"); ok {
+		t.Error("scan over the quota allowed")
+	}
+	release()
+	release() // Releasing twice is harmless.
+	if _, ok := l.Acquire("ci"); !ok {
+		t.Error("scan denied after release")
+	}
+	for i := range 2 {
+		if _, ok := l.Acquire("batch"); !ok {
+			t.Fatalf("scan %d denied within the client's quota", i)
+		}
+	}
+	if _, ok := l.Acquire("batch"); ok {
+		t.Error("scan over the client's quota allowed")
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"golang.org/x/sync/singleflight"
+)
+
+// keysRefresh is how often the signing keys of the issuer are refreshed.
+const keysRefresh = time.Hour
+
+var (
+	errMissingClaim     = errors.New("missing claim")
+	errInvalidDiscovery = errors.New("invalid OpenID configuration")
+	errHTTPStatus       = errors.New("unexpected HTTP status")
+)
+
+var signatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// OIDC authenticates clients with JWTs issued by an OpenID Connect provider,
+// e.g. the tokens of GitHub Actions or of a company identity provider.
+type OIDC struct {
+	keysFetched time.Time
+	httpClient  *http.Client
+	now         func() time.Time
+	keys        *jose.JSONWebKeySet
+	// refreshing coalesces the concurrent refreshes of the keys.
+	refreshing singleflight.Group
+	issuer     string
+	audience   string
+	claim      string
+	// mu guards keys and keysFetched, but isn't held while fetching them.
+	mu sync.Mutex
+}
+
+// NewOIDC returns an authenticator for the tokens of issuer with the given
+// audience. Clients are identified by claim, "sub" if empty. The signing keys
+// are discovered from the issuer when first needed.
+func NewOIDC(issuer, audience, claim string) *OIDC {
+	if claim == "" {
+		claim = "sub"
+	}
+	return &OIDC{
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		now:        time.Now,
+		issuer:     strings.TrimSuffix(issuer, "/"),
+		audience:   audience,
+		claim:      claim,
+	}
+}
+
+// Authenticate implements Authenticator.
+func (o *OIDC) Authenticate(r *http.Request) (*Client, error) {
+	raw, ok := bearerToken(r)
+	if !ok || strings.Count(raw, ".") != 2 {
+		return nil, ErrNoCredentials
+	}
+	token, err := jwt.ParseSigned(raw, signatureAlgorithms)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+	if len(token.Headers) != 1 {
+		return nil, fmt.Errorf("%w: unexpected JWT headers", ErrInvalidCredentials)
+	}
+	key, err := o.key(r.Context(), token.Headers[0].KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims jwt.Claims
+	var all map[string]any
+	if err := token.Claims(key.Key, &claims, &all); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+	if claims.Expiry == nil {
+		return nil, fmt.Errorf("%w: token without expiry", ErrInvalidCredentials)
+	}
+	err = claims.Validate(jwt.Expected{
+		Issuer:      o.issuer,
+		AnyAudience: jwt.Audience{o.audience},
+		Time:        o.now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+	id, ok := all[o.claim].(string)
+	if !ok || id == "" {
+		return nil, fmt.Errorf("%w: %w: %s", ErrInvalidCredentials, errMissingClaim, o.claim)
+	}
+	return &Client{ID: id, Method: "oidc"}, nil
+}
+
+// key returns the signing key with the given ID, refreshing the keys of the
+// issuer if they are old or don't have it.
+func (o *OIDC) key(ctx context.Context, kid string) (*jose.JSONWebKey, error) {
+	o.mu.Lock()
+	keys, fetched := o.keys, o.keysFetched
+	o.mu.Unlock()
+	if keys == nil || o.now().Sub(fetched) > keysRefresh {
+		var err error
+		if keys, fetched, err = o.refreshKeys(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if found := keys.Key(kid); len(found) > 0 {
+		return &found[0], nil
+	}
+	// The issuer may have rotated its keys, but unknown key IDs mustn't make
+	// every request fetch them.
+	if o.now().Sub(fetched) > time.Minute {
+		keys, _, err := o.refreshKeys(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if found := keys.Key(kid); len(found) > 0 {
+			return &found[0], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: unknown signing key %q", ErrInvalidCredentials, kid)
+}
+
+// refreshKeys discovers and fetches the signing keys of the issuer, and
+// returns them with the time they were fetched. Concurrent callers share the
+// same fetch.
+func (o *OIDC) refreshKeys(ctx context.Context) (*jose.JSONWebKeySet, time.Time, error) {
+	v, err, _ := o.refreshing.Do("keys", func() (any, error) {
+		keys, err := o.fetchKeys(ctx)
+		if err != nil {
+			return nil, err
+		}
+		o.mu.Lock()
+		defer o.mu.Unlock()
+		o.keys, o.keysFetched = keys, o.now()
+		return fetchedKeys{keys: o.keys, fetched: o.keysFetched}, nil
+	})
+	if err != nil {
+		//nolint:wrapcheck // fetchKeys already wraps its errors.
+		return nil, time.Time{}, err
+	}
+	//nolint:forcetypeassert // The group only returns fetchedKeys.
+	f := v.(fetchedKeys)
+	return f.keys, f.fetched, nil
+}
+
+type fetchedKeys struct {
+	fetched time.Time
+	keys    *jose.JSONWebKeySet
+}
+
+// fetchKeys discovers and fetches the signing keys of the issuer.
+func (o *OIDC) fetchKeys(ctx context.Context) (*jose.JSONWebKeySet, error) {
+	var discovery struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := o.getJSON(ctx, o.issuer+"/.well-known/openid-configuration", &discovery); err != nil {
+		return nil, err
+	}
+	if strings.TrimSuffix(discovery.Issuer, "/") != o.issuer || discovery.JWKSURI == "" {
+		return nil, fmt.Errorf("%w: %s", errInvalidDiscovery, o.issuer)
+	}
+	var keys jose.JSONWebKeySet
+	if err := o.getJSON(ctx, discovery.JWKSURI, &keys); err != nil {
+		return nil, err
+	}
+	return &keys, nil
+}
+
+func (o *OIDC) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("http.NewRequestWithContext: %w", err)
+	}
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("fetching %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: fetching %s: %s", errHTTPStatus, url, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("decoding %s: %w", url, err)
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+// testIssuer is an OpenID Connect issuer serving its discovery document and keys.
+type testIssuer struct {
+	server  *httptest.Server
+	key     *ecdsa.PrivateKey
+	fetches atomic.Int32
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer := &testIssuer{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		//nolint:errchkjson // Test server.
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   issuer.server.URL,
+			"jwks_uri": issuer.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		issuer.fetches.Add(1)
+		//nolint:errchkjson // Test server.
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &key.PublicKey, KeyID: "k1", Algorithm: string(jose.ES256), Use: "sig"},
+		}})
+	})
+	issuer.server = httptest.NewServer(mux)
+	t.Cleanup(issuer.server.Close)
+	return issuer
+}
+
+func (i *testIssuer) sign(t *testing.T, kid string, claims ...any) string {
+	t.Helper()
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: i.key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", kid))
+	if err != nil {
+		t.Fatal(err)
+	}
+	builder := jwt.Signed(signer)
+	for _, c := range claims {
+		builder = builder.Claims(c)
+	}
+	token, err := builder.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestOIDC(t *testing.T) {
+	t.Parallel()
+	issuer := newTestIssuer(t)
+	now := time.Now()
+	valid := jwt.Claims{
+		Issuer:   issuer.server.URL,
+		Subject:  "repo:ossf/scorecard:ref:refs/heads/main",
+		Audience: jwt.Audience{"scorecard"},
+		Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+	}
+	expired := valid
+	expired.Expiry = jwt.NewNumericDate(now.Add(-time.Hour))
+	otherAudience := valid
+	otherAudience.Audience = jwt.Audience{"other"}
+	otherIssuer := valid
+	otherIssuer.Issuer = "https://issuer.example.com"
+	noExpiry := valid
+	noExpiry.Expiry = nil
+
+	tests := []struct {
+		wantErr error
+		name    string
+		token   string
+		claim   string
+		want    string
+	}{
+		{name: "valid token", token: issuer.sign(t, "k1", valid), want: valid.Subject},
+		{
+			name:  "custom claim",
+			token: issuer.sign(t, "k1", valid, map[string]any{"repository_owner": "ossf"}),
+			claim: "repository_owner",
+			want:  "ossf",
+		},
+		{name: "missing claim", token: issuer.sign(t, "k1", valid), claim: "repository_owner", wantErr: errMissingClaim},
+		{name: "expired", token: issuer.sign(t, "k1", expired), wantErr: ErrInvalidCredentials},
+		{name: "no expiry", token: issuer.sign(t, "k1", noExpiry), wantErr: ErrInvalidCredentials},
+		{name: "other audience", token: issuer.sign(t, "k1", otherAudience), wantErr: ErrInvalidCredentials},
+		{name: "other issuer", token: issuer.sign(t, "k1", otherIssuer), wantErr: ErrInvalidCredentials},
+		{name: "unknown key", token: issuer.sign(t, "k2", valid), wantErr: ErrInvalidCredentials},
+		{name: "not a JWT", token: "s3cret", wantErr: ErrNoCredentials},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			o := NewOIDC(issuer.server.URL+"/", "scorecard", tt.claim)
+			client, err := o.Authenticate(requestWithToken(tt.token))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Authenticate() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.want != "" && (client.ID != tt.want || client.Method != "oidc") {
+				t.Errorf("Authenticate() = %+v, want client %s", client, tt.want)
+			}
+		})
+	}
+}
+
+func TestOIDCKeyRefresh(t *testing.T) {
+	t.Parallel()
+	issuer := newTestIssuer(t)
+	now := time.Now()
+	o := NewOIDC(issuer.server.URL, "scorecard", "")
+	o.now = func() time.Time { return now }
+	claims := jwt.Claims{
+		Issuer:   issuer.server.URL,
+		Subject:  "ci",
+		Audience: jwt.Audience{"scorecard"},
+		Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+	}
+	valid := requestWithToken(issuer.sign(t, "k1", claims))
+	unknown := requestWithToken(issuer.sign(t, "k2", claims))
+
+	for range 3 {
+		if _, err := o.Authenticate(valid); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := o.Authenticate(unknown); !errors.Is(err, ErrInvalidCredentials) {
+			t.Fatalf("Authenticate() error = %v, want %v", err, ErrInvalidCredentials)
+		}
+	}
+	if n := issuer.fetches.Load(); n != 1 {
+		t.Errorf("keys fetched %d times, want once", n)
+	}
+
+	// Unknown keys refresh the keys, at most once a minute.
+	now = now.Add(2 * time.Minute)
+	for range 3 {
+		if _, err := o.Authenticate(unknown); !errors.Is(err, ErrInvalidCredentials) {
+			t.Fatalf("Authenticate() error = %v, want %v", err, ErrInvalidCredentials)
+		}
+	}
+	if n := issuer.fetches.Load(); n != 2 {
+		t.Errorf("keys fetched %d times, want twice", n)
+	}
+}
+
+func TestOIDCRefreshUnlocked(t *testing.T) {
+	t.Parallel()
+	issuer := newTestIssuer(t)
+	now := time.Now()
+	o := NewOIDC(issuer.server.URL, "scorecard", "")
+	claims := jwt.Claims{
+		Issuer:   issuer.server.URL,
+		Subject:  "ci",
+		Audience: jwt.Audience{"scorecard"},
+		Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+	}
+	valid := requestWithToken(issuer.sign(t, "k1", claims))
+	if _, err := o.Authenticate(valid); err != nil {
+		t.Fatal(err)
+	}
+
+	// Tokens with known keys are verified while unknown keys are fetched.
+	entered, blocked := make(chan struct{}), make(chan struct{})
+	o.httpClient = &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		close(entered)
+		<-blocked
+		return nil, r.Context().Err()
+	})}
+	o.now = func() time.Time { return now.Add(2 * time.Minute) }
+	done := make(chan error)
+	go func() {
+		_, err := o.Authenticate(requestWithToken(issuer.sign(t, "k2", claims)))
+		done <- err
+	}()
+	<-entered
+	if _, err := o.Authenticate(valid); err != nil {
+		t.Error(err)
+	}
+	close(blocked)
+	if err := <-done; err == nil {
+		t.Error("Authenticate() with an unfetched key succeeded")
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
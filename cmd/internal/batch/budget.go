@@ -0,0 +1,129 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package batch
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Budget is an http.RoundTripper sharing a GitHub API budget between the
+// concurrent scans of a batch. It spreads the requests to stay under a number
+// of requests per hour, and once GitHub reports the rate limit is exhausted,
+// holds all the requests until the limit resets, rather than letting each
+// scan run into it. Each token, identified by the Authorization header of the
+// requests, has its own budget, since GitHub limits each token separately.
+type Budget struct {
+	next            http.RoundTripper
+	tokens          map[[sha256.Size]byte]*tokenBudget
+	requestsPerHour int
+	mu              sync.Mutex
+}
+
+// tokenBudget is the budget of a token.
+type tokenBudget struct {
+	limiter  *rate.Limiter
+	resumeAt time.Time
+}
+
+// NewBudget returns a Budget sending requests with next. A requestsPerHour of
+// 0 only applies GitHub's rate limit.
+func NewBudget(next http.RoundTripper, requestsPerHour int) *Budget {
+	return &Budget{
+		next:            next,
+		tokens:          make(map[[sha256.Size]byte]*tokenBudget),
+		requestsPerHour: requestsPerHour,
+	}
+}
+
+// RoundTrip waits for the budget of the token of r to allow it and sends it.
+func (b *Budget) RoundTrip(r *http.Request) (*http.Response, error) {
+	t := b.token(r)
+	if err := b.wait(r.Context(), t); err != nil {
+		return nil, err
+	}
+	resp, err := b.next.RoundTrip(r)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // RoundTrippers return the errors of the transport as is.
+	}
+	b.observe(t, resp)
+	return resp, nil
+}
+
+// token returns the budget of the token of r. Only the hashes of the tokens
+// are kept.
+func (b *Budget) token(r *http.Request) *tokenBudget {
+	key := sha256.Sum256([]byte(r.Header.Get("Authorization")))
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	t, ok := b.tokens[key]
+	if !ok {
+		t = &tokenBudget{}
+		if b.requestsPerHour > 0 {
+			// Allow bursts of up to a minute's worth of requests.
+			burst := max(1, b.requestsPerHour/60)
+			t.limiter = rate.NewLimiter(rate.Limit(float64(b.requestsPerHour)/time.Hour.Seconds()), burst)
+		}
+		b.tokens[key] = t
+	}
+	return t
+}
+
+func (b *Budget) wait(ctx context.Context, t *tokenBudget) error {
+	b.mu.Lock()
+	pause := time.Until(t.resumeAt)
+	b.mu.Unlock()
+	if pause > 0 {
+		timer := time.NewTimer(pause)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for rate limit reset: %w", ctx.Err())
+		case <-timer.C:
+		}
+	}
+	if t.limiter != nil {
+		if err := t.limiter.Wait(ctx); err != nil {
+			return fmt.Errorf("waiting for requests budget: %w", err)
+		}
+	}
+	return nil
+}
+
+// observe pauses the requests of a token until the rate limit resets if resp
+// exhausted it.
+func (b *Budget) observe(t *tokenBudget, resp *http.Response) {
+	remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	if err != nil || remaining > 0 {
+		return
+	}
+	reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+	resumeAt := time.Unix(reset, 0)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if resumeAt.After(t.resumeAt) {
+		t.resumeAt = resumeAt
+	}
+}
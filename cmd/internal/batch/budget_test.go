@@ -0,0 +1,112 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package batch
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestBudgetPausesUntilReset(t *testing.T) {
+	t.Parallel()
+	reset := time.Now().Add(1500 * time.Millisecond)
+	var calls atomic.Int32
+	b := NewBudget(roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		resp := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: http.NoBody}
+		if calls.Add(1) == 1 {
+			resp.Header.Set("X-RateLimit-Remaining", "0")
+			resp.Header.Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix()+1, 10))
+		}
+		return resp, nil
+	}), 0)
+
+	req := httptest.NewRequest(http.MethodGet, "https://api.github.com/repos/ossf/scorecard", nil)
+	if _, err := b.RoundTrip(req); err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+
+	// Requests are held until the reset.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := b.RoundTrip(req.WithContext(ctx)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("RoundTrip before reset: got %v, want %v", err, context.DeadlineExceeded)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("sent %d requests before reset, want 1", got)
+	}
+}
+
+func TestBudgetRequestsPerHour(t *testing.T) {
+	t.Parallel()
+	var calls atomic.Int32
+	// 60 requests per hour allow a single request at once.
+	b := NewBudget(roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		calls.Add(1)
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: http.NoBody}, nil
+	}), 60)
+
+	req := httptest.NewRequest(http.MethodGet, "https://api.github.com/repos/ossf/scorecard", nil)
+	if _, err := b.RoundTrip(req); err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := b.RoundTrip(req.WithContext(ctx)); err == nil {
+		t.Error("RoundTrip over budget succeeded, want error")
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("sent %d requests, want 1", got)
+	}
+}
+
+func TestBudgetPerToken(t *testing.T) {
+	t.Parallel()
+	var calls atomic.Int32
+	b := NewBudget(roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		calls.Add(1)
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: http.NoBody}, nil
+	}), 60)
+
+	// Each token has its own budget of a request at once.
+	for _, token := range []string{"token-a", "token-b"} {
+		req := httptest.NewRequest(http.MethodGet, "https://api.github.com/repos/ossf/scorecard", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		if _, err := b.RoundTrip(req); err != nil {
+			t.Fatalf("RoundTrip with %s: %v", token, err)
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	req := httptest.NewRequestWithContext(ctx, http.MethodGet, "https://api.github.com/repos/ossf/scorecard", nil)
+	req.Header.Set("Authorization", "Bearer token-a")
+	if _, err := b.RoundTrip(req); err == nil {
+		t.Error("RoundTrip over the budget of the token succeeded, want error")
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("sent %d requests, want 2", got)
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package batch scans many repositories concurrently, sharing a GitHub API
+// budget, recording progress in a checkpoint file so that interrupted scans
+// can be resumed, and writing all the results to a single report.
+package batch
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	statusSucceeded = "succeeded"
+	statusFailed    = "failed"
+)
+
+// entry is a line of a checkpoint file.
+type entry struct {
+	Time   time.Time `json:"time"`
+	Repo   string    `json:"repo"`
+	Commit string    `json:"commit,omitempty"`
+	Status string    `json:"status"`
+	Error  string    `json:"error,omitempty"`
+	// ReportSize is the size of the report once the scan was reported.
+	ReportSize int64 `json:"report_size,omitempty"`
+}
+
+// Checkpoint records the outcome of each scan in a JSON lines file, so that
+// the repositories successfully scanned can be skipped by a later batch.
+// Failed scans are retried. It is safe for concurrent use.
+//
+// Each entry also records the size of the report, so that a later batch can
+// drop the rows reported after the last entry, whose scans are run again.
+type Checkpoint struct {
+	file       *os.File
+	done       map[string]bool
+	reportSize int64
+	mu         sync.Mutex
+}
+
+// OpenCheckpoint reads the checkpoint file at path, creating it if needed,
+// and opens it to record the outcome of new scans.
+func OpenCheckpoint(path string) (*Checkpoint, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening checkpoint: %w", err)
+	}
+	done, reportSize, size, err := readCheckpoint(f)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("reading checkpoint %s: %w", path, err)
+	}
+	// Drop an incomplete last line, so that new entries start on a new line.
+	if err := f.Truncate(size); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("truncating checkpoint %s: %w", path, err)
+	}
+	return &Checkpoint{file: f, done: done, reportSize: reportSize}, nil
+}
+
+// readCheckpoint returns the repositories whose last recorded scan succeeded,
+// the report size of the last entry and the size of the complete lines. An
+// incomplete last line, left by an interrupted batch, is ignored.
+func readCheckpoint(r io.Reader) (done map[string]bool, reportSize, size int64, err error) {
+	done = make(map[string]bool)
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			return done, reportSize, size, nil
+		}
+		if err != nil {
+			return nil, 0, 0, fmt.Errorf("reading line: %w", err)
+		}
+		size += int64(len(line))
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var e entry
+		if err := json.Unmarshal(line, &e); err != nil {
+			return nil, 0, 0, fmt.Errorf("parsing line: %w", err)
+		}
+		done[e.Repo] = e.Status == statusSucceeded
+		reportSize = e.ReportSize
+	}
+}
+
+// Done returns true if the last recorded scan of repo succeeded.
+func (c *Checkpoint) Done(repo string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.done[repo]
+}
+
+// ReportSize returns the size of the report recorded by the last entry, 0 if
+// there is none.
+func (c *Checkpoint) ReportSize() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.reportSize
+}
+
+// Record appends the outcome of the scan of repo at commit to the file, with
+// the size of the report once the scan was reported.
+func (c *Checkpoint) Record(repo, commit string, reportSize int64, scanErr error) error {
+	e := entry{
+		Time:       time.Now().UTC(),
+		Repo:       repo,
+		Commit:     commit,
+		Status:     statusSucceeded,
+		ReportSize: reportSize,
+	}
+	if scanErr != nil {
+		e.Status = statusFailed
+		e.Error = scanErr.Error()
+	}
+	line, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("encoding checkpoint: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, err := c.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("writing checkpoint: %w", err)
+	}
+	// The checkpoint is only useful if it survives the batch being killed.
+	if err := c.file.Sync(); err != nil {
+		return fmt.Errorf("syncing checkpoint: %w", err)
+	}
+	c.done[repo] = scanErr == nil
+	c.reportSize = reportSize
+	return nil
+}
+
+// Close closes the checkpoint file.
+func (c *Checkpoint) Close() error {
+	if err := c.file.Close(); err != nil {
+		return fmt.Errorf("closing checkpoint: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package batch
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var errScan = errors.New("scan failed")
+
+func TestCheckpoint(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "checkpoint.jsonl")
+	c, err := OpenCheckpoint(path)
+	if err != nil {
+		t.Fatalf("OpenCheckpoint: %v", err)
+	}
+	for i, r := range []struct {
+		err  error
+		repo string
+	}{
+		{repo: "github.com/ossf/scorecard"},
+		{repo: "github.com/ossf/scorecard-action", err: errScan},
+		{repo: "github.com/ossf/scorecard-webapp", err: errScan},
+		{repo: "github.com/ossf/scorecard-webapp"},
+	} {
+		if err := c.Record(r.repo, "0123456789abcdef", int64(100*(i+1)), r.err); err != nil {
+			t.Fatalf("Record: %v", err)
+		}
+	}
+	if err := c.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	// Simulate a batch killed while writing.
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.WriteString(`{"repo":"github.com/ossf/allstar","sta`); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	c, err = OpenCheckpoint(path)
+	if err != nil {
+		t.Fatalf("OpenCheckpoint: %v", err)
+	}
+	if got := c.ReportSize(); got != 400 {
+		t.Errorf("ReportSize() = %d, want 400", got)
+	}
+	if err := c.Record("github.com/ossf/allstar", "0123456789abcdef", 500, nil); err != nil {
+		t.Fatalf("Record: %v", err)
+	}
+	c.Close()
+
+	c, err = OpenCheckpoint(path)
+	if err != nil {
+		t.Fatalf("OpenCheckpoint: %v", err)
+	}
+	defer c.Close()
+	for repo, want := range map[string]bool{
+		"github.com/ossf/scorecard":        true,
+		"github.com/ossf/scorecard-action": false,
+		"github.com/ossf/scorecard-webapp": true,
+		"github.com/ossf/allstar":          true,
+		"github.com/ossf/package-analysis": false,
+	} {
+		if got := c.Done(repo); got != want {
+			t.Errorf("Done(%s) = %v, want %v", repo, got, want)
+		}
+	}
+}
+
+func TestOpenCheckpointInvalid(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "checkpoint.jsonl")
+	if err := os.WriteFile(path, []byte("not json\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := OpenCheckpoint(path); err == nil {
+		t.Error("OpenCheckpoint succeeded, want error")
+	}
+}
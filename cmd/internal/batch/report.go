@@ -0,0 +1,241 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package batch
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/ossf/scorecard/v5/checker"
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	"github.com/ossf/scorecard/v5/log"
+	"github.com/ossf/scorecard/v5/options"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+	"github.com/ossf/scorecard/v5/policy"
+)
+
+var errUnsupportedReport = errors.New("unsupported report")
+
+// Report consolidates the results of a batch.
+type Report interface {
+	// Add reports the result of the scan of repo, or why it failed.
+	Add(repo string, result *scorecard.Result, scanErr error) error
+	// Close writes what remains of the report.
+	Close() error
+}
+
+// ReportOptions configures a Report.
+type ReportOptions struct {
+	// Options are the options of the scans, for the level of detail.
+	Options *options.Options
+	// Policy returns the policy SARIF reports evaluate a result against.
+	Policy func(*scorecard.Result) *policy.ScorecardPolicy
+	// Docs documents the checks.
+	Docs docs.Doc
+	// Checks are the columns of CSV reports.
+	Checks []string
+	// Append is set when adding to an existing report, so CSV reports don't
+	// repeat their header.
+	Append bool
+}
+
+// NewReport returns a report in the given format, one of the options.Report*
+// constants, written to w.
+func NewReport(format string, w io.Writer, opts *ReportOptions) (Report, error) {
+	switch format {
+	case options.ReportJSONL:
+		return &jsonlReport{w: w, opts: opts}, nil
+	case options.ReportCSV:
+		r := &csvReport{w: csv.NewWriter(w), opts: opts}
+		if !opts.Append {
+			if err := r.writeHeader(); err != nil {
+				return nil, err
+			}
+		}
+		return r, nil
+	case options.ReportSarif:
+		return &sarifReport{w: w, opts: opts}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnsupportedReport, format)
+	}
+}
+
+// jsonlReport writes a line of JSON per repository, as with --format=json.
+// Failed scans are reported with the name of the repository and the error.
+type jsonlReport struct {
+	w    io.Writer
+	opts *ReportOptions
+}
+
+type jsonlError struct {
+	Repo struct {
+		Name string `json:"name"`
+	} `json:"repo"`
+	Error string `json:"error"`
+}
+
+func (r *jsonlReport) Add(repo string, result *scorecard.Result, scanErr error) error {
+	if scanErr != nil {
+		var e jsonlError
+		e.Repo.Name = repo
+		e.Error = scanErr.Error()
+		if err := json.NewEncoder(r.w).Encode(e); err != nil {
+			return fmt.Errorf("writing report: %w", err)
+		}
+		return nil
+	}
+	// Buffer the line, so that it isn't written partially.
+	var buf bytes.Buffer
+	if err := result.AsJSON2(&buf, r.opts.Docs, &scorecard.AsJSON2ResultOption{
+		Details:     r.opts.Options.ShowDetails,
+		Annotations: r.opts.Options.ShowAnnotations,
+		LogLevel:    log.ParseLevel(r.opts.Options.LogLevel),
+	}); err != nil {
+		return fmt.Errorf("formatting result of %s: %w", repo, err)
+	}
+	if _, err := r.w.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("writing report: %w", err)
+	}
+	return nil
+}
+
+func (r *jsonlReport) Close() error {
+	return nil
+}
+
+// csvReport writes a table of the aggregate and check scores of each
+// repository. Inconclusive scores are written as "?".
+type csvReport struct {
+	w    *csv.Writer
+	opts *ReportOptions
+}
+
+func (r *csvReport) writeHeader() error {
+	header := append([]string{"repo", "commit", "score"}, r.opts.Checks...)
+	header = append(header, "error")
+	return r.write(header)
+}
+
+func (r *csvReport) Add(repo string, result *scorecard.Result, scanErr error) error {
+	row := make([]string, 0, len(r.opts.Checks)+4)
+	if scanErr != nil {
+		row = append(row, repo, "", "")
+		for range r.opts.Checks {
+			row = append(row, "")
+		}
+		return r.write(append(row, scanErr.Error()))
+	}
+
+	score, err := result.GetAggregateScore(r.opts.Docs)
+	if err != nil {
+		return fmt.Errorf("computing score of %s: %w", repo, err)
+	}
+	row = append(row, result.Repo.Name, result.Repo.CommitSHA, formatScore(score))
+	scores := make(map[string]int, len(result.Checks))
+	for _, c := range result.Checks {
+		scores[c.Name] = c.Score
+	}
+	for _, name := range r.opts.Checks {
+		s, ok := scores[name]
+		if !ok {
+			row = append(row, "")
+			continue
+		}
+		row = append(row, formatScore(float64(s)))
+	}
+	return r.write(append(row, ""))
+}
+
+func formatScore(score float64) string {
+	if score == checker.InconclusiveResultScore {
+		return "?"
+	}
+	return strconv.FormatFloat(score, 'f', -1, 64)
+}
+
+func (r *csvReport) write(row []string) error {
+	if err := r.w.Write(row); err != nil {
+		return fmt.Errorf("writing report: %w", err)
+	}
+	// Flush each row, so that the report is complete if the batch is killed.
+	r.w.Flush()
+	if err := r.w.Error(); err != nil {
+		return fmt.Errorf("writing report: %w", err)
+	}
+	return nil
+}
+
+func (r *csvReport) Close() error {
+	return nil
+}
+
+// sarifReport writes a single SARIF log with a run per repository, on Close.
+// Each run identifies its repository and commit with versionControlProvenance.
+// Failed scans aren't reported.
+type sarifReport struct {
+	w    io.Writer
+	opts *ReportOptions
+	runs []map[string]any
+}
+
+type sarifLog struct {
+	Schema  string           `json:"$schema"`
+	Version string           `json:"version"`
+	Runs    []map[string]any `json:"runs"`
+}
+
+const sarifSchema = "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/main/sarif-2.1/schema/sarif-schema-2.1.0.json"
+
+func (r *sarifReport) Add(repo string, result *scorecard.Result, scanErr error) error {
+	if scanErr != nil {
+		return nil
+	}
+	var buf bytes.Buffer
+	o := r.opts.Options
+	if err := result.AsSARIF(o.ShowDetails, log.ParseLevel(o.LogLevel), &buf,
+		r.opts.Docs, r.opts.Policy(result), o); err != nil {
+		return fmt.Errorf("formatting result of %s: %w", repo, err)
+	}
+	var l sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &l); err != nil {
+		return fmt.Errorf("parsing SARIF of %s: %w", repo, err)
+	}
+	for _, run := range l.Runs {
+		run["versionControlProvenance"] = []map[string]any{{
+			"repositoryUri": "https://" + result.Repo.Name,
+			"revisionId":    result.Repo.CommitSHA,
+		}}
+		r.runs = append(r.runs, run)
+	}
+	return nil
+}
+
+func (r *sarifReport) Close() error {
+	l := sarifLog{Schema: sarifSchema, Version: "2.1.0", Runs: r.runs}
+	if l.Runs == nil {
+		l.Runs = []map[string]any{}
+	}
+	encoder := json.NewEncoder(r.w)
+	encoder.SetIndent("", "   ")
+	if err := encoder.Encode(l); err != nil {
+		return fmt.Errorf("writing report: %w", err)
+	}
+	return nil
+}
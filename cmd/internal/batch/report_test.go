@@ -0,0 +1,170 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package batch
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	"github.com/ossf/scorecard/v5/options"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+	"github.com/ossf/scorecard/v5/policy"
+)
+
+func testResult(repo string) *scorecard.Result {
+	return &scorecard.Result{
+		Repo: scorecard.RepoInfo{Name: repo, CommitSHA: "0123456789abcdef"},
+		Checks: []checker.CheckResult{
+			{Name: "Binary-Artifacts", Score: 10, Reason: "no binaries found in the repo", Version: 2},
+			{Name: "Code-Review", Score: checker.InconclusiveResultScore, Reason: "no commits", Version: 2},
+		},
+	}
+}
+
+func testReportOptions(t *testing.T) *ReportOptions {
+	t.Helper()
+	checkDocs, err := docs.Read()
+	if err != nil {
+		t.Fatalf("docs.Read: %v", err)
+	}
+	return &ReportOptions{
+		Options: options.New(),
+		Policy: func(*scorecard.Result) *policy.ScorecardPolicy {
+			return &policy.ScorecardPolicy{Version: 1, Policies: map[string]*policy.CheckPolicy{
+				"Binary-Artifacts": {Mode: policy.CheckPolicy_ENFORCED, Score: checker.MaxResultScore},
+				"Code-Review":      {Mode: policy.CheckPolicy_ENFORCED, Score: checker.MaxResultScore},
+			}}
+		},
+		Docs:   checkDocs,
+		Checks: []string{"Binary-Artifacts", "Code-Review"},
+	}
+}
+
+func addResults(t *testing.T, r Report) {
+	t.Helper()
+	if err := r.Add("github.com/ossf/scorecard", testResult("github.com/ossf/scorecard"), nil); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := r.Add("github.com/ossf/allstar", nil, errScan); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := r.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+}
+
+func TestCSVReport(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	r, err := NewReport(options.ReportCSV, &buf, testReportOptions(t))
+	if err != nil {
+		t.Fatalf("NewReport: %v", err)
+	}
+	addResults(t, r)
+	want := `repo,commit,score,Binary-Artifacts,Code-Review,error
+github.com/ossf/scorecard,0123456789abcdef,10,10,?,
+github.com/ossf/allstar,,,,,scan failed
+`
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("report mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestCSVReportAppend(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	opts := testReportOptions(t)
+	opts.Append = true
+	if _, err := NewReport(options.ReportCSV, &buf, opts); err != nil {
+		t.Fatalf("NewReport: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("header written when appending: %q", buf.String())
+	}
+}
+
+func TestJSONLReport(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	r, err := NewReport(options.ReportJSONL, &buf, testReportOptions(t))
+	if err != nil {
+		t.Fatalf("NewReport: %v", err)
+	}
+	addResults(t, r)
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2:\n%s", len(lines), buf.String())
+	}
+	var got []map[string]any
+	for _, line := range lines {
+		var m map[string]any
+		if err := json.Unmarshal([]byte(line), &m); err != nil {
+			t.Fatalf("parsing %q: %v", line, err)
+		}
+		got = append(got, m)
+	}
+	if _, ok := got[0]["checks"]; !ok {
+		t.Errorf("result has no checks: %s", lines[0])
+	}
+	if got[1]["error"] != errScan.Error() {
+		t.Errorf("failure has error %v, want %q", got[1]["error"], errScan.Error())
+	}
+}
+
+func TestSARIFReport(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	r, err := NewReport(options.ReportSarif, &buf, testReportOptions(t))
+	if err != nil {
+		t.Fatalf("NewReport: %v", err)
+	}
+	addResults(t, r)
+	var log struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			VersionControlProvenance []struct {
+				RepositoryURI string `json:"repositoryUri"`
+				RevisionID    string `json:"revisionId"`
+			} `json:"versionControlProvenance"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("parsing report: %v", err)
+	}
+	// A run per check of the scanned repo.
+	if log.Version != "2.1.0" || len(log.Runs) != 2 {
+		t.Fatalf("got version %q and %d runs, want 2.1.0 and 2", log.Version, len(log.Runs))
+	}
+	for _, run := range log.Runs {
+		vcp := run.VersionControlProvenance
+		if len(vcp) != 1 || vcp[0].RepositoryURI != "https://github.com/ossf/scorecard" ||
+			vcp[0].RevisionID != "0123456789abcdef" {
+			t.Errorf("unexpected versionControlProvenance %+v", vcp)
+		}
+	}
+}
+
+func TestNewReportUnsupported(t *testing.T) {
+	t.Parallel()
+	if _, err := NewReport("xml", &bytes.Buffer{}, testReportOptions(t)); err == nil {
+		t.Error("NewReport succeeded, want error")
+	}
+}
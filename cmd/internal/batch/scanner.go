@@ -0,0 +1,159 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package batch
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+)
+
+// ScanFunc scans a repository.
+type ScanFunc func(ctx context.Context, repo string) (*scorecard.Result, error)
+
+// Scanner scans repositories concurrently and reports their results.
+type Scanner struct {
+	// Scan scans a repository.
+	Scan ScanFunc
+	// Report receives the outcome of each scan.
+	Report Report
+	// Checkpoint, if set, records the outcome of each scan, and the
+	// repositories it records as scanned are skipped. Failed scans are then
+	// only recorded by the checkpoint, as they are retried when resuming.
+	Checkpoint *Checkpoint
+	// ReportSize, if set, returns the size of the report written so far, which
+	// the checkpoint records with each scan.
+	ReportSize func() int64
+	// OnDone, if set, is called after each scan.
+	OnDone func(repo string, result *scorecard.Result, err error)
+	// Parallelism is the number of concurrent scans. It is at least 1.
+	Parallelism int
+}
+
+// Summary counts the outcomes of the scans of a batch.
+type Summary struct {
+	Scanned int
+	Skipped int
+	Failed  int
+	// CheckErrors is the number of scans where a check had a runtime error.
+	CheckErrors int
+}
+
+// Run scans repos and returns a summary once all of them are reported. The
+// scans which fail are reported and counted, but don't stop the batch. An
+// error is returned if the report or the checkpoint can't be written.
+func (s *Scanner) Run(parent context.Context, repos []string) (Summary, error) {
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+
+	var (
+		summary  Summary
+		writeErr error
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+	)
+	// done records the outcome of a scan. Reports and checkpoints are
+	// written by one scan at a time.
+	done := func(repo string, result *scorecard.Result, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if writeErr != nil {
+			return
+		}
+		if err := s.record(repo, result, err); err != nil {
+			writeErr = err
+			cancel()
+			return
+		}
+		if err != nil {
+			summary.Failed++
+		} else {
+			summary.Scanned++
+			for _, c := range result.Checks {
+				if c.Error != nil {
+					summary.CheckErrors++
+					break
+				}
+			}
+		}
+		if s.OnDone != nil {
+			s.OnDone(repo, result, err)
+		}
+	}
+
+	queue := make(chan string)
+	for range max(1, s.Parallelism) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for repo := range queue {
+				result, err := s.Scan(ctx, repo)
+				done(repo, result, err)
+			}
+		}()
+	}
+
+feed:
+	for _, repo := range repos {
+		if s.Checkpoint != nil && s.Checkpoint.Done(repo) {
+			mu.Lock()
+			summary.Skipped++
+			mu.Unlock()
+			continue
+		}
+		select {
+		case queue <- repo:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	if writeErr != nil {
+		return summary, writeErr
+	}
+	if err := s.Report.Close(); err != nil {
+		return summary, fmt.Errorf("closing report: %w", err)
+	}
+	if err := parent.Err(); err != nil {
+		return summary, fmt.Errorf("scanning: %w", err)
+	}
+	return summary, nil
+}
+
+func (s *Scanner) record(repo string, result *scorecard.Result, scanErr error) error {
+	if s.Checkpoint == nil {
+		return s.Report.Add(repo, result, scanErr)
+	}
+	// A row reporting the failure would stay in the report when the retried
+	// scan adds its own.
+	if scanErr == nil {
+		if err := s.Report.Add(repo, result, nil); err != nil {
+			return err
+		}
+	}
+	var commit string
+	if result != nil {
+		commit = result.Repo.CommitSHA
+	}
+	var reportSize int64
+	if s.ReportSize != nil {
+		reportSize = s.ReportSize()
+	}
+	return s.Checkpoint.Record(repo, commit, reportSize, scanErr)
+}
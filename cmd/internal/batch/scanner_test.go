@@ -0,0 +1,199 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package batch
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/options"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+)
+
+func TestScanner(t *testing.T) {
+	t.Parallel()
+	repos := []string{
+		"github.com/ossf/scorecard",
+		"github.com/ossf/scorecard-action",
+		"github.com/ossf/scorecard-webapp",
+		"github.com/ossf/allstar",
+		"github.com/ossf/package-analysis",
+	}
+	checkpoint, err := OpenCheckpoint(filepath.Join(t.TempDir(), "checkpoint.jsonl"))
+	if err != nil {
+		t.Fatalf("OpenCheckpoint: %v", err)
+	}
+	defer checkpoint.Close()
+	if err := checkpoint.Record("github.com/ossf/scorecard", "0123456789abcdef", 0, nil); err != nil {
+		t.Fatalf("Record: %v", err)
+	}
+
+	var buf bytes.Buffer
+	report, err := NewReport(options.ReportCSV, &buf, testReportOptions(t))
+	if err != nil {
+		t.Fatalf("NewReport: %v", err)
+	}
+
+	var (
+		running, maxRunning atomic.Int32
+		mu                  sync.Mutex
+		scanned             []string
+	)
+	s := &Scanner{
+		Scan: func(ctx context.Context, repo string) (*scorecard.Result, error) {
+			n := running.Add(1)
+			defer running.Add(-1)
+			for {
+				m := maxRunning.Load()
+				if n <= m || maxRunning.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			mu.Lock()
+			scanned = append(scanned, repo)
+			mu.Unlock()
+			switch repo {
+			case "github.com/ossf/allstar":
+				return nil, errScan
+			case "github.com/ossf/package-analysis":
+				result := testResult(repo)
+				result.Checks[1].Error = errScan
+				return result, nil
+			default:
+				return testResult(repo), nil
+			}
+		},
+		Report:      report,
+		Checkpoint:  checkpoint,
+		ReportSize:  func() int64 { return int64(buf.Len()) },
+		Parallelism: 2,
+	}
+	summary, err := s.Run(context.Background(), repos)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	want := Summary{Scanned: 3, Skipped: 1, Failed: 1, CheckErrors: 1}
+	if diff := cmp.Diff(want, summary); diff != "" {
+		t.Errorf("summary mismatch (-want +got):\n%s", diff)
+	}
+	if got := maxRunning.Load(); got > 2 {
+		t.Errorf("%d concurrent scans, want at most 2", got)
+	}
+	if len(scanned) != 4 {
+		t.Errorf("scanned %v, want all but the checkpointed repo", scanned)
+	}
+	// The header, and a row per successfully scanned repo.
+	if got := strings.Count(buf.String(), "\n"); got != 4 {
+		t.Errorf("report has %d lines, want 4:\n%s", got, buf.String())
+	}
+	if got := checkpoint.ReportSize(); got != int64(buf.Len()) {
+		t.Errorf("checkpoint ReportSize() = %d, want %d", got, buf.Len())
+	}
+	for repo, want := range map[string]bool{
+		"github.com/ossf/scorecard-action": true,
+		"github.com/ossf/allstar":          false,
+		"github.com/ossf/package-analysis": true,
+	} {
+		if got := checkpoint.Done(repo); got != want {
+			t.Errorf("Done(%s) = %v, want %v", repo, got, want)
+		}
+	}
+}
+
+func TestScannerResumeFailed(t *testing.T) {
+	t.Parallel()
+	checkpoint, err := OpenCheckpoint(filepath.Join(t.TempDir(), "checkpoint.jsonl"))
+	if err != nil {
+		t.Fatalf("OpenCheckpoint: %v", err)
+	}
+	defer checkpoint.Close()
+	repos := []string{"github.com/ossf/scorecard", "github.com/ossf/allstar"}
+
+	var buf bytes.Buffer
+	for _, failing := range []string{"github.com/ossf/allstar", ""} {
+		report, err := NewReport(options.ReportJSONL, &buf, testReportOptions(t))
+		if err != nil {
+			t.Fatalf("NewReport: %v", err)
+		}
+		s := &Scanner{
+			Scan: func(ctx context.Context, repo string) (*scorecard.Result, error) {
+				if repo == failing {
+					return nil, errScan
+				}
+				return testResult(repo), nil
+			},
+			Report:      report,
+			Checkpoint:  checkpoint,
+			ReportSize:  func() int64 { return int64(buf.Len()) },
+			Parallelism: 1,
+		}
+		if _, err := s.Run(context.Background(), repos); err != nil {
+			t.Fatalf("Run: %v", err)
+		}
+	}
+
+	// The failed scan is retried, and each repo is reported once.
+	for _, repo := range repos {
+		if got := strings.Count(buf.String(), `"repo":{"name":"`+repo+`"`); got != 1 {
+			t.Errorf("%s reported %d times, want 1:\n%s", repo, got, buf.String())
+		}
+	}
+	if got := strings.Count(buf.String(), "\n"); got != len(repos) {
+		t.Errorf("report has %d lines, want %d:\n%s", got, len(repos), buf.String())
+	}
+}
+
+type failingReport struct {
+	adds int
+}
+
+func (r *failingReport) Add(string, *scorecard.Result, error) error {
+	r.adds++
+	return errScan
+}
+
+func (r *failingReport) Close() error {
+	return nil
+}
+
+func TestScannerReportError(t *testing.T) {
+	t.Parallel()
+	report := &failingReport{}
+	s := &Scanner{
+		Scan: func(ctx context.Context, repo string) (*scorecard.Result, error) {
+			return &scorecard.Result{Checks: []checker.CheckResult{{Name: "Code-Review"}}}, nil
+		},
+		Report:      report,
+		Parallelism: 1,
+	}
+	repos := []string{"github.com/ossf/scorecard", "github.com/ossf/allstar", "github.com/ossf/scorecard-action"}
+	if _, err := s.Run(context.Background(), repos); err == nil {
+		t.Fatal("Run succeeded, want error")
+	}
+	if report.adds != 1 {
+		t.Errorf("report written %d times after failing, want 1", report.adds)
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diff compares the results of two commits of a repository, e.g. the
+// base and head of a pull request, so that only the problems introduced by
+// the head are reported.
+package diff
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+	spol "github.com/ossf/scorecard/v5/policy"
+)
+
+// Diff is the comparison of the results of a head commit with the results of
+// a base commit.
+type Diff struct {
+	Repo string `json:"repo"`
+	Base Ref    `json:"base"`
+	Head Ref    `json:"head"`
+	// Checks are the checks whose score changed.
+	Checks []CheckDelta `json:"checks"`
+	// New are the warnings of the head which the base doesn't have.
+	New []Finding `json:"new"`
+	// Fixed are the warnings of the base which the head doesn't have.
+	Fixed []Finding `json:"fixed"`
+	// Violations are the policy rules which the head violates and the base
+	// doesn't.
+	Violations []Violation `json:"violations"`
+}
+
+// Ref is an analyzed commit.
+type Ref struct {
+	Name   string `json:"name"`
+	Commit string `json:"commit"`
+	// Score is the aggregate score, or -1 if it is inconclusive.
+	Score float64 `json:"score"`
+}
+
+// CheckDelta is the score of a check at the base and at the head. Scores are
+// -1 if they are inconclusive, or if the check didn't run.
+type CheckDelta struct {
+	Name string `json:"name"`
+	Base int    `json:"base"`
+	Head int    `json:"head"`
+}
+
+// Finding is a warning of a check.
+type Finding struct {
+	Check   string `json:"check"`
+	Probe   string `json:"probe,omitempty"`
+	Path    string `json:"path,omitempty"`
+	Snippet string `json:"snippet,omitempty"`
+	Message string `json:"message"`
+	// Line is the line of the warning in the compared commit.
+	Line uint `json:"line,omitempty"`
+}
+
+// Violation is a check whose score is below the minimum score of the policy.
+type Violation struct {
+	Check    string `json:"check"`
+	Score    int    `json:"score"`
+	MinScore int    `json:"minScore"`
+}
+
+// Compare compares the results of the head with those of the base. Warnings
+// are matched by probe, or check, path and snippet, so that those which only
+// moved to other lines are neither new nor fixed. With a policy, the checks
+// which violate it at the head but not at the base are reported.
+func Compare(
+	base, head Ref,
+	baseResult, headResult *scorecard.Result,
+	policy *spol.ScorecardPolicy,
+	checkDocs docs.Doc,
+) (*Diff, error) {
+	var err error
+	if base.Score, err = baseResult.GetAggregateScore(checkDocs); err != nil {
+		return nil, fmt.Errorf("computing score of %s: %w", base.Name, err)
+	}
+	if head.Score, err = headResult.GetAggregateScore(checkDocs); err != nil {
+		return nil, fmt.Errorf("computing score of %s: %w", head.Name, err)
+	}
+	d := &Diff{
+		Repo:       headResult.Repo.Name,
+		Base:       base,
+		Head:       head,
+		Checks:     []CheckDelta{},
+		New:        []Finding{},
+		Fixed:      []Finding{},
+		Violations: []Violation{},
+	}
+
+	baseChecks := checksByName(baseResult)
+	headChecks := checksByName(headResult)
+	for _, name := range checkNames(baseChecks, headChecks) {
+		baseCheck, headCheck := baseChecks[name], headChecks[name]
+		delta := CheckDelta{Name: name, Base: score(baseCheck), Head: score(headCheck)}
+		if delta.Base != delta.Head {
+			d.Checks = append(d.Checks, delta)
+		}
+		d.New = append(d.New, subtract(warnings(headCheck), warnings(baseCheck))...)
+		d.Fixed = append(d.Fixed, subtract(warnings(baseCheck), warnings(headCheck))...)
+		if policy == nil {
+			continue
+		}
+		minScore, ok := enforced(policy, name)
+		if ok && violates(headCheck, minScore) && !violates(baseCheck, minScore) {
+			d.Violations = append(d.Violations, Violation{Check: name, Score: delta.Head, MinScore: minScore})
+		}
+	}
+	return d, nil
+}
+
+// Regressed returns true if the head has new warnings or policy violations.
+func (d *Diff) Regressed() bool {
+	return len(d.New) > 0 || len(d.Violations) > 0
+}
+
+func checksByName(r *scorecard.Result) map[string]*checker.CheckResult {
+	checks := make(map[string]*checker.CheckResult, len(r.Checks))
+	for i := range r.Checks {
+		checks[r.Checks[i].Name] = &r.Checks[i]
+	}
+	return checks
+}
+
+func checkNames(checks ...map[string]*checker.CheckResult) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, m := range checks {
+		for name := range m {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func score(check *checker.CheckResult) int {
+	if check == nil {
+		return checker.InconclusiveResultScore
+	}
+	return check.Score
+}
+
+// enforced returns the minimum score of a check enforced by the policy.
+func enforced(policy *spol.ScorecardPolicy, name string) (int, bool) {
+	p, ok := policy.GetPolicies()[name]
+	if !ok || p.GetMode() != spol.CheckPolicy_ENFORCED {
+		return 0, false
+	}
+	return int(p.GetScore()), true
+}
+
+func violates(check *checker.CheckResult, minScore int) bool {
+	return check != nil && check.Score != checker.InconclusiveResultScore && check.Score < minScore
+}
+
+func warnings(check *checker.CheckResult) []Finding {
+	if check == nil {
+		return nil
+	}
+	var findings []Finding
+	for i := range check.Details {
+		detail := &check.Details[i]
+		if detail.Type != checker.DetailWarn {
+			continue
+		}
+		msg := &detail.Msg
+		f := Finding{
+			Check:   check.Name,
+			Path:    msg.Path,
+			Line:    msg.Offset,
+			Snippet: msg.Snippet,
+			Message: msg.Text,
+		}
+		if msg.Finding != nil {
+			f.Probe = msg.Finding.Probe
+			if f.Message == "" {
+				f.Message = msg.Finding.Message
+			}
+			if loc := msg.Finding.Location; loc != nil {
+				if f.Path == "" {
+					f.Path = loc.Path
+				}
+				if f.Line == 0 && loc.LineStart != nil {
+					f.Line = *loc.LineStart
+				}
+				if f.Snippet == "" && loc.Snippet != nil {
+					f.Snippet = *loc.Snippet
+				}
+			}
+		}
+		findings = append(findings, f)
+	}
+	return findings
+}
+
+// subtract returns the findings of a which b doesn't have, counting
+// duplicates, e.g. the same unpinned action used in two jobs of a workflow.
+func subtract(a, b []Finding) []Finding {
+	remaining := make(map[string]int, len(b))
+	for i := range b {
+		remaining[key(&b[i])]++
+	}
+	var findings []Finding
+	for i := range a {
+		k := key(&a[i])
+		if remaining[k] > 0 {
+			remaining[k]--
+			continue
+		}
+		findings = append(findings, a[i])
+	}
+	return findings
+}
+
+// key identifies a finding regardless of its line. Findings without a
+// snippet are identified by their message instead, which can name e.g. the
+// dependency or the permission.
+func key(f *Finding) string {
+	source := f.Probe
+	if source == "" {
+		source = f.Check
+	}
+	what := strings.TrimSpace(f.Snippet)
+	if what == "" {
+		what = f.Message
+	}
+	return strings.Join([]string{source, f.Path, what}, "\x00")
+}
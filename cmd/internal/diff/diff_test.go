@@ -0,0 +1,165 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v5/checker"
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+	spol "github.com/ossf/scorecard/v5/policy"
+)
+
+func unpinned(path string, line uint, snippet string) checker.CheckDetail {
+	return checker.CheckDetail{
+		Type: checker.DetailWarn,
+		Msg: checker.LogMessage{
+			Finding: &finding.Finding{
+				Probe:   "pinsDependencies",
+				Outcome: finding.OutcomeFalse,
+				Message: "GitHub-owned GitHubAction not pinned by hash",
+				Location: &finding.Location{
+					Path:      path,
+					LineStart: &line,
+					Snippet:   &snippet,
+				},
+			},
+		},
+	}
+}
+
+func result(checks ...checker.CheckResult) *scorecard.Result {
+	r := &scorecard.Result{Checks: checks}
+	r.Repo.Name = "github.com/ossf/scorecard"
+	return r
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+	checkDocs, err := docs.Read()
+	if err != nil {
+		t.Fatalf("docs.Read: %v", err)
+	}
+	const workflow = ".github/workflows/ci.yml"
+	policy := &spol.ScorecardPolicy{Policies: map[string]*spol.CheckPolicy{
+		"Pinned-Dependencies": {Mode: spol.CheckPolicy_ENFORCED, Score: 8},
+		"Binary-Artifacts":    {Mode: spol.CheckPolicy_ENFORCED, Score: 10},
+	}}
+	tests := []struct {
+		base, head     *scorecard.Result
+		policy         *spol.ScorecardPolicy
+		name           string
+		wantChecks     []CheckDelta
+		wantNew        []Finding
+		wantFixed      []Finding
+		wantViolations []Violation
+	}{
+		{
+			name: "moved findings are neither new nor fixed",
+			base: result(checker.CheckResult{Name: "Pinned-Dependencies", Score: 5, Details: []checker.CheckDetail{
+				unpinned(workflow, 10, "uses: actions/checkout@v4"),
+			}}),
+			head: result(checker.CheckResult{Name: "Pinned-Dependencies", Score: 5, Details: []checker.CheckDetail{
+				unpinned(workflow, 14, "uses: actions/checkout@v4"),
+			}}),
+			policy: policy,
+		},
+		{
+			name: "new and fixed findings",
+			base: result(checker.CheckResult{Name: "Pinned-Dependencies", Score: 5, Details: []checker.CheckDetail{
+				unpinned(workflow, 10, "uses: actions/checkout@v4"),
+				{Type: checker.DetailInfo, Msg: checker.LogMessage{Text: "pinned"}},
+			}}),
+			head: result(checker.CheckResult{Name: "Pinned-Dependencies", Score: 3, Details: []checker.CheckDetail{
+				unpinned(workflow, 10, "uses: actions/setup-go@v5"),
+				unpinned(workflow, 20, "uses: actions/setup-go@v5"),
+			}}),
+			wantChecks: []CheckDelta{{Name: "Pinned-Dependencies", Base: 5, Head: 3}},
+			wantNew: []Finding{
+				{
+					Check: "Pinned-Dependencies", Probe: "pinsDependencies", Path: workflow, Line: 10,
+					Snippet: "uses: actions/setup-go@v5", Message: "GitHub-owned GitHubAction not pinned by hash",
+				},
+				{
+					Check: "Pinned-Dependencies", Probe: "pinsDependencies", Path: workflow, Line: 20,
+					Snippet: "uses: actions/setup-go@v5", Message: "GitHub-owned GitHubAction not pinned by hash",
+				},
+			},
+			wantFixed: []Finding{{
+				Check: "Pinned-Dependencies", Probe: "pinsDependencies", Path: workflow, Line: 10,
+				Snippet: "uses: actions/checkout@v4", Message: "GitHub-owned GitHubAction not pinned by hash",
+			}},
+		},
+		{
+			name: "only new violations are reported",
+			base: result(
+				checker.CheckResult{Name: "Pinned-Dependencies", Score: 9},
+				checker.CheckResult{Name: "Binary-Artifacts", Score: 8},
+			),
+			head: result(
+				checker.CheckResult{Name: "Pinned-Dependencies", Score: 7},
+				checker.CheckResult{Name: "Binary-Artifacts", Score: 7},
+			),
+			policy: policy,
+			wantChecks: []CheckDelta{
+				{Name: "Binary-Artifacts", Base: 8, Head: 7},
+				{Name: "Pinned-Dependencies", Base: 9, Head: 7},
+			},
+			wantViolations: []Violation{{Check: "Pinned-Dependencies", Score: 7, MinScore: 8}},
+		},
+		{
+			name: "new checks",
+			base: result(),
+			head: result(checker.CheckResult{Name: "Binary-Artifacts", Score: 0, Details: []checker.CheckDetail{{
+				Type: checker.DetailWarn,
+				Msg:  checker.LogMessage{Text: "binary detected", Path: "bin/tool"},
+			}}}),
+			policy:         policy,
+			wantChecks:     []CheckDelta{{Name: "Binary-Artifacts", Base: checker.InconclusiveResultScore, Head: 0}},
+			wantNew:        []Finding{{Check: "Binary-Artifacts", Path: "bin/tool", Message: "binary detected"}},
+			wantViolations: []Violation{{Check: "Binary-Artifacts", Score: 0, MinScore: 10}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			d, err := Compare(Ref{Name: "main"}, Ref{Name: "feature"}, tt.base, tt.head, tt.policy, checkDocs)
+			if err != nil {
+				t.Fatalf("Compare: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantChecks, d.Checks, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("checks mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantNew, d.New, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("new findings mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantFixed, d.Fixed, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("fixed findings mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantViolations, d.Violations, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("violations mismatch (-want +got):\n%s", diff)
+			}
+			wantRegressed := len(tt.wantNew) > 0 || len(tt.wantViolations) > 0
+			if d.Regressed() != wantRegressed {
+				t.Errorf("Regressed() = %v, want %v", d.Regressed(), wantRegressed)
+			}
+		})
+	}
+}
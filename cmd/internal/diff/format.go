@@ -0,0 +1,134 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+)
+
+// maxListed is the number of findings listed in each section of the markdown
+// summary, which keeps it below the size limits of pull request comments.
+const maxListed = 50
+
+// WriteJSON writes the diff as JSON.
+func (d *Diff) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(d); err != nil {
+		return fmt.Errorf("encoding diff: %w", err)
+	}
+	return nil
+}
+
+// WriteMarkdown writes a summary of the diff which can be posted as a pull
+// request comment.
+func (d *Diff) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## OpenSSF Scorecard\n\n")
+	fmt.Fprintf(&b, "Comparing `%s` (%s) with `%s` (%s) of %s.\n\n",
+		d.Head.Name, shortSHA(d.Head.Commit), d.Base.Name, shortSHA(d.Base.Commit), d.Repo)
+	fmt.Fprintf(&b, "**Aggregate score:** %s → %s", formatScore(d.Base.Score), formatScore(d.Head.Score))
+	if d.Base.Score != checker.InconclusiveResultScore && d.Head.Score != checker.InconclusiveResultScore &&
+		d.Base.Score != d.Head.Score {
+		fmt.Fprintf(&b, " (%+.1f)", d.Head.Score-d.Base.Score)
+	}
+	b.WriteString("\n\n")
+
+	if len(d.Violations) > 0 {
+		fmt.Fprintf(&b, "### :x: Policy violations (%d)\n\n", len(d.Violations))
+		for _, v := range d.Violations {
+			fmt.Fprintf(&b, "- **%s**: score %d is below the minimum of %d\n", v.Check, v.Score, v.MinScore)
+		}
+		b.WriteString("\n")
+	}
+
+	if len(d.Checks) > 0 {
+		b.WriteString("### Score changes\n\n| Check | Base | Head |\n| --- | --- | --- |\n")
+		for _, c := range d.Checks {
+			fmt.Fprintf(&b, "| %s | %s | %s |\n", c.Name, formatCheckScore(c.Base), formatCheckScore(c.Head))
+		}
+		b.WriteString("\n")
+	}
+
+	writeFindings(&b, ":warning: New findings", d.New)
+	writeFindings(&b, ":white_check_mark: Fixed findings", d.Fixed)
+
+	if !d.Regressed() && len(d.Fixed) == 0 && len(d.Checks) == 0 {
+		b.WriteString("No new findings.\n")
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("writing diff: %w", err)
+	}
+	return nil
+}
+
+func writeFindings(b *strings.Builder, title string, findings []Finding) {
+	if len(findings) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "### %s (%d)\n\n", title, len(findings))
+	for i := range findings {
+		if i == maxListed {
+			fmt.Fprintf(b, "- … and %d more\n", len(findings)-maxListed)
+			break
+		}
+		f := &findings[i]
+		fmt.Fprintf(b, "- **%s**: %s", f.Check, f.Message)
+		if loc := location(f); loc != "" {
+			fmt.Fprintf(b, " (`%s`)", loc)
+		}
+		b.WriteString("\n")
+		if snippet := strings.TrimSpace(f.Snippet); snippet != "" && !strings.Contains(snippet, "```") {
+			fmt.Fprintf(b, "  ```\n  %s\n  ```\n", strings.ReplaceAll(snippet, "\n", "\n  "))
+		}
+	}
+	b.WriteString("\n")
+}
+
+func location(f *Finding) string {
+	if f.Path == "" || f.Line == 0 {
+		return f.Path
+	}
+	return fmt.Sprintf("%s:%d", f.Path, f.Line)
+}
+
+func shortSHA(sha string) string {
+	const length = 12
+	if len(sha) > length {
+		return sha[:length]
+	}
+	return sha
+}
+
+func formatCheckScore(score int) string {
+	if score == checker.InconclusiveResultScore {
+		return "?"
+	}
+	return strconv.Itoa(score)
+}
+
+func formatScore(score float64) string {
+	if score == checker.InconclusiveResultScore {
+		return "?"
+	}
+	return fmt.Sprintf("%.1f", score)
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWriteMarkdown(t *testing.T) {
+	t.Parallel()
+	d := &Diff{
+		Repo:   "github.com/ossf/scorecard",
+		Base:   Ref{Name: "main", Commit: "0123456789abcdef", Score: 7.5},
+		Head:   Ref{Name: "feature", Commit: "fedcba9876543210", Score: 7},
+		Checks: []CheckDelta{{Name: "Pinned-Dependencies", Base: 5, Head: 3}},
+		New: []Finding{{
+			Check: "Pinned-Dependencies", Path: ".github/workflows/ci.yml", Line: 10,
+			Snippet: "uses: actions/setup-go@v5", Message: "GitHub-owned GitHubAction not pinned by hash",
+		}},
+		Violations: []Violation{{Check: "Pinned-Dependencies", Score: 3, MinScore: 8}},
+	}
+	var buf bytes.Buffer
+	if err := d.WriteMarkdown(&buf); err != nil {
+		t.Fatalf("WriteMarkdown: %v", err)
+	}
+	want := "## OpenSSF Scorecard\n\n" +
+		"Comparing `feature` (fedcba987654) with `main` (0123456789ab) of github.com/ossf/scorecard.\n\n" +
+		"**Aggregate score:** 7.5 → 7.0 (-0.5)\n\n" +
+		"### :x: Policy violations (1)\n\n" +
+		"- **Pinned-Dependencies**: score 3 is below the minimum of 8\n\n" +
+		"### Score changes\n\n" +
+		"| Check | Base | Head |\n| --- | --- | --- |\n" +
+		"| Pinned-Dependencies | 5 | 3 |\n\n" +
+		"### :warning: New findings (1)\n\n" +
+		"- **Pinned-Dependencies**: GitHub-owned GitHubAction not pinned by hash (`.github/workflows/ci.yml:10`)\n" +
+		"  ```\n  uses: actions/setup-go@v5\n  ```\n\n"
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("markdown mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestWriteMarkdown_Truncated(t *testing.T) {
+	t.Parallel()
+	d := &Diff{}
+	for i := range maxListed + 5 {
+		d.Fixed = append(d.Fixed, Finding{Check: "Binary-Artifacts", Message: fmt.Sprintf("binary %d", i)})
+	}
+	var buf bytes.Buffer
+	if err := d.WriteMarkdown(&buf); err != nil {
+		t.Fatalf("WriteMarkdown: %v", err)
+	}
+	if got := strings.Count(buf.String(), "- **Binary-Artifacts**"); got != maxListed {
+		t.Errorf("listed %d findings, want %d", got, maxListed)
+	}
+	if !strings.Contains(buf.String(), "and 5 more") {
+		t.Errorf("markdown doesn't mention the unlisted findings:\n%s", buf.String())
+	}
+}
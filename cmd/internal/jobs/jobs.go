@@ -0,0 +1,386 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jobs runs scorecard analyses in the background for the HTTP server,
+// on a bounded pool of workers.
+package jobs
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+)
+
+var (
+	// ErrQueueFull indicates no more jobs can be queued.
+	ErrQueueFull = errors.New("job queue is full")
+	// ErrNotFound indicates the job doesn't exist or has expired.
+	ErrNotFound = errors.New("job not found")
+	// ErrFinished indicates the job can't be canceled since it's finished.
+	ErrFinished = errors.New("job already finished")
+	// ErrStopped indicates the manager doesn't accept jobs anymore.
+	ErrStopped = errors.New("job manager stopped")
+)
+
+// Status is the state of a job.
+type Status string
+
+const (
+	StatusQueued    Status = "queued"
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+	StatusCanceled  Status = "canceled"
+	// StatusCanceling is a running job which was canceled, until its worker returns.
+	StatusCanceling Status = "canceling"
+)
+
+// Finished returns true if the job won't change state anymore.
+func (s Status) Finished() bool {
+	return s == StatusSucceeded || s == StatusFailed || s == StatusCanceled
+}
+
+// CheckStatus is the state of a check of a job.
+type CheckStatus string
+
+const (
+	CheckPending CheckStatus = "pending"
+	CheckDone    CheckStatus = "done"
+	CheckFailed  CheckStatus = "failed"
+)
+
+// CheckProgress is the progress of a check of a job.
+type CheckProgress struct {
+	Name   string      `json:"name"`
+	Status CheckStatus `json:"status"`
+	Error  string      `json:"error,omitempty"`
+	Score  int         `json:"score"`
+}
+
+// Job is a snapshot of the state of a job.
+type Job struct {
+	Created         time.Time       `json:"created"`
+	Started         *time.Time      `json:"started,omitempty"`
+	Finished        *time.Time      `json:"finished,omitempty"`
+	ID              string          `json:"id"`
+	Repo            string          `json:"repo"`
+	Owner           string          `json:"owner,omitempty"`
+	Status          Status          `json:"status"`
+	Error           string          `json:"error,omitempty"`
+	Checks          []CheckProgress `json:"checks,omitempty"`
+	ShowDetails     bool            `json:"show_details,omitempty"`
+	ShowAnnotations bool            `json:"show_annotations,omitempty"`
+}
+
+// RunFunc runs the analysis of a job, calling progress with the result of
+// each check as it completes.
+type RunFunc func(ctx context.Context, progress func(checker.CheckResult)) (*scorecard.Result, error)
+
+// Spec describes a job to submit.
+type Spec struct {
+	Run RunFunc
+	// OnFinish, if set, is called once the job is finished, succeeded or not.
+	OnFinish func()
+	Repo     string
+	// Owner identifies the client which submitted the job.
+	Owner string
+	// Checks are reported as pending until they complete.
+	Checks []string
+	// ShowDetails and ShowAnnotations are kept to format the result.
+	ShowDetails     bool
+	ShowAnnotations bool
+}
+
+type job struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	run      RunFunc
+	onFinish func()
+	result   *scorecard.Result
+	state    Job
+}
+
+// expirySweep is the longest interval between two sweeps of the expired jobs.
+const expirySweep = time.Minute
+
+// Manager queues jobs and runs them on a fixed number of workers. Finished jobs
+// are kept for the configured retention, after which they are forgotten.
+type Manager struct {
+	ctx       context.Context
+	stop      context.CancelFunc
+	jobs      map[string]*job
+	queue     chan *job
+	now       func() time.Time
+	sweeper   *time.Ticker
+	wg        sync.WaitGroup
+	retention time.Duration
+	mu        sync.Mutex
+}
+
+// NewManager starts a manager running at most workers jobs at a time, with at
+// most queueSize jobs waiting for a worker.
+func NewManager(workers, queueSize int, retention time.Duration) *Manager {
+	ctx, stop := context.WithCancel(context.Background())
+	m := &Manager{
+		ctx:       ctx,
+		stop:      stop,
+		jobs:      make(map[string]*job),
+		queue:     make(chan *job, queueSize),
+		now:       time.Now,
+		sweeper:   time.NewTicker(max(min(retention, expirySweep), time.Second)),
+		retention: retention,
+	}
+	for range workers {
+		m.wg.Add(1)
+		go m.work()
+	}
+	m.wg.Add(1)
+	go m.sweep()
+	return m
+}
+
+// Submit queues a job. OnFinish isn't called if the job can't be queued.
+func (m *Manager) Submit(spec *Spec) (Job, error) {
+	id, err := newID()
+	if err != nil {
+		return Job{}, err
+	}
+	progress := make([]CheckProgress, 0, len(spec.Checks))
+	for _, c := range spec.Checks {
+		progress = append(progress, CheckProgress{Name: c, Status: CheckPending})
+	}
+	sort.Slice(progress, func(i, j int) bool { return progress[i].Name < progress[j].Name })
+	ctx, cancel := context.WithCancel(m.ctx)
+	j := &job{
+		ctx:      ctx,
+		cancel:   cancel,
+		run:      spec.Run,
+		onFinish: spec.OnFinish,
+		state: Job{
+			ID:              id,
+			Repo:            spec.Repo,
+			Owner:           spec.Owner,
+			Status:          StatusQueued,
+			Created:         m.now(),
+			Checks:          progress,
+			ShowDetails:     spec.ShowDetails,
+			ShowAnnotations: spec.ShowAnnotations,
+		},
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.ctx.Err() != nil {
+		cancel()
+		return Job{}, ErrStopped
+	}
+	m.expire()
+	select {
+	case m.queue <- j:
+	default:
+		cancel()
+		return Job{}, ErrQueueFull
+	}
+	m.jobs[id] = j
+	return j.snapshot(), nil
+}
+
+// Get returns the state of a job.
+func (m *Manager) Get(id string) (Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	j, ok := m.jobs[id]
+	if !ok {
+		return Job{}, ErrNotFound
+	}
+	return j.snapshot(), nil
+}
+
+// Result returns the state of a job and its result, which is nil unless the
+// job succeeded.
+func (m *Manager) Result(id string) (Job, *scorecard.Result, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	j, ok := m.jobs[id]
+	if !ok {
+		return Job{}, nil, ErrNotFound
+	}
+	return j.snapshot(), j.result, nil
+}
+
+// Cancel cancels a queued or running job. Queued jobs are canceled right away.
+// Running analyses stop at their next cancellation point, and the job is
+// reported as canceling until then, since it keeps its worker busy.
+func (m *Manager) Cancel(id string) (Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	j, ok := m.jobs[id]
+	if !ok {
+		return Job{}, ErrNotFound
+	}
+	if j.state.Status.Finished() {
+		return j.snapshot(), ErrFinished
+	}
+	j.cancel()
+	switch j.state.Status {
+	case StatusQueued:
+		m.finish(j, StatusCanceled, nil, context.Canceled)
+	case StatusRunning:
+		j.state.Status = StatusCanceling
+	default:
+		// Already canceling.
+	}
+	return j.snapshot(), nil
+}
+
+// Stop cancels all the jobs and waits for the workers to return. Queued jobs
+// are finished as canceled.
+func (m *Manager) Stop() {
+	m.mu.Lock()
+	m.stop()
+	for _, j := range m.jobs {
+		if j.state.Status == StatusQueued {
+			m.finish(j, StatusCanceled, nil, ErrStopped)
+		}
+	}
+	m.mu.Unlock()
+	m.wg.Wait()
+}
+
+// sweep forgets the expired jobs periodically, so that their results are
+// released even if no job is submitted.
+func (m *Manager) sweep() {
+	defer m.wg.Done()
+	defer m.sweeper.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-m.sweeper.C:
+			m.mu.Lock()
+			m.expire()
+			m.mu.Unlock()
+		}
+	}
+}
+
+func (m *Manager) work() {
+	defer m.wg.Done()
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case j := <-m.queue:
+			m.runJob(j)
+		}
+	}
+}
+
+func (m *Manager) runJob(j *job) {
+	m.mu.Lock()
+	if j.state.Status != StatusQueued {
+		// Canceled while queued.
+		m.mu.Unlock()
+		return
+	}
+	now := m.now()
+	j.state.Status = StatusRunning
+	j.state.Started = &now
+	m.mu.Unlock()
+
+	result, err := j.run(j.ctx, func(r checker.CheckResult) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		j.progress(r)
+	})
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	switch {
+	case j.state.Status == StatusCanceling:
+		if err == nil {
+			err = context.Canceled
+		}
+		m.finish(j, StatusCanceled, nil, err)
+	case err != nil && j.ctx.Err() != nil:
+		m.finish(j, StatusCanceled, nil, err)
+	case err != nil:
+		m.finish(j, StatusFailed, nil, err)
+	default:
+		m.finish(j, StatusSucceeded, result, nil)
+	}
+	j.cancel()
+}
+
+// finish records the outcome of a job. m.mu must be held.
+func (m *Manager) finish(j *job, status Status, result *scorecard.Result, err error) {
+	now := m.now()
+	j.state.Status = status
+	j.state.Finished = &now
+	j.result = result
+	if err != nil {
+		j.state.Error = err.Error()
+	}
+	if j.onFinish != nil {
+		j.onFinish()
+	}
+}
+
+// expire forgets the jobs finished for longer than the retention. m.mu must be held.
+func (m *Manager) expire() {
+	now := m.now()
+	for id, j := range m.jobs {
+		if j.state.Finished != nil && now.Sub(*j.state.Finished) > m.retention {
+			delete(m.jobs, id)
+		}
+	}
+}
+
+// progress records the result of a check. The manager's lock must be held.
+func (j *job) progress(r checker.CheckResult) {
+	status, msg := CheckDone, ""
+	if r.Error != nil {
+		status, msg = CheckFailed, r.Error.Error()
+	}
+	for i := range j.state.Checks {
+		if j.state.Checks[i].Name == r.Name {
+			j.state.Checks[i] = CheckProgress{Name: r.Name, Status: status, Score: r.Score, Error: msg}
+			return
+		}
+	}
+	j.state.Checks = append(j.state.Checks, CheckProgress{Name: r.Name, Status: status, Score: r.Score, Error: msg})
+}
+
+// snapshot returns a copy of the state of the job. The manager's lock must be held.
+func (j *job) snapshot() Job {
+	s := j.state
+	s.Checks = append([]CheckProgress(nil), j.state.Checks...)
+	return s
+}
+
+func newID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generating job ID: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
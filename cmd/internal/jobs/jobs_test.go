@@ -0,0 +1,266 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+)
+
+var errScan = errors.New("scan failed")
+
+// waitFor polls the job until it's in the given status.
+func waitFor(t *testing.T, m *Manager, id string, status Status) Job {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		job, err := m.Get(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if job.Status == status {
+			return job
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("job status = %s, want %s", job.Status, status)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestManager(t *testing.T) {
+	t.Parallel()
+	m := NewManager(1, 1, time.Hour)
+	defer m.Stop()
+
+	release := make(chan struct{})
+	job, err := m.Submit(&Spec{
+		Repo:   "github.com/ossf/scorecard",
+		Checks: []string{"Pinned-Dependencies", "Binary-Artifacts"},
+		Run: func(ctx context.Context, progress func(checker.CheckResult)) (*scorecard.Result, error) {
+			progress(checker.CheckResult{Name: "Binary-Artifacts", Score: 10})
+			<-release
+			progress(checker.CheckResult{Name: "Pinned-Dependencies", Error: errScan})
+			return &scorecard.Result{Repo: scorecard.RepoInfo{Name: "github.com/ossf/scorecard"}}, nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Status != StatusQueued || job.Checks[0].Name != "Binary-Artifacts" {
+		t.Errorf("unexpected submitted job: %+v", job)
+	}
+
+	running := waitFor(t, m, job.ID, StatusRunning)
+	if running.Checks[0].Status != CheckDone || running.Checks[1].Status != CheckPending {
+		t.Errorf("unexpected progress: %+v", running.Checks)
+	}
+
+	// The worker is busy, so the next job waits in the queue which is then full.
+	queued, err := m.Submit(&Spec{Run: func(ctx context.Context, _ func(checker.CheckResult)) (*scorecard.Result, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Submit(&Spec{}); !errors.Is(err, ErrQueueFull) {
+		t.Errorf("Submit() error = %v, want %v", err, ErrQueueFull)
+	}
+	if _, _, err := m.Result(queued.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	close(release)
+	done := waitFor(t, m, job.ID, StatusSucceeded)
+	if done.Checks[1].Status != CheckFailed || done.Checks[1].Error != errScan.Error() {
+		t.Errorf("unexpected progress: %+v", done.Checks)
+	}
+	_, result, err := m.Result(job.ID)
+	if err != nil || result == nil || result.Repo.Name != "github.com/ossf/scorecard" {
+		t.Errorf("Result() = %v, %v", result, err)
+	}
+	if _, err := m.Cancel(job.ID); !errors.Is(err, ErrFinished) {
+		t.Errorf("Cancel() error = %v, want %v", err, ErrFinished)
+	}
+
+	waitFor(t, m, queued.ID, StatusRunning)
+	canceled, err := m.Cancel(queued.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if canceled.Status != StatusCanceling {
+		t.Errorf("canceled job status = %s", canceled.Status)
+	}
+	waitFor(t, m, queued.ID, StatusCanceled)
+	if _, err := m.Get("unknown"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() error = %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestManagerFailureAndExpiry(t *testing.T) {
+	t.Parallel()
+	m := NewManager(1, 10, time.Minute)
+	defer m.Stop()
+	now := time.Now()
+	m.mu.Lock()
+	m.now = func() time.Time { return now }
+	m.mu.Unlock()
+
+	job, err := m.Submit(&Spec{Run: func(context.Context, func(checker.CheckResult)) (*scorecard.Result, error) {
+		return nil, errScan
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	failed := waitFor(t, m, job.ID, StatusFailed)
+	if failed.Error != errScan.Error() || failed.Finished == nil {
+		t.Errorf("unexpected failed job: %+v", failed)
+	}
+
+	// Finished jobs are forgotten after the retention, when new jobs are submitted.
+	m.mu.Lock()
+	m.now = func() time.Time { return now.Add(2 * time.Minute) }
+	m.mu.Unlock()
+	if _, err := m.Submit(&Spec{Run: func(context.Context, func(checker.CheckResult)) (*scorecard.Result, error) {
+		return &scorecard.Result{}, nil
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Get(job.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() error = %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestManagerSweep(t *testing.T) {
+	t.Parallel()
+	m := NewManager(1, 10, time.Minute)
+	defer m.Stop()
+	now := time.Now()
+	m.mu.Lock()
+	m.now = func() time.Time { return now }
+	m.mu.Unlock()
+
+	job, err := m.Submit(&Spec{Run: func(context.Context, func(checker.CheckResult)) (*scorecard.Result, error) {
+		return &scorecard.Result{}, nil
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, m, job.ID, StatusSucceeded)
+
+	// Finished jobs are forgotten after the retention without new submissions.
+	m.mu.Lock()
+	m.now = func() time.Time { return now.Add(2 * time.Minute) }
+	m.mu.Unlock()
+	m.sweeper.Reset(10 * time.Millisecond)
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if _, err := m.Get(job.ID); errors.Is(err, ErrNotFound) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("finished job wasn't swept")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestManagerStop(t *testing.T) {
+	t.Parallel()
+	m := NewManager(1, 10, time.Hour)
+
+	var finished sync.WaitGroup
+	finished.Add(2)
+	running, err := m.Submit(&Spec{
+		OnFinish: finished.Done,
+		Run: func(ctx context.Context, _ func(checker.CheckResult)) (*scorecard.Result, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, m, running.ID, StatusRunning)
+	queued, err := m.Submit(&Spec{OnFinish: finished.Done})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m.Stop()
+	// Both jobs release their quota.
+	finished.Wait()
+	for _, id := range []string{running.ID, queued.ID} {
+		if job, err := m.Get(id); err != nil || job.Status != StatusCanceled {
+			t.Errorf("Get(%s) = %s, %v, want %s", id, job.Status, err, StatusCanceled)
+		}
+	}
+}
+
+func TestManagerOnFinish(t *testing.T) {
+	t.Parallel()
+	m := NewManager(1, 10, time.Hour)
+	defer m.Stop()
+
+	var mu sync.Mutex
+	finished := map[string]int{}
+	onFinish := func(name string) func() {
+		return func() {
+			mu.Lock()
+			defer mu.Unlock()
+			finished[name]++
+		}
+	}
+	release := make(chan struct{})
+	running, err := m.Submit(&Spec{
+		Owner:    "ci",
+		OnFinish: onFinish("running"),
+		Run: func(context.Context, func(checker.CheckResult)) (*scorecard.Result, error) {
+			<-release
+			return &scorecard.Result{}, nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if running.Owner != "ci" {
+		t.Errorf("Owner = %q, want %q", running.Owner, "ci")
+	}
+	waitFor(t, m, running.ID, StatusRunning)
+
+	// A job canceled while queued is finished right away, and never runs.
+	queued, err := m.Submit(&Spec{OnFinish: onFinish("queued")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Cancel(queued.ID); err != nil {
+		t.Fatal(err)
+	}
+	close(release)
+	waitFor(t, m, running.ID, StatusSucceeded)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if finished["running"] != 1 || finished["queued"] != 1 {
+		t.Errorf("OnFinish calls = %v, want one per job", finished)
+	}
+}
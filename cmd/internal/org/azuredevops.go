@@ -0,0 +1,103 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package org
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+)
+
+var (
+	errAzureDevOpsExperimental = errors.New("support for Azure DevOps requires SCORECARD_EXPERIMENTAL")
+	errAzureDevOpsOrg          = errors.New("expected an Azure DevOps organization or project, e.g. dev.azure.com/org/project")
+)
+
+func isAzureDevOpsHost(host string) bool {
+	return host == "dev.azure.com" || strings.HasSuffix(host, ".visualstudio.com")
+}
+
+// azureDevOpsGitClient is the subset of git.Client used to list repositories.
+type azureDevOpsGitClient interface {
+	GetRepositories(ctx context.Context, args git.GetRepositoriesArgs) (*[]git.GitRepository, error)
+}
+
+// listAzureDevOpsRepos lists the repositories of an Azure DevOps organization
+// or project. Azure DevOps repositories have no topics, language or stars, and
+// the last activity is the one of their project.
+func listAzureDevOpsRepos(ctx context.Context, scheme, host, path string) ([]Repo, error) {
+	if _, experimental := os.LookupEnv("SCORECARD_EXPERIMENTAL"); !experimental {
+		return nil, errAzureDevOpsExperimental
+	}
+	org, project, _ := strings.Cut(path, "/")
+	if org == "" || strings.Contains(project, "/") {
+		return nil, fmt.Errorf("%w: %s/%s", errAzureDevOpsOrg, host, path)
+	}
+	connection := azuredevops.NewPatConnection(scheme+"://"+host+"/"+org, os.Getenv("AZURE_DEVOPS_AUTH_TOKEN"))
+	client, err := git.NewClient(ctx, connection)
+	if err != nil {
+		return nil, fmt.Errorf("creating azure devops git client: %w", err)
+	}
+	return listAzureDevOpsProjectRepos(ctx, client, project)
+}
+
+// listAzureDevOpsProjectRepos lists the repositories of project, or of all
+// the projects if it's empty.
+func listAzureDevOpsProjectRepos(ctx context.Context, client azureDevOpsGitClient, project string) ([]Repo, error) {
+	args := git.GetRepositoriesArgs{}
+	if project != "" {
+		args.Project = &project
+	}
+	gitRepos, err := client.GetRepositories(ctx, args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repos: %w", err)
+	}
+
+	var repos []Repo
+	for _, r := range *gitRepos {
+		if r.WebUrl == nil {
+			continue
+		}
+		repo := Repo{
+			URL:  *r.WebUrl,
+			Name: deref(r.Name),
+			// Disabled repositories can't be read, like archived ones.
+			Archived: r.IsDisabled != nil && *r.IsDisabled,
+			Fork:     r.IsFork != nil && *r.IsFork,
+		}
+		if p := r.Project; p != nil {
+			if p.Visibility != nil {
+				repo.Visibility = string(*p.Visibility)
+			}
+			if p.LastUpdateTime != nil {
+				repo.LastActivity = p.LastUpdateTime.Time
+			}
+		}
+		repos = append(repos, repo)
+	}
+	return repos, nil
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
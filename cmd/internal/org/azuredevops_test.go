@@ -0,0 +1,75 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package org
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+)
+
+type fakeAzureDevOpsGitClient struct {
+	project *string
+	repos   []git.GitRepository
+}
+
+func (c *fakeAzureDevOpsGitClient) GetRepositories(
+	ctx context.Context, args git.GetRepositoriesArgs,
+) (*[]git.GitRepository, error) {
+	c.project = args.Project
+	return &c.repos, nil
+}
+
+func TestListAzureDevOpsProjectRepos(t *testing.T) {
+	t.Parallel()
+	updated := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	project := &core.TeamProjectReference{
+		Visibility:     &core.ProjectVisibilityValues.Private,
+		LastUpdateTime: &azuredevops.Time{Time: updated},
+	}
+	client := &fakeAzureDevOpsGitClient{
+		repos: []git.GitRepository{
+			{WebUrl: toPtr("https://dev.azure.com/org/project/_git/repo1"), Project: project},
+			{
+				WebUrl:     toPtr("https://dev.azure.com/org/project/_git/repo2"),
+				IsFork:     toPtr(true),
+				IsDisabled: toPtr(true),
+			},
+		},
+	}
+	repos, err := listAzureDevOpsProjectRepos(context.Background(), client, "project")
+	if err != nil {
+		t.Fatalf("listAzureDevOpsProjectRepos: %v", err)
+	}
+	if client.project == nil || *client.project != "project" {
+		t.Errorf("listed project %v, want project", client.project)
+	}
+	want := []Repo{
+		{URL: "https://dev.azure.com/org/project/_git/repo1", Visibility: "private", LastActivity: updated},
+		{URL: "https://dev.azure.com/org/project/_git/repo2", Archived: true, Fork: true},
+	}
+	if diff := cmp.Diff(want, repos); diff != "" {
+		t.Errorf("repos mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func toPtr[T any](v T) *T {
+	return &v
+}
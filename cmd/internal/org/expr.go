@@ -0,0 +1,456 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package org
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var errInvalidExpression = errors.New("invalid filter expression")
+
+// Expression selects repositories by their properties, e.g.
+//
+//	not fork and not archived and language in (Go, Python) and pushed > 90d
+//
+// Expressions combine comparisons with "and", "or", "not" and parentheses.
+// The properties are:
+//   - fork, archived, template and mirror, which are true or false. They can
+//     be used alone, e.g. "not fork", or compared, e.g. "fork = false".
+//   - name, language, visibility and topic, which are compared with "=",
+//     "!=" and "in (...)", case-insensitively. Values can use the wildcards of
+//     [path.Match], e.g. name = "scorecard-*". A repository matches a topic if
+//     any of its topics does.
+//   - pushed, the last activity, which is compared with "<", "<=", ">" and
+//     ">=" to a date (2006-01-02) or a duration before now (90d, 720h), e.g.
+//     "pushed > 90d" for the repositories active in the last 90 days.
+//     Repositories whose last activity is unknown never match.
+//   - stars, which is compared with "=", "!=", "<", "<=", ">" and ">=".
+//
+// Properties the forge doesn't report, like the language of GitLab projects,
+// are empty, false or 0.
+type Expression struct {
+	match  func(*Repo) bool
+	source string
+}
+
+// ParseExpression parses an expression. now is the time durations are
+// relative to.
+func ParseExpression(s string, now time.Time) (*Expression, error) {
+	tokens, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens, now: now}
+	match, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok, ok := p.peek(); ok {
+		return nil, p.errorf("unexpected %q", tok.text)
+	}
+	return &Expression{match: match, source: s}, nil
+}
+
+// Match returns true if the expression selects r.
+func (e *Expression) Match(r *Repo) bool {
+	return e.match(r)
+}
+
+// String returns the source of the expression.
+func (e *Expression) String() string {
+	return e.source
+}
+
+var (
+	boolProperties = map[string]func(*Repo) bool{
+		"fork":     func(r *Repo) bool { return r.Fork },
+		"archived": func(r *Repo) bool { return r.Archived },
+		"template": func(r *Repo) bool { return r.Template },
+		"mirror":   func(r *Repo) bool { return r.Mirror },
+	}
+	stringProperties = map[string]func(*Repo) []string{
+		"name":       func(r *Repo) []string { return []string{r.Name} },
+		"language":   func(r *Repo) []string { return []string{r.Language} },
+		"visibility": func(r *Repo) []string { return []string{r.Visibility} },
+		"topic":      func(r *Repo) []string { return r.Topics },
+	}
+	timeProperties = map[string]func(*Repo) time.Time{
+		"pushed": func(r *Repo) time.Time { return r.LastActivity },
+	}
+	intProperties = map[string]func(*Repo) int{
+		"stars": func(r *Repo) int { return r.Stars },
+	}
+)
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenString
+	tokenOperator
+	tokenPunct
+)
+
+type token struct {
+	text string
+	kind tokenKind
+	pos  int
+}
+
+// is returns true if the token is the given keyword, operator or punctuation.
+func (t token) is(text string) bool {
+	return t.kind != tokenString && strings.EqualFold(t.text, text)
+}
+
+func tokenize(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case c == '(' || c == ')' || c == ',':
+			tokens = append(tokens, token{text: s[i : i+1], kind: tokenPunct, pos: i})
+			i++
+		case strings.ContainsRune("=!<>", rune(c)):
+			n := 1
+			if i+1 < len(s) && s[i+1] == '=' {
+				n = 2
+			}
+			op := s[i : i+n]
+			if op == "!" {
+				return nil, fmt.Errorf("%w: unexpected %q at %d", errInvalidExpression, op, i)
+			}
+			tokens = append(tokens, token{text: op, kind: tokenOperator, pos: i})
+			i += n
+		case c == '"' || c == '\'':
+			end := strings.IndexByte(s[i+1:], c)
+			if end < 0 {
+				return nil, fmt.Errorf("%w: unterminated string at %d", errInvalidExpression, i)
+			}
+			tokens = append(tokens, token{text: s[i+1 : i+1+end], kind: tokenString, pos: i})
+			i += end + 2
+		default:
+			end := i
+			for end < len(s) && !unicode.IsSpace(rune(s[end])) && !strings.ContainsRune(`(),=!<>"'`, rune(s[end])) {
+				end++
+			}
+			tokens = append(tokens, token{text: s[i:end], kind: tokenWord, pos: i})
+			i = end
+		}
+	}
+	return tokens, nil
+}
+
+type parser struct {
+	now    time.Time
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() (token, bool) {
+	if p.pos >= len(p.tokens) {
+		return token{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *parser) next() (token, error) {
+	tok, ok := p.peek()
+	if !ok {
+		return token{}, fmt.Errorf("%w: unexpected end", errInvalidExpression)
+	}
+	p.pos++
+	return tok, nil
+}
+
+// accept consumes the next token if it is text.
+func (p *parser) accept(text string) bool {
+	if tok, ok := p.peek(); ok && tok.is(text) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(text string) error {
+	tok, err := p.next()
+	if err != nil {
+		return err
+	}
+	if !tok.is(text) {
+		p.pos--
+		return p.errorf("expected %q, got %q", text, tok.text)
+	}
+	return nil
+}
+
+// errorf returns an error at the position of the current token.
+func (p *parser) errorf(format string, args ...any) error {
+	pos := -1
+	if tok, ok := p.peek(); ok {
+		pos = tok.pos
+	}
+	return fmt.Errorf("%w: %s at %d", errInvalidExpression, fmt.Sprintf(format, args...), pos)
+}
+
+func (p *parser) parseOr() (func(*Repo) bool, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("or") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(r *Repo) bool { return l(r) || right(r) }
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (func(*Repo) bool, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("and") {
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(r *Repo) bool { return l(r) && right(r) }
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (func(*Repo) bool, error) {
+	if p.accept("not") {
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return func(r *Repo) bool { return !operand(r) }, nil
+	}
+	if p.accept("(") {
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return expr, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (func(*Repo) bool, error) {
+	tok, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if tok.kind != tokenWord {
+		p.pos--
+		return nil, p.errorf("expected a property, got %q", tok.text)
+	}
+	name := strings.ToLower(tok.text)
+	if get, ok := boolProperties[name]; ok {
+		return p.parseBool(get)
+	}
+	if get, ok := stringProperties[name]; ok {
+		return p.parseString(get)
+	}
+	if get, ok := timeProperties[name]; ok {
+		return p.parseTime(get)
+	}
+	if get, ok := intProperties[name]; ok {
+		return p.parseInt(get)
+	}
+	p.pos--
+	return nil, p.errorf("unknown property %q", tok.text)
+}
+
+// operator consumes a comparison operator among allowed.
+func (p *parser) operator(allowed ...string) (string, error) {
+	tok, err := p.next()
+	if err != nil {
+		return "", err
+	}
+	for _, op := range allowed {
+		if tok.is(op) {
+			return op, nil
+		}
+	}
+	p.pos--
+	return "", p.errorf("expected one of %s, got %q", strings.Join(allowed, " "), tok.text)
+}
+
+func (p *parser) value() (token, error) {
+	tok, err := p.next()
+	if err != nil {
+		return token{}, err
+	}
+	if tok.kind != tokenWord && tok.kind != tokenString {
+		p.pos--
+		return token{}, p.errorf("expected a value, got %q", tok.text)
+	}
+	return tok, nil
+}
+
+func (p *parser) parseBool(get func(*Repo) bool) (func(*Repo) bool, error) {
+	tok, ok := p.peek()
+	if !ok || (!tok.is("=") && !tok.is("!=")) {
+		return get, nil
+	}
+	op, err := p.operator("=", "!=")
+	if err != nil {
+		return nil, err
+	}
+	v, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	want, err := strconv.ParseBool(v.text)
+	if err != nil {
+		p.pos--
+		return nil, p.errorf("expected true or false, got %q", v.text)
+	}
+	if op == "!=" {
+		want = !want
+	}
+	return func(r *Repo) bool { return get(r) == want }, nil
+}
+
+func (p *parser) parseString(get func(*Repo) []string) (func(*Repo) bool, error) {
+	op, err := p.operator("=", "!=", "in")
+	if err != nil {
+		return nil, err
+	}
+	var patterns []string
+	if op == "in" {
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		for {
+			v, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			patterns = append(patterns, strings.ToLower(v.text))
+			if !p.accept(",") {
+				break
+			}
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+	} else {
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		patterns = append(patterns, strings.ToLower(v.text))
+	}
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("%w: invalid pattern %q", errInvalidExpression, pattern)
+		}
+	}
+
+	matches := func(r *Repo) bool {
+		return slices.ContainsFunc(get(r), func(value string) bool {
+			value = strings.ToLower(value)
+			return slices.ContainsFunc(patterns, func(pattern string) bool {
+				ok, err := path.Match(pattern, value)
+				return err == nil && ok
+			})
+		})
+	}
+	if op == "!=" {
+		return func(r *Repo) bool { return !matches(r) }, nil
+	}
+	return matches, nil
+}
+
+func (p *parser) parseTime(get func(*Repo) time.Time) (func(*Repo) bool, error) {
+	op, err := p.operator("<=", ">=", "<", ">")
+	if err != nil {
+		return nil, err
+	}
+	v, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	t, err := ParseActiveSince(v.text, p.now)
+	if err != nil {
+		p.pos--
+		return nil, p.errorf("expected a date or a duration, got %q", v.text)
+	}
+	return func(r *Repo) bool {
+		got := get(r)
+		if got.IsZero() {
+			return false
+		}
+		return compare(got.Compare(t), op)
+	}, nil
+}
+
+func (p *parser) parseInt(get func(*Repo) int) (func(*Repo) bool, error) {
+	op, err := p.operator("=", "!=", "<=", ">=", "<", ">")
+	if err != nil {
+		return nil, err
+	}
+	v, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(v.text)
+	if err != nil {
+		p.pos--
+		return nil, p.errorf("expected a number, got %q", v.text)
+	}
+	return func(r *Repo) bool {
+		return compare(cmp.Compare(get(r), n), op)
+	}, nil
+}
+
+// compare returns whether the result of a comparison, -1, 0 or 1, satisfies op.
+func compare(c int, op string) bool {
+	switch op {
+	case "=":
+		return c == 0
+	case "!=":
+		return c != 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	default:
+		return c >= 0
+	}
+}
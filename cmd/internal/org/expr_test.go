@@ -0,0 +1,95 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package org
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestExpression(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	scorecard := Repo{
+		Name:         "scorecard",
+		Language:     "Go",
+		Visibility:   "public",
+		Topics:       []string{"security", "supply-chain"},
+		Stars:        4500,
+		LastActivity: now.AddDate(0, 0, -3),
+	}
+	fork := Repo{
+		Name:         "scorecard-fork",
+		Language:     "Go",
+		Visibility:   "public",
+		Stars:        2,
+		LastActivity: now.AddDate(-1, 0, 0),
+		Fork:         true,
+	}
+	template := Repo{Name: "python-template", Language: "Python", Template: true, Archived: true}
+
+	tests := []struct {
+		expr string
+		want []bool // scorecard, fork, template
+	}{
+		{expr: "not fork and not archived", want: []bool{true, false, false}},
+		{expr: "fork = true or template != false", want: []bool{false, true, true}},
+		{expr: "language in (Go, python)", want: []bool{true, true, true}},
+		{expr: "language = go and pushed > 90d", want: []bool{true, false, false}},
+		{expr: "pushed <= 2025-01-01", want: []bool{false, true, false}},
+		{expr: `name = "scorecard*" and not (fork or stars < 100)`, want: []bool{true, false, false}},
+		{expr: "topic = security", want: []bool{true, false, false}},
+		{expr: "topic != security", want: []bool{false, true, true}},
+		{expr: "visibility='public' AND stars>=2", want: []bool{true, true, false}},
+		{expr: "not fork and not archived and language in (Go, Python) and pushed > 90d", want: []bool{true, false, false}},
+	}
+	for _, tt := range tests {
+		e, err := ParseExpression(tt.expr, now)
+		if err != nil {
+			t.Errorf("ParseExpression(%q): %v", tt.expr, err)
+			continue
+		}
+		for i, r := range []Repo{scorecard, fork, template} {
+			if got := e.Match(&r); got != tt.want[i] {
+				t.Errorf("%q matches %s = %v, want %v", tt.expr, r.Name, got, tt.want[i])
+			}
+		}
+	}
+}
+
+func TestParseExpressionErrors(t *testing.T) {
+	t.Parallel()
+	for _, expr := range []string{
+		"",
+		"fork and",
+		"stars",
+		"stars > many",
+		"pushed = 90d",
+		"fork = maybe",
+		"license = MIT",
+		"language in (Go",
+		"language in ()",
+		`name = "scorecard`,
+		"(fork",
+		"fork archived",
+		"name = [",
+		"!fork",
+	} {
+		if _, err := ParseExpression(expr, time.Now()); !errors.Is(err, errInvalidExpression) {
+			t.Errorf("ParseExpression(%q) error = %v, want %v", expr, err, errInvalidExpression)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package org
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var errInvalidActiveSince = errors.New("invalid active since, expected a date (2006-01-02) or a duration (90d, 720h)")
+
+// Repo is a repository of an organization, with the properties filters
+// apply to.
+type Repo struct {
+	// LastActivity is when the repository was last pushed to, or updated. It
+	// is zero if the forge doesn't report it.
+	LastActivity time.Time
+	URL          string
+	Name         string
+	// Visibility is one of "public", "private" or "internal".
+	Visibility string
+	// Language is the main language, if the forge reports it.
+	Language string
+	Topics   []string
+	Stars    int
+	Archived bool
+	Fork     bool
+	Template bool
+	Mirror   bool
+}
+
+// Inclusion is whether repositories with a property are listed.
+type Inclusion string
+
+const (
+	// Include lists repositories whether or not they have the property.
+	Include Inclusion = "include"
+	// Exclude only lists repositories without the property.
+	Exclude Inclusion = "exclude"
+	// Only lists repositories with the property.
+	Only Inclusion = "only"
+)
+
+func (i Inclusion) match(has bool, def Inclusion) bool {
+	if i == "" {
+		i = def
+	}
+	switch i {
+	case Exclude:
+		return !has
+	case Only:
+		return has
+	default:
+		return true
+	}
+}
+
+// Filter selects the repositories of an organization. The zero value selects
+// the repositories which aren't archived.
+type Filter struct {
+	// ActiveSince, if set, excludes the repositories without activity since,
+	// including those whose last activity is unknown.
+	ActiveSince time.Time
+	// Archived defaults to Exclude, or to Include with an Expression, which
+	// can select archived repositories itself.
+	Archived Inclusion
+	// Forks defaults to Include.
+	Forks Inclusion
+	// Visibility, if set, selects the repositories with one of these
+	// visibilities.
+	Visibility []string
+	// Topics, if set, selects the repositories with one of these topics.
+	Topics []string
+	// Expression, if set, selects the repositories it matches, among the
+	// ones selected by the other fields.
+	Expression *Expression
+}
+
+// Match returns true if the filter selects r.
+func (f *Filter) Match(r *Repo) bool {
+	archived := Exclude
+	if f.Expression != nil {
+		archived = Include
+	}
+	if !f.Archived.match(r.Archived, archived) || !f.Forks.match(r.Fork, Include) {
+		return false
+	}
+	if len(f.Visibility) > 0 && !slices.Contains(f.Visibility, r.Visibility) {
+		return false
+	}
+	if len(f.Topics) > 0 && !slices.ContainsFunc(r.Topics, func(topic string) bool {
+		return slices.ContainsFunc(f.Topics, func(t string) bool { return strings.EqualFold(t, topic) })
+	}) {
+		return false
+	}
+	if !f.ActiveSince.IsZero() && r.LastActivity.Before(f.ActiveSince) {
+		return false
+	}
+	if f.Expression != nil && !f.Expression.Match(r) {
+		return false
+	}
+	return true
+}
+
+// ParseActiveSince parses a date, e.g. "2025-01-31", or a duration before
+// now, e.g. "90d" or "720h".
+func ParseActiveSince(s string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.DateOnly, s); err == nil {
+		return t, nil
+	}
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return time.Time{}, fmt.Errorf("%w: %s", errInvalidActiveSince, s)
+		}
+		return now.AddDate(0, 0, -n), nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return time.Time{}, fmt.Errorf("%w: %s", errInvalidActiveSince, s)
+	}
+	return now.Add(-d), nil
+}
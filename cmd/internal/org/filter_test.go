@@ -0,0 +1,107 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package org
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFilterMatch(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	repo := Repo{
+		URL:          "https://gitlab.com/group/project",
+		Visibility:   "internal",
+		Topics:       []string{"go", "security"},
+		LastActivity: now.AddDate(0, -1, 0),
+		Fork:         true,
+	}
+	archived := repo
+	archived.Archived = true
+
+	tests := []struct {
+		name   string
+		filter Filter
+		repo   Repo
+		want   bool
+	}{
+		{name: "zero value", repo: repo, want: true},
+		{name: "archived excluded by default", repo: archived, want: false},
+		{name: "archived included", filter: Filter{Archived: Include}, repo: archived, want: true},
+		{name: "only archived", filter: Filter{Archived: Only}, repo: repo, want: false},
+		{name: "forks excluded", filter: Filter{Forks: Exclude}, repo: repo, want: false},
+		{name: "only forks", filter: Filter{Forks: Only}, repo: repo, want: true},
+		{name: "visibility", filter: Filter{Visibility: []string{"public", "internal"}}, repo: repo, want: true},
+		{name: "other visibility", filter: Filter{Visibility: []string{"public"}}, repo: repo, want: false},
+		{name: "topic", filter: Filter{Topics: []string{"Security"}}, repo: repo, want: true},
+		{name: "other topic", filter: Filter{Topics: []string{"rust"}}, repo: repo, want: false},
+		{name: "active", filter: Filter{ActiveSince: now.AddDate(0, -2, 0)}, repo: repo, want: true},
+		{name: "inactive", filter: Filter{ActiveSince: now.AddDate(0, 0, -7)}, repo: repo, want: false},
+		{name: "unknown activity", filter: Filter{ActiveSince: now}, repo: Repo{}, want: false},
+		{name: "expression", filter: Filter{Expression: mustParse(t, "fork and topic = go")}, repo: repo, want: true},
+		{name: "other expression", filter: Filter{Expression: mustParse(t, "not fork")}, repo: repo, want: false},
+		{name: "archived expression", filter: Filter{Expression: mustParse(t, "archived")}, repo: archived, want: true},
+		{
+			name:   "archived expression with archived excluded",
+			filter: Filter{Archived: Exclude, Expression: mustParse(t, "archived")},
+			repo:   archived,
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.filter.Match(&tt.repo); got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func mustParse(t *testing.T, expr string) *Expression {
+	t.Helper()
+	e, err := ParseExpression(expr, time.Now())
+	if err != nil {
+		t.Fatalf("ParseExpression(%q): %v", expr, err)
+	}
+	return e
+}
+
+func TestParseActiveSince(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		want    time.Time
+		in      string
+		wantErr bool
+	}{
+		{in: "2025-01-31", want: time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)},
+		{in: "90d", want: now.AddDate(0, 0, -90)},
+		{in: "36h", want: now.Add(-36 * time.Hour)},
+		{in: "-3d", wantErr: true},
+		{in: "last year", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseActiveSince(tt.in, now)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseActiveSince(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("ParseActiveSince(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package org
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/go-github/v82/github"
+
+	"github.com/ossf/scorecard/v5/clients/githubrepo/roundtripper"
+	"github.com/ossf/scorecard/v5/log"
+)
+
+// listGitHubRepos lists the repositories of a GitHub organization.
+func listGitHubRepos(ctx context.Context, orgName string, rt http.RoundTripper) ([]Repo, error) {
+	// Parse org name if needed.
+	if len(orgName) > 0 {
+		if parsed := parseOrgName(orgName); parsed != "" {
+			orgName = parsed
+		}
+	}
+
+	// Use the centralized transport so we respect token rotation, GitHub App
+	// auth, rate limiting and instrumentation already implemented in
+	// clients/githubrepo/roundtripper.
+	logger := log.NewLogger(log.DefaultLevel)
+	if rt == nil {
+		rt = roundtripper.NewTransport(ctx, logger)
+	}
+	httpClient := &http.Client{Transport: rt}
+	client := github.NewClient(httpClient)
+
+	opt := &github.RepositoryListByOrgOptions{
+		Type: "all",
+	}
+
+	var repos []Repo
+	for {
+		page, resp, err := client.Repositories.ListByOrg(ctx, orgName, opt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list repos: %w", err)
+		}
+
+		for _, r := range page {
+			repos = append(repos, Repo{
+				URL:          r.GetHTMLURL(),
+				Name:         r.GetName(),
+				Visibility:   r.GetVisibility(),
+				Language:     r.GetLanguage(),
+				Topics:       r.Topics,
+				Stars:        r.GetStargazersCount(),
+				LastActivity: r.GetPushedAt().Time,
+				Archived:     r.GetArchived(),
+				Fork:         r.GetFork(),
+				Template:     r.GetIsTemplate(),
+				Mirror:       r.GetMirrorURL() != "",
+			})
+		}
+
+		if resp == nil {
+			return nil, ErrNilResponse
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return repos, nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package org
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// listGitLabRepos lists the projects of a GitLab group and its subgroups.
+// GitLab doesn't report the language of projects when listing them.
+func listGitLabRepos(ctx context.Context, scheme, host, group string) ([]Repo, error) {
+	baseURL := scheme + "://" + host
+	// GL_HOST may include a path, which isn't part of the group.
+	if h := os.Getenv("GL_HOST"); h != "" {
+		if !strings.Contains(h, "://") {
+			h = "https://" + h
+		}
+		hostURL, err := url.Parse(h)
+		if err == nil && strings.EqualFold(hostURL.Host, host) {
+			prefix := strings.Trim(hostURL.Path, "/")
+			if rest, ok := strings.CutPrefix(group, prefix+"/"); ok && prefix != "" {
+				baseURL += "/" + prefix
+				group = rest
+			}
+		}
+	}
+
+	client, err := gitlab.NewClient(os.Getenv("GITLAB_AUTH_TOKEN"), gitlab.WithBaseURL(baseURL))
+	if err != nil {
+		return nil, fmt.Errorf("creating gitlab client: %w", err)
+	}
+
+	opt := &gitlab.ListGroupProjectsOptions{
+		ListOptions:      gitlab.ListOptions{PerPage: 100},
+		IncludeSubGroups: gitlab.Ptr(true),
+	}
+	var repos []Repo
+	for {
+		projects, resp, err := client.Groups.ListGroupProjects(group, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list projects of group %s: %w", group, err)
+		}
+		for _, p := range projects {
+			r := Repo{
+				URL:        p.WebURL,
+				Name:       p.Path,
+				Visibility: string(p.Visibility),
+				Topics:     p.Topics,
+				Stars:      int(p.StarCount),
+				Archived:   p.Archived,
+				Fork:       p.ForkedFromProject != nil,
+				Mirror:     p.Mirror,
+			}
+			if p.LastActivityAt != nil {
+				r.LastActivity = *p.LastActivityAt
+			}
+			repos = append(repos, r)
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+	return repos, nil
+}
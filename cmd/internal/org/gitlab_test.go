@@ -0,0 +1,76 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package org
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestListGitLabRepos(t *testing.T) {
+	t.Parallel()
+	pages := []string{
+		`[
+			{"web_url": "https://gitlab.example.com/group/project1", "visibility": "public",
+			 "topics": ["go"], "last_activity_at": "2025-05-01T00:00:00Z"},
+			{"web_url": "https://gitlab.example.com/group/sub/project2", "visibility": "internal",
+			 "forked_from_project": {"id": 1}}
+		]`,
+		`[{"web_url": "https://gitlab.example.com/group/sub/project3", "visibility": "private", "archived": true}]`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/api/v4/groups/group%2Fsub/projects" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.URL.Query().Get("include_subgroups") != "true" {
+			t.Errorf("subgroups aren't included: %s", r.URL)
+		}
+		page := 0
+		if r.URL.Query().Get("page") == "2" {
+			page = 1
+		} else {
+			w.Header().Set("X-Next-Page", "2")
+		}
+		fmt.Fprint(w, pages[page])
+	}))
+	defer srv.Close()
+
+	host := strings.TrimPrefix(srv.URL, "http://")
+	repos, err := listGitLabRepos(context.Background(), "http", host, "group/sub")
+	if err != nil {
+		t.Fatalf("listGitLabRepos: %v", err)
+	}
+	want := []Repo{
+		{
+			URL:          "https://gitlab.example.com/group/project1",
+			Visibility:   "public",
+			Topics:       []string{"go"},
+			LastActivity: time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{URL: "https://gitlab.example.com/group/sub/project2", Visibility: "internal", Fork: true},
+		{URL: "https://gitlab.example.com/group/sub/project3", Visibility: "private", Archived: true},
+	}
+	if diff := cmp.Diff(want, repos); diff != "" {
+		t.Errorf("repos mismatch (-want +got):\n%s", diff)
+	}
+}
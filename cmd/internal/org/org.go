@@ -17,70 +17,68 @@ package org
 import (
 	"context"
 	"errors"
-	"fmt"
 	"net/http"
 	"strings"
-
-	"github.com/google/go-github/v82/github"
-
-	"github.com/ossf/scorecard/v5/clients/githubrepo/roundtripper"
-	"github.com/ossf/scorecard/v5/log"
 )
 
 // ErrNilResponse indicates the GitHub API returned a nil response object.
 var ErrNilResponse = errors.New("nil response from GitHub API")
 
-// ListOrgRepos lists all non-archived repositories for a GitHub organization.
-// The caller should provide an http.RoundTripper (rt). If rt is nil, the
-// default transport will be created via roundtripper.NewTransport.
-func ListOrgRepos(ctx context.Context, orgName string, rt http.RoundTripper) ([]string, error) {
-	// Parse org name if needed.
-	if len(orgName) > 0 {
-		if parsed := parseOrgName(orgName); parsed != "" {
-			orgName = parsed
-		}
+// ListOrgRepos lists the repositories of an organization selected by filter,
+// or the ones which aren't archived if filter is nil. The organization is one
+// of:
+//   - a GitHub organization, e.g. "ossf" or "github.com/ossf".
+//   - a GitLab group, including its subgroups, e.g. "gitlab.com/group/subgroup".
+//   - an Azure DevOps organization or project, e.g. "dev.azure.com/org/project".
+//
+// GitHub requests are sent with rt. If rt is nil, the default transport will
+// be created via roundtripper.NewTransport.
+func ListOrgRepos(ctx context.Context, orgName string, filter *Filter, rt http.RoundTripper) ([]string, error) {
+	if filter == nil {
+		filter = &Filter{}
 	}
 
-	// Use the centralized transport so we respect token rotation, GitHub App
-	// auth, rate limiting and instrumentation already implemented in
-	// clients/githubrepo/roundtripper.
-	logger := log.NewLogger(log.DefaultLevel)
-	if rt == nil {
-		rt = roundtripper.NewTransport(ctx, logger)
+	var repos []Repo
+	var err error
+	scheme, host, path := splitOrg(orgName)
+	switch {
+	case host == "" || host == "github.com":
+		repos, err = listGitHubRepos(ctx, orgName, rt)
+	case isAzureDevOpsHost(host):
+		repos, err = listAzureDevOpsRepos(ctx, scheme, host, path)
+	default:
+		repos, err = listGitLabRepos(ctx, scheme, host, path)
 	}
-	httpClient := &http.Client{Transport: rt}
-	client := github.NewClient(httpClient)
-
-	opt := &github.RepositoryListByOrgOptions{
-		Type: "all",
+	if err != nil {
+		return nil, err
 	}
 
 	var urls []string
-	for {
-		repos, resp, err := client.Repositories.ListByOrg(ctx, orgName, opt)
-		if err != nil {
-			return nil, fmt.Errorf("failed to list repos: %w", err)
-		}
-
-		for _, r := range repos {
-			if r.GetArchived() {
-				continue
-			}
-			urls = append(urls, r.GetHTMLURL())
+	for i := range repos {
+		if filter.Match(&repos[i]) {
+			urls = append(urls, repos[i].URL)
 		}
-
-		if resp == nil {
-			return nil, ErrNilResponse
-		}
-		if resp.NextPage == 0 {
-			break
-		}
-		opt.Page = resp.NextPage
 	}
-
 	return urls, nil
 }
 
+// splitOrg splits an organization into the scheme and host of its forge, if
+// any, and its path.
+func splitOrg(input string) (scheme, host, path string) {
+	s := strings.TrimSpace(input)
+	scheme = "https"
+	if before, after, ok := strings.Cut(s, "://"); ok {
+		scheme, s = before, after
+	}
+	s = strings.Trim(s, "/")
+	first, rest, _ := strings.Cut(s, "/")
+	// Hosts are told apart from GitHub organizations by their dots or ports.
+	if !strings.ContainsAny(first, ".:") {
+		return scheme, "", s
+	}
+	return scheme, strings.ToLower(first), rest
+}
+
 // parseOrgName extracts the GitHub organization from a supported input.
 // Supported:
 //   - owner > owner
@@ -61,7 +61,7 @@ func TestListOrgRepos_PaginationAndArchived(t *testing.T) {
 	// Override TransportFactory to redirect requests to our test server.
 	rt := roundTripperToServer(srv.URL)
 
-	repos, err := ListOrgRepos(context.Background(), "owner", rt)
+	repos, err := ListOrgRepos(context.Background(), "owner", nil, rt)
 	if err != nil {
 		t.Fatalf("ListOrgRepos returned error: %v", err)
 	}
@@ -74,6 +74,50 @@ func TestListOrgRepos_PaginationAndArchived(t *testing.T) {
 	}
 }
 
+func TestSplitOrg(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		in, scheme, host, path string
+	}{
+		{"ossf", "https", "", "ossf"},
+		{"github.com/ossf", "https", "github.com", "ossf"},
+		{"https://gitlab.com/group/subgroup/", "https", "gitlab.com", "group/subgroup"},
+		{"http://localhost:8080/group", "http", "localhost:8080", "group"},
+		{"dev.azure.com/org/project", "https", "dev.azure.com", "org/project"},
+	}
+	for _, c := range cases {
+		scheme, host, path := splitOrg(c.in)
+		if scheme != c.scheme || host != c.host || path != c.path {
+			t.Errorf("splitOrg(%q) = %q, %q, %q; want %q, %q, %q", c.in, scheme, host, path, c.scheme, c.host, c.path)
+		}
+	}
+}
+
+// Test ListOrgRepos filters GitHub repos by their properties.
+func TestListOrgRepos_Filter(t *testing.T) {
+	t.Parallel()
+	body := `[
+        {"html_url": "https://github.com/owner/repo1", "fork": true, "visibility": "public"},
+        {"html_url": "https://github.com/owner/repo2", "topics": ["security"], "visibility": "public"},
+        {"html_url": "https://github.com/owner/repo3", "topics": ["security"], "visibility": "internal"}
+    ]`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer srv.Close()
+
+	filter := &Filter{Forks: Exclude, Visibility: []string{"public"}, Topics: []string{"Security"}}
+	repos, err := ListOrgRepos(context.Background(), "github.com/owner", filter, roundTripperToServer(srv.URL))
+	if err != nil {
+		t.Fatalf("ListOrgRepos returned error: %v", err)
+	}
+	if len(repos) != 1 || repos[0] != "https://github.com/owner/repo2" {
+		t.Fatalf("unexpected repos: %v", repos)
+	}
+}
+
 // roundTripperToServer returns an http.RoundTripper that rewrites requests
 // to the given serverURL, keeping the path and query intact.
 func roundTripperToServer(serverURL string) http.RoundTripper {
@@ -0,0 +1,266 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullrequest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+var (
+	errAzureDevOpsExperimental = errors.New("support for Azure DevOps requires SCORECARD_EXPERIMENTAL")
+	errNoIterations            = errors.New("pull request has no iterations")
+)
+
+// fileDiffBatch is the number of files whose diffs are requested at once.
+const fileDiffBatch = 10
+
+// azureDevOpsGitClient is the subset of git.Client used to fetch pull
+// requests, and GetFileDiffs, which the SDK doesn't implement.
+type azureDevOpsGitClient interface {
+	GetPullRequest(ctx context.Context, args git.GetPullRequestArgs) (*git.GitPullRequest, error)
+	GetPullRequestIterations(ctx context.Context, args git.GetPullRequestIterationsArgs) (*[]git.GitPullRequestIteration, error)
+	GetPullRequestIterationChanges(
+		ctx context.Context, args git.GetPullRequestIterationChangesArgs,
+	) (*git.GitPullRequestIterationChanges, error)
+	GetFileDiffs(ctx context.Context, args getFileDiffsArgs) (*[]git.FileDiff, error)
+}
+
+// getFileDiffsArgs are the arguments of GetFileDiffs.
+type getFileDiffsArgs struct {
+	FileDiffsCriteria *git.FileDiffsCriteria
+	Project           *string
+	RepositoryID      *string
+}
+
+// azureDevOpsClient adds GetFileDiffs to the git client of the SDK.
+type azureDevOpsClient struct {
+	git.Client
+	rest *azuredevops.Client
+	// orgURL is the URL of the organization, e.g. https://dev.azure.com/org.
+	orgURL string
+}
+
+// GetFileDiffs returns the line diff blocks of files between two commits.
+func (c *azureDevOpsClient) GetFileDiffs(ctx context.Context, args getFileDiffsArgs) (*[]git.FileDiff, error) {
+	body, err := json.Marshal(args.FileDiffsCriteria)
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal: %w", err)
+	}
+	diffsURL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/filediffs",
+		c.orgURL, url.PathEscape(*args.Project), url.PathEscape(*args.RepositoryID))
+	req, err := c.rest.CreateRequestMessage(
+		ctx,
+		http.MethodPost,
+		diffsURL,
+		"7.1",
+		bytes.NewReader(body),
+		"application/json",
+		"application/json",
+		map[string]string{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("client.CreateRequestMessage: %w", err)
+	}
+	res, err := c.rest.SendRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("client.SendRequest: %w", err)
+	}
+	var diffs []git.FileDiff
+	if err := c.rest.UnmarshalCollectionBody(res, &diffs); err != nil {
+		return nil, fmt.Errorf("reading file diffs: %w", err)
+	}
+	return &diffs, nil
+}
+
+func fetchAzureDevOps(ctx context.Context, repo clients.Repo, number int) (*PullRequest, error) {
+	if _, experimental := os.LookupEnv("SCORECARD_EXPERIMENTAL"); !experimental {
+		return nil, errAzureDevOpsExperimental
+	}
+	// The path of Azure DevOps repositories is org/project/_git/name.
+	parts := strings.Split(repo.Path(), "/")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("%w: %s", errUnsupportedRepo, repo.URI())
+	}
+	org, project, name := parts[0], parts[1], parts[3]
+	orgURL := "https://" + repo.Host() + "/" + org
+	connection := azuredevops.NewPatConnection(orgURL, os.Getenv("AZURE_DEVOPS_AUTH_TOKEN"))
+	gitClient, err := git.NewClient(ctx, connection)
+	if err != nil {
+		return nil, fmt.Errorf("creating azure devops git client: %w", err)
+	}
+	client := &azureDevOpsClient{Client: gitClient, rest: connection.GetClientByUrl(orgURL), orgURL: orgURL}
+	return fetchAzureDevOpsPullRequest(ctx, client, project, name, number)
+}
+
+// fetchAzureDevOpsPullRequest returns a pull request with the files changed
+// by its last iteration, i.e. push, compared to its target branch, and the
+// lines added or edited in them since the merge base. Every line of a file
+// is considered changed if its diff is unknown.
+func fetchAzureDevOpsPullRequest(
+	ctx context.Context,
+	client azureDevOpsGitClient,
+	project, repo string,
+	number int,
+) (*PullRequest, error) {
+	pull, err := client.GetPullRequest(ctx, git.GetPullRequestArgs{
+		RepositoryId:  &repo,
+		PullRequestId: &number,
+		Project:       &project,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting pull request %d: %w", number, err)
+	}
+	pr := &PullRequest{Files: make(map[string]*File)}
+	if pull.LastMergeSourceCommit != nil && pull.LastMergeSourceCommit.CommitId != nil {
+		pr.HeadSHA = *pull.LastMergeSourceCommit.CommitId
+	}
+
+	iterations, err := client.GetPullRequestIterations(ctx, git.GetPullRequestIterationsArgs{
+		RepositoryId:  &repo,
+		PullRequestId: &number,
+		Project:       &project,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing iterations of pull request %d: %w", number, err)
+	}
+	if iterations == nil || len(*iterations) == 0 || (*iterations)[len(*iterations)-1].Id == nil {
+		return nil, fmt.Errorf("%w: %d", errNoIterations, number)
+	}
+	iteration := (*iterations)[len(*iterations)-1]
+	last := *iteration.Id
+
+	// originalPaths are the paths of the changed files at the merge base.
+	originalPaths := make(map[string]string)
+	top, skip := 2000, 0
+	for {
+		changes, err := client.GetPullRequestIterationChanges(ctx, git.GetPullRequestIterationChangesArgs{
+			RepositoryId:  &repo,
+			PullRequestId: &number,
+			IterationId:   &last,
+			Project:       &project,
+			Top:           &top,
+			Skip:          &skip,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing changes of pull request %d: %w", number, err)
+		}
+		if changes.ChangeEntries != nil {
+			for _, c := range *changes.ChangeEntries {
+				if c.ChangeType != nil && strings.Contains(string(*c.ChangeType), "delete") {
+					continue
+				}
+				if path, ok := changedItemPath(c.Item); ok {
+					pr.Files[path] = &File{}
+					originalPaths[path] = "/" + path
+					if c.OriginalPath != nil && *c.OriginalPath != "" {
+						originalPaths[path] = *c.OriginalPath
+					}
+				}
+			}
+		}
+		if changes.NextSkip == nil || *changes.NextSkip == 0 {
+			break
+		}
+		skip = *changes.NextSkip
+	}
+
+	if iteration.CommonRefCommit == nil || iteration.CommonRefCommit.CommitId == nil ||
+		iteration.SourceRefCommit == nil || iteration.SourceRefCommit.CommitId == nil {
+		return pr, nil
+	}
+	paths := pr.Paths()
+	for len(paths) > 0 {
+		batch := paths[:min(fileDiffBatch, len(paths))]
+		paths = paths[len(batch):]
+		params := make([]git.FileDiffParams, 0, len(batch))
+		for _, path := range batch {
+			params = append(params, git.FileDiffParams{Path: toPtr("/" + path), OriginalPath: toPtr(originalPaths[path])})
+		}
+		diffs, err := client.GetFileDiffs(ctx, getFileDiffsArgs{
+			FileDiffsCriteria: &git.FileDiffsCriteria{
+				BaseVersionCommit:   iteration.CommonRefCommit.CommitId,
+				TargetVersionCommit: iteration.SourceRefCommit.CommitId,
+				FileDiffParams:      &params,
+			},
+			Project:      &project,
+			RepositoryID: &repo,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("getting diffs of pull request %d: %w", number, err)
+		}
+		for _, d := range *diffs {
+			if d.Path == nil || d.LineDiffBlocks == nil {
+				continue
+			}
+			if file, ok := pr.Files[strings.TrimPrefix(*d.Path, "/")]; ok {
+				file.Lines = changedLines(*d.LineDiffBlocks)
+			}
+		}
+	}
+	return pr, nil
+}
+
+// changedLines returns the lines of the modified file which line diff blocks
+// add or edit.
+func changedLines(blocks []git.LineDiffBlock) map[uint]bool {
+	lines := make(map[uint]bool)
+	for _, b := range blocks {
+		if b.ChangeType == nil || b.ModifiedLineNumberStart == nil || b.ModifiedLinesCount == nil ||
+			*b.ModifiedLineNumberStart < 1 {
+			continue
+		}
+		if *b.ChangeType != git.LineDiffBlockChangeTypeValues.Add && *b.ChangeType != git.LineDiffBlockChangeTypeValues.Edit {
+			continue
+		}
+		for i := range *b.ModifiedLinesCount {
+			lines[uint(*b.ModifiedLineNumberStart+i)] = true
+		}
+	}
+	return lines
+}
+
+// changedItemPath returns the path, relative to the root of the repository,
+// of the file of a change. Folders have no path.
+func changedItemPath(item any) (string, bool) {
+	m, ok := item.(map[string]any)
+	if !ok {
+		return "", false
+	}
+	if isFolder, ok := m["isFolder"].(bool); ok && isFolder {
+		return "", false
+	}
+	path, ok := m["path"].(string)
+	if !ok || path == "" {
+		return "", false
+	}
+	return strings.TrimPrefix(path, "/"), true
+}
+
+func toPtr[T any](v T) *T {
+	return &v
+}
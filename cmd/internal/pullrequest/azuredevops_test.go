@@ -0,0 +1,132 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullrequest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+)
+
+type fakeAzureDevOpsGitClient struct {
+	// diffs are the line diff blocks of files, by path.
+	diffs map[string][]git.LineDiffBlock
+	// originalPaths records the original paths of the files whose diffs
+	// were requested, by path.
+	originalPaths map[string]string
+	pages         [][]git.GitPullRequestChange
+}
+
+func (f *fakeAzureDevOpsGitClient) GetPullRequest(
+	ctx context.Context, args git.GetPullRequestArgs,
+) (*git.GitPullRequest, error) {
+	return &git.GitPullRequest{LastMergeSourceCommit: &git.GitCommitRef{CommitId: toPtr("0123456789abcdef")}}, nil
+}
+
+func (f *fakeAzureDevOpsGitClient) GetPullRequestIterations(
+	ctx context.Context, args git.GetPullRequestIterationsArgs,
+) (*[]git.GitPullRequestIteration, error) {
+	return &[]git.GitPullRequestIteration{
+		{Id: toPtr(1)},
+		{
+			Id:              toPtr(2),
+			CommonRefCommit: &git.GitCommitRef{CommitId: toPtr("base")},
+			SourceRefCommit: &git.GitCommitRef{CommitId: toPtr("0123456789abcdef")},
+		},
+	}, nil
+}
+
+func (f *fakeAzureDevOpsGitClient) GetPullRequestIterationChanges(
+	ctx context.Context, args git.GetPullRequestIterationChangesArgs,
+) (*git.GitPullRequestIterationChanges, error) {
+	page := *args.Skip / 2
+	changes := &git.GitPullRequestIterationChanges{ChangeEntries: &f.pages[page], NextSkip: toPtr(0)}
+	if *args.IterationId != 2 {
+		changes.ChangeEntries = &[]git.GitPullRequestChange{}
+	}
+	if page+1 < len(f.pages) {
+		changes.NextSkip = toPtr(2 * (page + 1))
+	}
+	return changes, nil
+}
+
+func (f *fakeAzureDevOpsGitClient) GetFileDiffs(
+	ctx context.Context, args getFileDiffsArgs,
+) (*[]git.FileDiff, error) {
+	criteria := args.FileDiffsCriteria
+	if *criteria.BaseVersionCommit != "base" || *criteria.TargetVersionCommit != "0123456789abcdef" {
+		return nil, errInvalidPatch
+	}
+	var diffs []git.FileDiff
+	for _, p := range *criteria.FileDiffParams {
+		f.originalPaths[*p.Path] = *p.OriginalPath
+		if blocks, ok := f.diffs[*p.Path]; ok {
+			diffs = append(diffs, git.FileDiff{Path: p.Path, LineDiffBlocks: &blocks})
+		}
+	}
+	return &diffs, nil
+}
+
+func TestFetchAzureDevOpsPullRequest(t *testing.T) {
+	t.Parallel()
+	edit, del := git.VersionControlChangeType("edit"), git.VersionControlChangeType("delete")
+	rename := git.VersionControlChangeType("rename")
+	added, edited, deleted := git.LineDiffBlockChangeTypeValues.Add,
+		git.LineDiffBlockChangeTypeValues.Edit, git.LineDiffBlockChangeTypeValues.Delete
+	client := &fakeAzureDevOpsGitClient{
+		pages: [][]git.GitPullRequestChange{
+			{
+				{ChangeType: &edit, Item: map[string]any{"path": "/azure-pipelines.yml"}},
+				{ChangeType: &edit, Item: map[string]any{"path": "/src", "isFolder": true}},
+			},
+			{
+				{ChangeType: &del, Item: map[string]any{"path": "/old.yml"}},
+				{ChangeType: &rename, Item: map[string]any{"path": "/src/main.go"}, OriginalPath: toPtr("/main.go")},
+			},
+		},
+		diffs: map[string][]git.LineDiffBlock{
+			"/azure-pipelines.yml": {
+				{ChangeType: &added, ModifiedLineNumberStart: toPtr(3), ModifiedLinesCount: toPtr(2)},
+				{ChangeType: &deleted, ModifiedLineNumberStart: toPtr(6), ModifiedLinesCount: toPtr(0)},
+				{ChangeType: &edited, ModifiedLineNumberStart: toPtr(10), ModifiedLinesCount: toPtr(1)},
+			},
+		},
+		originalPaths: make(map[string]string),
+	}
+	pr, err := fetchAzureDevOpsPullRequest(context.Background(), client, "project", "repo", 3)
+	if err != nil {
+		t.Fatalf("fetchAzureDevOpsPullRequest: %v", err)
+	}
+	want := &PullRequest{
+		HeadSHA: "0123456789abcdef",
+		Files: map[string]*File{
+			"azure-pipelines.yml": {Lines: map[uint]bool{3: true, 4: true, 10: true}},
+			// The diff of the file is unknown, so all its lines are changed.
+			"src/main.go": {},
+		},
+	}
+	if diff := cmp.Diff(want, pr); diff != "" {
+		t.Errorf("pull request mismatch (-want +got):\n%s", diff)
+	}
+	wantOriginalPaths := map[string]string{
+		"/azure-pipelines.yml": "/azure-pipelines.yml",
+		"/src/main.go":         "/main.go",
+	}
+	if diff := cmp.Diff(wantOriginalPaths, client.originalPaths); diff != "" {
+		t.Errorf("original paths mismatch (-want +got):\n%s", diff)
+	}
+}
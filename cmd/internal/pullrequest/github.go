@@ -0,0 +1,80 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullrequest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/google/go-github/v82/github"
+
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/githubrepo/roundtripper"
+	"github.com/ossf/scorecard/v5/log"
+)
+
+// newGitHubClient returns a client of github.com, or of the GitHub Enterprise
+// Server of GH_HOST, which authenticates like the analysis does.
+func newGitHubClient(ctx context.Context) *github.Client {
+	logger := log.NewLogger(log.DefaultLevel)
+	httpClient := &http.Client{Transport: roundtripper.NewTransport(ctx, logger)}
+	client := github.NewClient(httpClient)
+	if host, ok := os.LookupEnv("GH_HOST"); ok && host != "github.com" {
+		restURL := fmt.Sprintf("https://%s/api/v3", strings.TrimSpace(host))
+		if enterprise, err := client.WithEnterpriseURLs(restURL, restURL); err == nil {
+			client = enterprise
+		}
+	}
+	return client
+}
+
+func fetchGitHub(ctx context.Context, client *github.Client, repo clients.Repo, number int) (*PullRequest, error) {
+	owner, name, _ := strings.Cut(repo.Path(), "/")
+	pull, _, err := client.PullRequests.Get(ctx, owner, name, number)
+	if err != nil {
+		return nil, fmt.Errorf("getting pull request %d: %w", number, err)
+	}
+	pr := &PullRequest{HeadSHA: pull.GetHead().GetSHA(), Files: make(map[string]*File)}
+
+	opt := &github.ListOptions{PerPage: 100}
+	for {
+		files, resp, err := client.PullRequests.ListFiles(ctx, owner, name, number, opt)
+		if err != nil {
+			return nil, fmt.Errorf("listing files of pull request %d: %w", number, err)
+		}
+		for _, f := range files {
+			if f.GetStatus() == "removed" {
+				continue
+			}
+			file := &File{}
+			// GitHub omits the patches of binary and large files.
+			if f.Patch != nil {
+				file.Lines, err = addedLines(f.GetPatch())
+				if err != nil {
+					return nil, fmt.Errorf("parsing patch of %s: %w", f.GetFilename(), err)
+				}
+			}
+			pr.Files[f.GetFilename()] = file
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+	return pr, nil
+}
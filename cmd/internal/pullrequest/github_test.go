@@ -0,0 +1,76 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullrequest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v82/github"
+
+	"github.com/ossf/scorecard/v5/clients/githubrepo"
+)
+
+func TestFetchGitHub(t *testing.T) {
+	t.Parallel()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/ossf/scorecard/pulls/42", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"number": 42, "head": {"sha": "0123456789abcdef"}}`)
+	})
+	mux.HandleFunc("/repos/ossf/scorecard/pulls/42/files", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") != "2" {
+			w.Header().Set("Link", `<`+r.URL.Path+`?page=2>; rel="next"`)
+			fmt.Fprint(w, `[
+				{"filename": ".github/workflows/ci.yml", "status": "modified", "patch": "@@ -3,2 +3,2 @@\n-a\n+b\n c"},
+				{"filename": "old.yml", "status": "removed", "patch": "@@ -1 +0,0 @@\n-a"}
+			]`)
+			return
+		}
+		fmt.Fprint(w, `[{"filename": "bin/tool", "status": "added"}]`)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	client := github.NewClient(nil)
+	baseURL, err := url.Parse(srv.URL + "/")
+	if err != nil {
+		t.Fatalf("parsing URL: %v", err)
+	}
+	client.BaseURL = baseURL
+	repo, err := githubrepo.MakeGithubRepo("github.com/ossf/scorecard")
+	if err != nil {
+		t.Fatalf("MakeGithubRepo: %v", err)
+	}
+
+	pr, err := fetchGitHub(context.Background(), client, repo, 42)
+	if err != nil {
+		t.Fatalf("fetchGitHub: %v", err)
+	}
+	want := &PullRequest{
+		HeadSHA: "0123456789abcdef",
+		Files: map[string]*File{
+			".github/workflows/ci.yml": {Lines: map[uint]bool{3: true}},
+			"bin/tool":                 {},
+		},
+	}
+	if diff := cmp.Diff(want, pr); diff != "" {
+		t.Errorf("pull request mismatch (-want +got):\n%s", diff)
+	}
+}
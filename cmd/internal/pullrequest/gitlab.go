@@ -0,0 +1,69 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullrequest
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+func fetchGitLab(ctx context.Context, repo clients.Repo, number int) (*PullRequest, error) {
+	client, err := gitlab.NewClient(os.Getenv("GITLAB_AUTH_TOKEN"), gitlab.WithBaseURL("https://"+repo.Host()))
+	if err != nil {
+		return nil, fmt.Errorf("creating gitlab client: %w", err)
+	}
+	return fetchGitLabMergeRequest(ctx, client, repo.Path(), number)
+}
+
+func fetchGitLabMergeRequest(ctx context.Context, client *gitlab.Client, project string, number int) (*PullRequest, error) {
+	iid := int64(number)
+	mr, _, err := client.MergeRequests.GetMergeRequest(project, iid, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("getting merge request %d: %w", number, err)
+	}
+	pr := &PullRequest{HeadSHA: mr.SHA, Files: make(map[string]*File)}
+
+	opt := &gitlab.ListMergeRequestDiffsOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
+	for {
+		diffs, resp, err := client.MergeRequests.ListMergeRequestDiffs(project, iid, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("listing diffs of merge request %d: %w", number, err)
+		}
+		for _, d := range diffs {
+			if d.DeletedFile {
+				continue
+			}
+			file := &File{}
+			// GitLab omits the diffs of binary and large files.
+			if d.Diff != "" && !d.TooLarge && !d.Collapsed {
+				file.Lines, err = addedLines(d.Diff)
+				if err != nil {
+					return nil, fmt.Errorf("parsing diff of %s: %w", d.NewPath, err)
+				}
+			}
+			pr.Files[d.NewPath] = file
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+	return pr, nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullrequest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestFetchGitLabMergeRequest(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case "/api/v4/projects/group%2Fproject/merge_requests/7":
+			fmt.Fprint(w, `{"iid": 7, "sha": "fedcba9876543210"}`)
+		case "/api/v4/projects/group%2Fproject/merge_requests/7/diffs":
+			fmt.Fprint(w, `[
+				{"new_path": ".gitlab-ci.yml", "diff": "@@ -1,1 +1,2 @@\n image: golang\n+script: make\n"},
+				{"new_path": "removed.txt", "deleted_file": true, "diff": "@@ -1 +0,0 @@\n-a\n"},
+				{"new_path": "large.json", "too_large": true}
+			]`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	client, err := gitlab.NewClient("", gitlab.WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatalf("gitlab.NewClient: %v", err)
+	}
+	pr, err := fetchGitLabMergeRequest(context.Background(), client, "group/project", 7)
+	if err != nil {
+		t.Fatalf("fetchGitLabMergeRequest: %v", err)
+	}
+	want := &PullRequest{
+		HeadSHA: "fedcba9876543210",
+		Files: map[string]*File{
+			".gitlab-ci.yml": {Lines: map[uint]bool{2: true}},
+			"large.json":     {},
+		},
+	}
+	if diff := cmp.Diff(want, pr); diff != "" {
+		t.Errorf("merge request mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pullrequest fetches the files changed by a pull request, or merge
+// request, so that only those are analyzed, and annotates the changed lines
+// with the findings of the analysis.
+package pullrequest
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/azuredevopsrepo"
+	"github.com/ossf/scorecard/v5/clients/githubrepo"
+	"github.com/ossf/scorecard/v5/clients/gitlabrepo"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/hasCommittedSecrets"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowArtifactPoisoning"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowCachePoisoning"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowScriptInjection"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowSelfHostedRunner"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowUntrustedCheckout"
+	"github.com/ossf/scorecard/v5/probes/hasUnverifiedBinaryArtifacts"
+	"github.com/ossf/scorecard/v5/probes/jobLevelPermissions"
+	"github.com/ossf/scorecard/v5/probes/pinsDependencies"
+	"github.com/ossf/scorecard/v5/probes/topLevelPermissions"
+)
+
+var (
+	errUnsupportedRepo = errors.New("pull requests are only supported for GitHub, GitLab and Azure DevOps repositories")
+	errInvalidPatch    = errors.New("invalid patch")
+	errNoHead          = errors.New("pull request has no head commit")
+)
+
+// Probes are the probes which analyze the content of files, and so can be
+// run on the files changed by a pull request.
+var Probes = []string{
+	pinsDependencies.Probe,
+	hasDangerousWorkflowScriptInjection.Probe,
+	hasDangerousWorkflowUntrustedCheckout.Probe,
+	hasDangerousWorkflowArtifactPoisoning.Probe,
+	hasDangerousWorkflowCachePoisoning.Probe,
+	hasDangerousWorkflowSelfHostedRunner.Probe,
+	topLevelPermissions.Probe,
+	jobLevelPermissions.Probe,
+	hasUnverifiedBinaryArtifacts.Probe,
+	hasCommittedSecrets.Probe,
+}
+
+// PullRequest is the head of a pull request and the files it changes.
+type PullRequest struct {
+	// Files are the files added or modified by the pull request, by path at
+	// the head. Deleted files aren't included.
+	Files   map[string]*File
+	HeadSHA string
+	Number  int
+}
+
+// File is a file changed by a pull request.
+type File struct {
+	// Lines are the lines added or modified by the pull request, or nil if
+	// they are unknown, e.g. for binary files, in which case every line is
+	// considered changed.
+	Lines map[uint]bool
+}
+
+// Paths returns the paths of the changed files, sorted.
+func (pr *PullRequest) Paths() []string {
+	paths := make([]string, 0, len(pr.Files))
+	for path := range pr.Files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// Changed returns true if the pull request changes path, and one of the lines
+// from start to end if start isn't 0.
+func (pr *PullRequest) Changed(path string, start, end uint) bool {
+	f, ok := pr.Files[path]
+	if !ok {
+		return false
+	}
+	if f.Lines == nil || start == 0 {
+		return true
+	}
+	end = max(end, start)
+	for line := start; line <= end; line++ {
+		if f.Lines[line] {
+			return true
+		}
+	}
+	return false
+}
+
+// Annotations returns the findings which are on a line changed by the pull
+// request, or in a changed file if they aren't on a specific line.
+func (pr *PullRequest) Annotations(findings []finding.Finding) []finding.Finding {
+	var annotations []finding.Finding
+	for i := range findings {
+		loc := findings[i].Location
+		if loc == nil {
+			continue
+		}
+		var start, end uint
+		if loc.LineStart != nil {
+			start = *loc.LineStart
+		}
+		if loc.LineEnd != nil {
+			end = *loc.LineEnd
+		}
+		if pr.Changed(loc.Path, start, end) {
+			annotations = append(annotations, findings[i])
+		}
+	}
+	return annotations
+}
+
+// Fetch returns the pull request, or merge request, number of repo.
+func Fetch(ctx context.Context, repo clients.Repo, number int) (*PullRequest, error) {
+	var pr *PullRequest
+	var err error
+	switch r := repo.(type) {
+	case *githubrepo.Repo:
+		pr, err = fetchGitHub(ctx, newGitHubClient(ctx), r, number)
+	case *gitlabrepo.Repo:
+		pr, err = fetchGitLab(ctx, r, number)
+	case *azuredevopsrepo.Repo:
+		pr, err = fetchAzureDevOps(ctx, r, number)
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnsupportedRepo, repo.URI())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if pr.HeadSHA == "" {
+		return nil, fmt.Errorf("%w: %d", errNoHead, number)
+	}
+	pr.Number = number
+	return pr, nil
+}
+
+// addedLines returns the lines of the new version of a file which a unified
+// diff adds or modifies.
+func addedLines(patch string) (map[uint]bool, error) {
+	lines := make(map[uint]bool)
+	var line uint
+	inHunk := false
+	scanner := bufio.NewScanner(strings.NewReader(patch))
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		text := scanner.Text()
+		switch {
+		case strings.HasPrefix(text, "@@"):
+			start, err := hunkStart(text)
+			if err != nil {
+				return nil, err
+			}
+			line = start
+			inHunk = true
+		case !inHunk, strings.HasPrefix(text, `\`):
+			// File headers, and "\ No newline at end of file".
+		case strings.HasPrefix(text, "+"):
+			lines[line] = true
+			line++
+		case strings.HasPrefix(text, "-"):
+		default:
+			line++
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading patch: %w", err)
+	}
+	return lines, nil
+}
+
+// hunkStart returns the first line of the new version of a file in a hunk
+// header, e.g. 12 for "@@ -10,7 +12,8 @@ func main() {".
+func hunkStart(header string) (uint, error) {
+	fields := strings.Fields(header)
+	if len(fields) < 3 || !strings.HasPrefix(fields[2], "+") {
+		return 0, fmt.Errorf("%w: hunk header %q", errInvalidPatch, header)
+	}
+	start, _, _ := strings.Cut(strings.TrimPrefix(fields[2], "+"), ",")
+	n, err := strconv.ParseUint(start, 10, 0)
+	if err != nil {
+		return 0, fmt.Errorf("%w: hunk header %q", errInvalidPatch, header)
+	}
+	return uint(n), nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullrequest
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+func TestAddedLines(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		want    map[uint]bool
+		name    string
+		patch   string
+		wantErr bool
+	}{
+		{
+			name: "github patch",
+			patch: "@@ -1,3 +1,4 @@\n" +
+				" on: push\n" +
+				"+permissions: read-all\n" +
+				" jobs:\n" +
+				"   build:\n" +
+				"@@ -10,2 +11,2 @@ jobs:\n" +
+				"-      - uses: actions/checkout@v3\n" +
+				"+      - uses: actions/checkout@v4\n" +
+				"       - run: make\n",
+			want: map[uint]bool{2: true, 11: true},
+		},
+		{
+			name: "file headers and no newline",
+			patch: "--- a/Dockerfile\n" +
+				"+++ b/Dockerfile\n" +
+				"@@ -0,0 +1,2 @@\n" +
+				"+FROM golang\n" +
+				"+RUN make\n" +
+				"\\ No newline at end of file\n",
+			want: map[uint]bool{1: true, 2: true},
+		},
+		{
+			name:  "single line hunk",
+			patch: "@@ -5 +5 @@\n-old\n+new\n",
+			want:  map[uint]bool{5: true},
+		},
+		{
+			name:    "invalid hunk header",
+			patch:   "@@ -1,3 1,4 @@\n+line\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := addedLines(tt.patch)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("addedLines() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); !tt.wantErr && diff != "" {
+				t.Errorf("lines mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAnnotations(t *testing.T) {
+	t.Parallel()
+	pr := &PullRequest{Files: map[string]*File{
+		".github/workflows/ci.yml": {Lines: map[uint]bool{7: true, 8: true}},
+		"bin/tool":                 {},
+	}}
+	at := func(path string, lines ...uint) finding.Finding {
+		f := finding.Finding{Probe: "pinsDependencies", Location: &finding.Location{Path: path}}
+		if len(lines) > 0 {
+			f.Location.LineStart = &lines[0]
+		}
+		if len(lines) > 1 {
+			f.Location.LineEnd = &lines[1]
+		}
+		return f
+	}
+	findings := []finding.Finding{
+		at(".github/workflows/ci.yml", 7),
+		at(".github/workflows/ci.yml", 3),
+		at(".github/workflows/ci.yml", 5, 8),
+		at(".github/workflows/ci.yml"),
+		at(".github/workflows/release.yml", 7),
+		at("bin/tool"),
+		{Probe: "hasCommittedSecrets"},
+	}
+	got := pr.Annotations(findings)
+	want := []finding.Finding{findings[0], findings[2], findings[3], findings[5]}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(finding.Finding{})); diff != "" {
+		t.Errorf("annotations mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{".github/workflows/ci.yml", "bin/tool"}, pr.Paths()); diff != "" {
+		t.Errorf("paths mismatch (-want +got):\n%s", diff)
+	}
+}
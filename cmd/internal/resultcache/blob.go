@@ -0,0 +1,109 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resultcache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"gocloud.dev/blob"
+	_ "gocloud.dev/blob/fileblob" // Needed to use file:/// buckets as an on-disk cache.
+	_ "gocloud.dev/blob/gcsblob"  // Needed to link in GCP drivers.
+	"gocloud.dev/gcerrors"
+
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+)
+
+// Blob is a cache storing results as JSON objects in a blob bucket. Expired
+// results are removed when read, or by the lifecycle rules of the bucket.
+type Blob struct {
+	bucket *blob.Bucket
+	now    func() time.Time
+	ttl    time.Duration
+}
+
+// OpenBlob opens the bucket at url as a cache keeping results for ttl.
+func OpenBlob(ctx context.Context, url string, ttl time.Duration) (*Blob, error) {
+	bucket, err := blob.OpenBucket(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("error from blob.OpenBucket: %w", err)
+	}
+	return &Blob{bucket: bucket, now: time.Now, ttl: ttl}, nil
+}
+
+// Get implements Cache.
+func (b *Blob) Get(ctx context.Context, key string) (*scorecard.Result, error) {
+	data, err := b.bucket.ReadAll(ctx, key+".json")
+	if gcerrors.Code(err) == gcerrors.NotFound {
+		return nil, ErrMiss
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error during bucket.ReadAll: %w", err)
+	}
+	var e entry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, fmt.Errorf("decoding cached result: %w", err)
+	}
+	if e.Result == nil || b.now().After(e.Expires) {
+		if err := b.bucket.Delete(ctx, key+".json"); err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+			return nil, fmt.Errorf("error during bucket.Delete: %w", err)
+		}
+		return nil, ErrMiss
+	}
+	return e.result(), nil
+}
+
+// Put implements Cache.
+func (b *Blob) Put(ctx context.Context, key string, result *scorecard.Result) error {
+	data, err := json.Marshal(newEntry(result, b.now().Add(b.ttl)))
+	if err != nil {
+		return fmt.Errorf("encoding result: %w", err)
+	}
+	if err := b.bucket.WriteAll(ctx, key+".json", data, &blob.WriterOptions{ContentType: "application/json"}); err != nil {
+		return fmt.Errorf("error during bucket.WriteAll: %w", err)
+	}
+	return nil
+}
+
+// Invalidate implements Cache.
+func (b *Blob) Invalidate(ctx context.Context, prefix string) (int, error) {
+	iter := b.bucket.List(&blob.ListOptions{Prefix: prefix})
+	n := 0
+	for {
+		obj, err := iter.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			return n, nil
+		}
+		if err != nil {
+			return n, fmt.Errorf("error during iter.Next: %w", err)
+		}
+		if err := b.bucket.Delete(ctx, obj.Key); err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+			return n, fmt.Errorf("error during bucket.Delete: %w", err)
+		}
+		n++
+	}
+}
+
+// Close implements Cache.
+func (b *Blob) Close() error {
+	if err := b.bucket.Close(); err != nil {
+		return fmt.Errorf("error during bucket.Close: %w", err)
+	}
+	return nil
+}
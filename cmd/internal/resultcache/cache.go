@@ -0,0 +1,129 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package resultcache caches scorecard results for the HTTP server, keyed by
+// the repository, the analyzed commit and the parameters of the analysis.
+package resultcache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+)
+
+// ErrMiss indicates the cache has no result for a key, or it expired.
+var ErrMiss = errors.New("cache miss")
+
+// Cache stores results for a limited time.
+type Cache interface {
+	// Get returns the result stored for key, or ErrMiss.
+	Get(ctx context.Context, key string) (*scorecard.Result, error)
+	// Put stores the result for key.
+	Put(ctx context.Context, key string, result *scorecard.Result) error
+	// Invalidate removes the results whose keys start with prefix and
+	// returns how many were removed.
+	Invalidate(ctx context.Context, prefix string) (int, error)
+	Close() error
+}
+
+// Key identifies an analysis. Results only match keys with the same values.
+type Key struct {
+	Repo     string
+	Commit   string
+	Version  string
+	FileMode string
+	Checks   []string
+	Probes   []string
+	Depth    int
+}
+
+// String returns the key as "<repo>/<commit>/<hash of the other fields>", so
+// that the results of a repository or commit share a prefix.
+func (k *Key) String() string {
+	checks := append([]string(nil), k.Checks...)
+	sort.Strings(checks)
+	probes := append([]string(nil), k.Probes...)
+	sort.Strings(probes)
+	params := fmt.Sprintf("version=%s\nfile_mode=%s\ncommit_depth=%d\nchecks=%s\nprobes=%s",
+		k.Version, k.FileMode, k.Depth, strings.Join(checks, ","), strings.Join(probes, ","))
+	sum := sha256.Sum256([]byte(params))
+	return Prefix(k.Repo, k.Commit) + hex.EncodeToString(sum[:])
+}
+
+// Prefix returns the prefix of the keys of a repository, or of one of its
+// commits if commit isn't empty.
+func Prefix(repo, commit string) string {
+	repo = strings.ToLower(strings.Trim(repo, "/"))
+	if commit == "" {
+		return repo + "/"
+	}
+	return repo + "/" + strings.ToLower(commit) + "/"
+}
+
+// entry is a cached result. Raw results aren't kept since they are only used to
+// compute the checks and findings, and errors are kept as their messages.
+type entry struct {
+	Expires time.Time         `json:"expires"`
+	Result  *scorecard.Result `json:"result"`
+	Errors  map[string]string `json:"errors,omitempty"`
+}
+
+func newEntry(result *scorecard.Result, expires time.Time) *entry {
+	r := *result
+	r.RawResults = checker.RawResults{}
+	r.Checks = append([]checker.CheckResult(nil), result.Checks...)
+	e := &entry{Expires: expires, Result: &r}
+	for i := range r.Checks {
+		if r.Checks[i].Error == nil {
+			continue
+		}
+		if e.Errors == nil {
+			e.Errors = make(map[string]string)
+		}
+		e.Errors[r.Checks[i].Name] = r.Checks[i].Error.Error()
+		r.Checks[i].Error = nil
+	}
+	return e
+}
+
+// result returns a copy of the cached result, with its errors.
+func (e *entry) result() *scorecard.Result {
+	r := *e.Result
+	r.Checks = append([]checker.CheckResult(nil), e.Result.Checks...)
+	for i := range r.Checks {
+		if msg, ok := e.Errors[r.Checks[i].Name]; ok {
+			//nolint:err113 // The original error can't be restored.
+			r.Checks[i].Error = errors.New(msg)
+		}
+	}
+	return &r
+}
+
+// Open returns the cache at url: "memory" for an in-memory cache of size
+// results, or the URL of a blob bucket, e.g. file:///var/cache/scorecard or
+// gs://bucket/prefix.
+func Open(ctx context.Context, url string, size int, ttl time.Duration) (Cache, error) {
+	if url == "memory" {
+		return NewMemory(size, ttl), nil
+	}
+	return OpenBlob(ctx, url, ttl)
+}
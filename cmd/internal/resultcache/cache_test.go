@@ -0,0 +1,171 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resultcache
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+)
+
+var errCheck = errors.New("check failed")
+
+func testResult(repo string) *scorecard.Result {
+	return &scorecard.Result{
+		Repo: scorecard.RepoInfo{Name: repo, CommitSHA: "0123456789abcdef0123456789abcdef01234567"},
+		Checks: []checker.CheckResult{
+			{
+				Name:   "Binary-Artifacts",
+				Score:  10,
+				Reason: "no binaries found in the repo",
+				Findings: []finding.Finding{
+					{Probe: "hasBinaryArtifacts", Message: "no binaries", Outcome: finding.OutcomeFalse},
+				},
+			},
+			{Name: "Pinned-Dependencies", Score: -1, Error: errCheck},
+		},
+		RawResults: checker.RawResults{
+			BinaryArtifactResults: checker.BinaryArtifactData{Files: []checker.File{{Path: "a.exe"}}},
+		},
+	}
+}
+
+func TestKey(t *testing.T) {
+	t.Parallel()
+	k := Key{
+		Repo:    "github.com/ossf/scorecard",
+		Commit:  "0123456789ABCDEF0123456789ABCDEF01234567",
+		Version: "v5.0.0",
+		Checks:  []string{"Pinned-Dependencies", "Binary-Artifacts"},
+	}
+	key := k.String()
+	if !strings.HasPrefix(key, Prefix("github.com/ossf/scorecard", "0123456789abcdef0123456789abcdef01234567")) {
+		t.Errorf("key %s doesn't start with the commit prefix", key)
+	}
+	reordered := k
+	reordered.Checks = []string{"Binary-Artifacts", "Pinned-Dependencies"}
+	if reordered.String() != key {
+		t.Errorf("key depends on the order of the checks")
+	}
+	for _, other := range []Key{
+		{Repo: k.Repo, Commit: k.Commit, Version: "v5.1.0", Checks: k.Checks},
+		{Repo: k.Repo, Commit: k.Commit, Version: k.Version, Checks: k.Checks[:1]},
+		{Repo: k.Repo, Commit: k.Commit, Version: k.Version, Probes: []string{"hasLicenseFile"}},
+		{Repo: k.Repo, Commit: k.Commit, Version: k.Version, Checks: k.Checks, Depth: 10},
+	} {
+		if other.String() == key {
+			t.Errorf("key %+v matches %+v", other, k)
+		}
+	}
+}
+
+func testCache(t *testing.T, c Cache, now *time.Time) {
+	t.Helper()
+	ctx := context.Background()
+	key := (&Key{Repo: "github.com/ossf/scorecard", Commit: "abc"}).String()
+	if _, err := c.Get(ctx, key); !errors.Is(err, ErrMiss) {
+		t.Fatalf("Get() error = %v, want %v", err, ErrMiss)
+	}
+
+	want := testResult("github.com/ossf/scorecard")
+	if err := c.Put(ctx, key, want); err != nil {
+		t.Fatal(err)
+	}
+	other := (&Key{Repo: "github.com/ossf/scorecard", Commit: "def"}).String()
+	if err := c.Put(ctx, other, want); err != nil {
+		t.Fatal(err)
+	}
+	got, err := c.Get(ctx, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Checks[1].Error == nil || got.Checks[1].Error.Error() != errCheck.Error() {
+		t.Errorf("check error = %v, want %v", got.Checks[1].Error, errCheck)
+	}
+	if len(got.RawResults.BinaryArtifactResults.Files) != 0 {
+		t.Errorf("raw results are cached")
+	}
+	if diff := cmp.Diff(want.Checks[0], got.Checks[0], cmp.AllowUnexported(finding.Finding{})); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+	if want.Checks[1].Error == nil || len(want.RawResults.BinaryArtifactResults.Files) == 0 {
+		t.Errorf("cached result was modified")
+	}
+
+	n, err := c.Invalidate(ctx, Prefix("github.com/ossf/scorecard", "abc"))
+	if err != nil || n != 1 {
+		t.Errorf("Invalidate() = %d, %v, want 1", n, err)
+	}
+	if _, err := c.Get(ctx, key); !errors.Is(err, ErrMiss) {
+		t.Errorf("Get() error = %v, want %v", err, ErrMiss)
+	}
+
+	*now = now.Add(2 * time.Hour)
+	if _, err := c.Get(ctx, other); !errors.Is(err, ErrMiss) {
+		t.Errorf("Get() of an expired result error = %v, want %v", err, ErrMiss)
+	}
+}
+
+func TestMemory(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	m := NewMemory(2, time.Hour)
+	m.now = func() time.Time { return now }
+	testCache(t, m, &now)
+
+	// The least recently used result is evicted.
+	ctx := context.Background()
+	for _, k := range []string{"a", "b"} {
+		if err := m.Put(ctx, k, testResult(k)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := m.Get(ctx, "a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Put(ctx, "c", testResult("c")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Get(ctx, "b"); !errors.Is(err, ErrMiss) {
+		t.Errorf("Get() error = %v, want %v", err, ErrMiss)
+	}
+	if _, err := m.Get(ctx, "a"); err != nil {
+		t.Errorf("Get() error = %v", err)
+	}
+}
+
+func TestBlob(t *testing.T) {
+	t.Parallel()
+	c, err := Open(context.Background(), "file://"+t.TempDir(), 0, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	b, ok := c.(*Blob)
+	if !ok {
+		t.Fatalf("Open() = %T, want *Blob", c)
+	}
+	now := time.Now()
+	b.now = func() time.Time { return now }
+	testCache(t, b, &now)
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resultcache
+
+import (
+	"container/list"
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+)
+
+// Memory is an in-memory cache evicting the least recently used results.
+type Memory struct {
+	items map[string]*list.Element
+	lru   *list.List
+	now   func() time.Time
+	size  int
+	ttl   time.Duration
+	mu    sync.Mutex
+}
+
+type memoryItem struct {
+	entry *entry
+	key   string
+}
+
+// NewMemory returns an in-memory cache keeping at most size results for ttl.
+func NewMemory(size int, ttl time.Duration) *Memory {
+	return &Memory{
+		items: make(map[string]*list.Element),
+		lru:   list.New(),
+		now:   time.Now,
+		size:  size,
+		ttl:   ttl,
+	}
+}
+
+// Get implements Cache.
+func (m *Memory) Get(ctx context.Context, key string) (*scorecard.Result, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.items[key]
+	if !ok {
+		return nil, ErrMiss
+	}
+	item := e.Value.(*memoryItem) //nolint:forcetypeassert // Only memoryItems are stored.
+	if m.now().After(item.entry.Expires) {
+		m.remove(e)
+		return nil, ErrMiss
+	}
+	m.lru.MoveToFront(e)
+	return item.entry.result(), nil
+}
+
+// Put implements Cache.
+func (m *Memory) Put(ctx context.Context, key string, result *scorecard.Result) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	item := &memoryItem{key: key, entry: newEntry(result, m.now().Add(m.ttl))}
+	if e, ok := m.items[key]; ok {
+		e.Value = item
+		m.lru.MoveToFront(e)
+		return nil
+	}
+	m.items[key] = m.lru.PushFront(item)
+	for m.lru.Len() > m.size {
+		m.remove(m.lru.Back())
+	}
+	return nil
+}
+
+// Invalidate implements Cache.
+func (m *Memory) Invalidate(ctx context.Context, prefix string) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n := 0
+	for key, e := range m.items {
+		if strings.HasPrefix(key, prefix) {
+			m.remove(e)
+			n++
+		}
+	}
+	return n, nil
+}
+
+// Close implements Cache.
+func (m *Memory) Close() error {
+	return nil
+}
+
+// remove drops an item. m.mu must be held.
+func (m *Memory) remove(e *list.Element) {
+	m.lru.Remove(e)
+	delete(m.items, e.Value.(*memoryItem).key) //nolint:forcetypeassert // Only memoryItems are stored.
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package telemetry exports the metrics and traces of scorecard runs: metrics
+// to Prometheus and traces to an OTLP collector.
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	ocprometheus "contrib.go.opencensus.io/exporter/prometheus"
+	"go.opencensus.io/stats/view"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"sigs.k8s.io/release-utils/version"
+
+	"github.com/ossf/scorecard/v5/stats"
+)
+
+// views are the metrics exported to Prometheus.
+var views = []*view.View{
+	&stats.CheckRuntime,
+	&stats.CheckLatency,
+	&stats.CheckErrorCount,
+	&stats.RepoClientCallCount,
+	&stats.RepoClientErrorCount,
+	&stats.OutgoingHTTPRequests,
+	&stats.OutgoingHTTPRequestErrors,
+}
+
+// MetricsHandler returns a handler serving the metrics in the Prometheus text format.
+func MetricsHandler() (http.Handler, error) {
+	exporter, err := ocprometheus.NewExporter(ocprometheus.Options{Namespace: "scorecard"})
+	if err != nil {
+		return nil, fmt.Errorf("ocprometheus.NewExporter: %w", err)
+	}
+	if err := view.Register(views...); err != nil {
+		return nil, fmt.Errorf("view.Register: %w", err)
+	}
+	return exporter, nil
+}
+
+// tracingEnabled returns true if an OTLP endpoint is configured with the
+// standard OpenTelemetry environment variables.
+func tracingEnabled() bool {
+	return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" ||
+		os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+// StartTracing exports traces over OTLP/HTTP when OTEL_EXPORTER_OTLP_ENDPOINT
+// or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT is set, and does nothing otherwise.
+// The exporter is configured with the standard OTEL_* environment variables.
+// The returned function flushes the remaining spans and stops exporting them.
+func StartTracing(ctx context.Context) (func(context.Context) error, error) {
+	if !tracingEnabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("otlptracehttp.New: %w", err)
+	}
+	// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES take precedence.
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			attribute.String("service.name", "scorecard"),
+			attribute.String("service.version", version.GetVersionInfo().GitVersion),
+		),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("resource.New: %w", err)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{}))
+	return func(ctx context.Context) error {
+		if err := provider.Shutdown(ctx); err != nil {
+			return fmt.Errorf("shutting down tracing: %w", err)
+		}
+		return nil
+	}, nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	opencensusstats "go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/otel"
+
+	"github.com/ossf/scorecard/v5/stats"
+)
+
+func TestMetricsHandler(t *testing.T) {
+	t.Parallel()
+	handler, err := MetricsHandler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, err := tag.New(t.Context(),
+		tag.Upsert(stats.CheckName, "Binary-Artifacts"),
+		tag.Upsert(stats.RepoHost, "github.com"),
+		tag.Upsert(stats.ClientMethod, "ListFiles"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	opencensusstats.Record(ctx, stats.RepoClientCalls.M(1), stats.CheckLatencyInMs.M(42))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := w.Body.String()
+	for _, want := range []string{
+		`scorecard_RepoClientCallCount{checkName="Binary-Artifacts",clientMethod="ListFiles",repoHost="github.com"}`,
+		`scorecard_CheckLatency_bucket{checkName="Binary-Artifacts",repoHost="github.com",le="50"} 1`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("metrics don't contain %s:\n%s", want, body)
+		}
+	}
+}
+
+//nolint:paralleltest // Uses t.Setenv and sets the global tracer provider.
+func TestStartTracing(t *testing.T) {
+	var exported atomic.Int32
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/traces" {
+			if body, err := io.ReadAll(r.Body); err == nil && len(body) > 0 {
+				exported.Add(1)
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer collector.Close()
+	previous := otel.GetTracerProvider()
+	defer otel.SetTracerProvider(previous)
+
+	// Tracing is off without an endpoint.
+	shutdown, err := StartTracing(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := shutdown(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	if otel.GetTracerProvider() != previous {
+		t.Error("StartTracing() set a tracer provider without an endpoint")
+	}
+
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", collector.URL)
+	shutdown, err = StartTracing(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, span := otel.Tracer("test").Start(context.Background(), "test")
+	span.End()
+	if err := shutdown(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	if exported.Load() == 0 {
+		t.Error("no spans exported to the collector")
+	}
+}
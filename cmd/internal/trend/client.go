@@ -0,0 +1,37 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trend
+
+import (
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/git"
+)
+
+// CheckoutClient is a RepoClient reading a clone made by a git client, which
+// checks out the commit to analyze rather than cloning the repository again.
+// The clone is removed by closing the git client.
+type CheckoutClient struct {
+	*git.Client
+}
+
+// InitRepo checks out commitSHA.
+func (c CheckoutClient) InitRepo(_ clients.Repo, commitSHA string, _ int) error {
+	return c.Checkout(commitSHA) //nolint:wrapcheck // Checkout wraps its errors.
+}
+
+// Close keeps the clone for the next commit.
+func (c CheckoutClient) Close() error {
+	return nil
+}
@@ -0,0 +1,186 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package trend analyzes a series of commits of a repository, e.g. its
+// releases, and reports how their scores evolved.
+package trend
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+var (
+	errNotAncestor = errors.New("not a first-parent ancestor")
+	errNoTags      = errors.New("no tags match")
+)
+
+// Commit is a commit selected for analysis.
+type Commit struct {
+	// Date is the commit date.
+	Date time.Time
+	SHA  string
+	// Tag is the tag which selected the commit, if any.
+	Tag string
+}
+
+// Range returns the commits from since to until, both included, oldest
+// first. It follows the first parents of until, i.e. the history of the
+// branch rather than of the branches merged into it, so since must be one of
+// them.
+func Range(repo *git.Repository, since, until string) ([]Commit, error) {
+	sinceCommit, err := resolve(repo, since)
+	if err != nil {
+		return nil, err
+	}
+	c, err := resolve(repo, until)
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []Commit
+	for {
+		commits = append(commits, Commit{SHA: c.Hash.String(), Date: c.Committer.When})
+		if c.Hash == sinceCommit.Hash {
+			break
+		}
+		if c.NumParents() == 0 {
+			return nil, fmt.Errorf("%s of %s: %w", since, until, errNotAncestor)
+		}
+		parent, err := c.Parent(0)
+		if err != nil {
+			return nil, fmt.Errorf("reading parent of %s: %w", c.Hash, err)
+		}
+		c = parent
+	}
+	for i, j := 0, len(commits)-1; i < j; i, j = i+1, j-1 {
+		commits[i], commits[j] = commits[j], commits[i]
+	}
+	return commits, nil
+}
+
+// Sample returns at most n of commits, evenly spaced and including the first
+// and last ones, so that a long range is analyzed in a bounded time. All the
+// commits are returned if n is 0.
+func Sample(commits []Commit, n int) []Commit {
+	if n <= 0 || len(commits) <= n {
+		return commits
+	}
+	if n == 1 {
+		return commits[len(commits)-1:]
+	}
+	sampled := make([]Commit, 0, n)
+	for i := range n {
+		sampled = append(sampled, commits[i*(len(commits)-1)/(n-1)])
+	}
+	return sampled
+}
+
+// Tags returns the commits of the tags matching any of patterns, in the
+// syntax of [path.Match], ordered by commit date.
+func Tags(repo *git.Repository, patterns []string) ([]Commit, error) {
+	refs, err := repo.Tags()
+	if err != nil {
+		return nil, fmt.Errorf("listing tags: %w", err)
+	}
+	var commits []Commit
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		name := ref.Name().Short()
+		if !matchAny(patterns, name) {
+			return nil
+		}
+		c, err := tagCommit(repo, ref)
+		if err != nil {
+			return fmt.Errorf("resolving tag %s: %w", name, err)
+		}
+		commits = append(commits, Commit{SHA: c.Hash.String(), Date: c.Committer.When, Tag: name})
+		return nil
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck // The callback wraps its errors.
+	}
+	if len(commits) == 0 {
+		return nil, fmt.Errorf("%w: %v", errNoTags, patterns)
+	}
+	sort.SliceStable(commits, func(i, j int) bool {
+		return commits[i].Date.Before(commits[j].Date)
+	})
+	return commits, nil
+}
+
+func matchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if ok, err := path.Match(pattern, name); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+// tagCommit returns the commit of a lightweight or annotated tag.
+func tagCommit(repo *git.Repository, ref *plumbing.Reference) (*object.Commit, error) {
+	tag, err := repo.TagObject(ref.Hash())
+	switch {
+	case err == nil:
+		c, err := tag.Commit()
+		if err != nil {
+			return nil, fmt.Errorf("reading tagged commit: %w", err)
+		}
+		return c, nil
+	case errors.Is(err, plumbing.ErrObjectNotFound):
+		c, err := repo.CommitObject(ref.Hash())
+		if err != nil {
+			return nil, fmt.Errorf("reading commit: %w", err)
+		}
+		return c, nil
+	default:
+		return nil, fmt.Errorf("reading tag: %w", err)
+	}
+}
+
+// Resolve returns the commit of rev, e.g. a branch, a tag or a SHA.
+func Resolve(repo *git.Repository, rev string) (Commit, error) {
+	c, err := resolve(repo, rev)
+	if err != nil {
+		return Commit{}, err
+	}
+	return Commit{SHA: c.Hash.String(), Date: c.Committer.When}, nil
+}
+
+// resolve returns the commit of rev. The branches of a clone other than its
+// default branch only exist in the origin remote, so they are also looked up
+// there.
+func resolve(repo *git.Repository, rev string) (*object.Commit, error) {
+	hash, err := repo.ResolveRevision(plumbing.Revision(rev))
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		if remoteHash, remoteErr := repo.ResolveRevision(plumbing.Revision("origin/" + rev)); remoteErr == nil {
+			hash, err = remoteHash, nil
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("resolving %s: %w", rev, err)
+	}
+	c, err := repo.CommitObject(*hash)
+	if err != nil {
+		return nil, fmt.Errorf("reading commit %s: %w", rev, err)
+	}
+	return c, nil
+}
@@ -0,0 +1,183 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trend
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/google/go-cmp/cmp"
+)
+
+// testRepo returns a repository with a commit a day, and their hashes.
+func testRepo(t *testing.T, n int) (*git.Repository, []plumbing.Hash) {
+	t.Helper()
+	fs := memfs.New()
+	repo, err := git.Init(memory.NewStorage(), fs)
+	if err != nil {
+		t.Fatalf("git.Init: %v", err)
+	}
+	w, err := repo.Worktree()
+	if err != nil {
+		t.Fatalf("Worktree: %v", err)
+	}
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	var hashes []plumbing.Hash
+	for i := range n {
+		f, err := fs.Create("file")
+		if err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		if _, err := f.Write([]byte{byte(i)}); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+		f.Close()
+		if _, err := w.Add("file"); err != nil {
+			t.Fatalf("Add: %v", err)
+		}
+		sig := &object.Signature{Name: "Test Author", Email: "author@example.com", When: start.AddDate(0, 0, i)}
+		hash, err := w.Commit("commit", &git.CommitOptions{Author: sig, Committer: sig})
+		if err != nil {
+			t.Fatalf("Commit: %v", err)
+		}
+		hashes = append(hashes, hash)
+	}
+	return repo, hashes
+}
+
+func TestRange(t *testing.T) {
+	t.Parallel()
+	repo, hashes := testRepo(t, 5)
+	commits, err := Range(repo, hashes[1].String(), hashes[3].String())
+	if err != nil {
+		t.Fatalf("Range: %v", err)
+	}
+	var got []string
+	for _, c := range commits {
+		got = append(got, c.SHA)
+	}
+	want := []string{hashes[1].String(), hashes[2].String(), hashes[3].String()}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("commits mismatch (-want +got):\n%s", diff)
+	}
+	if !commits[0].Date.Equal(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("first commit date = %v, want 2025-01-02", commits[0].Date)
+	}
+
+	if _, err := Range(repo, hashes[3].String(), hashes[1].String()); !errors.Is(err, errNotAncestor) {
+		t.Errorf("Range of reversed refs: got %v, want %v", err, errNotAncestor)
+	}
+	if _, err := Range(repo, "v9.9.9", "HEAD"); err == nil {
+		t.Error("Range of an unknown ref succeeded, want error")
+	}
+}
+
+func TestSample(t *testing.T) {
+	t.Parallel()
+	var commits []Commit
+	for _, sha := range []string{"a", "b", "c", "d", "e", "f", "g"} {
+		commits = append(commits, Commit{SHA: sha})
+	}
+	tests := []struct {
+		name string
+		want []string
+		n    int
+	}{
+		{name: "all", n: 0, want: []string{"a", "b", "c", "d", "e", "f", "g"}},
+		{name: "fewer commits", n: 10, want: []string{"a", "b", "c", "d", "e", "f", "g"}},
+		{name: "first and last", n: 2, want: []string{"a", "g"}},
+		{name: "evenly spaced", n: 4, want: []string{"a", "c", "e", "g"}},
+		{name: "last", n: 1, want: []string{"g"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var got []string
+			for _, c := range Sample(commits, tt.n) {
+				got = append(got, c.SHA)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Sample(%d) mismatch (-want +got):\n%s", tt.n, diff)
+			}
+		})
+	}
+}
+
+func TestTags(t *testing.T) {
+	t.Parallel()
+	repo, hashes := testRepo(t, 4)
+	if _, err := repo.CreateTag("v1.1.0", hashes[2], &git.CreateTagOptions{
+		Tagger:  &object.Signature{Name: "Test Author", Email: "author@example.com", When: time.Now()},
+		Message: "v1.1.0",
+	}); err != nil {
+		t.Fatalf("CreateTag: %v", err)
+	}
+	if _, err := repo.CreateTag("v1.0.0", hashes[0], nil); err != nil {
+		t.Fatalf("CreateTag: %v", err)
+	}
+	if _, err := repo.CreateTag("nightly", hashes[3], nil); err != nil {
+		t.Fatalf("CreateTag: %v", err)
+	}
+
+	commits, err := Tags(repo, []string{"v*"})
+	if err != nil {
+		t.Fatalf("Tags: %v", err)
+	}
+	want := []Commit{
+		{SHA: hashes[0].String(), Tag: "v1.0.0", Date: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{SHA: hashes[2].String(), Tag: "v1.1.0", Date: time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)},
+	}
+	if diff := cmp.Diff(want, commits, cmp.Comparer(func(a, b time.Time) bool { return a.Equal(b) })); diff != "" {
+		t.Errorf("commits mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := Tags(repo, []string{"release-*"}); !errors.Is(err, errNoTags) {
+		t.Errorf("Tags without matches: got %v, want %v", err, errNoTags)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+	repo, hashes := testRepo(t, 3)
+	// Branches other than the default one are only fetched into the origin remote.
+	ref := plumbing.NewHashReference(plumbing.NewRemoteReferenceName("origin", "feature"), hashes[1])
+	if err := repo.Storer.SetReference(ref); err != nil {
+		t.Fatalf("SetReference: %v", err)
+	}
+	for rev, want := range map[string]plumbing.Hash{
+		"HEAD":             hashes[2],
+		"feature":          hashes[1],
+		"origin/feature":   hashes[1],
+		hashes[0].String(): hashes[0],
+	} {
+		c, err := Resolve(repo, rev)
+		if err != nil {
+			t.Errorf("Resolve(%s): %v", rev, err)
+			continue
+		}
+		if c.SHA != want.String() {
+			t.Errorf("Resolve(%s) = %s, want %s", rev, c.SHA, want)
+		}
+	}
+	if _, err := Resolve(repo, "missing"); err == nil {
+		t.Error("Resolve of a missing branch succeeded, want error")
+	}
+}
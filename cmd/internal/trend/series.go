@@ -0,0 +1,195 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trend
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ossf/scorecard/v5/checker"
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+)
+
+// Series is the time series of the scores of the analyzed commits of a
+// repository.
+type Series struct {
+	Repo   string  `json:"repo"`
+	Points []Point `json:"points"`
+}
+
+// Point is the analysis of a commit.
+type Point struct {
+	Date   time.Time `json:"date"`
+	Commit string    `json:"commit"`
+	Tag    string    `json:"tag,omitempty"`
+	// Error is why the commit couldn't be analyzed, if it couldn't.
+	Error  string          `json:"error,omitempty"`
+	Checks []CheckScore    `json:"checks,omitempty"`
+	Probes []ProbeOutcomes `json:"probes,omitempty"`
+	// Score is the aggregate score, or -1 if it is inconclusive.
+	Score float64 `json:"score"`
+}
+
+// CheckScore is the score of a check, or -1 if it is inconclusive.
+type CheckScore struct {
+	Name  string `json:"name"`
+	Score int    `json:"score"`
+}
+
+// ProbeOutcomes counts the findings of a probe by outcome.
+type ProbeOutcomes struct {
+	Outcomes map[finding.Outcome]int `json:"outcomes"`
+	Probe    string                  `json:"probe"`
+}
+
+// Add adds the analysis of c, or the error which prevented it, to the series.
+func (s *Series) Add(c Commit, result *scorecard.Result, scanErr error, checkDocs docs.Doc) error {
+	p := Point{Date: c.Date, Commit: c.SHA, Tag: c.Tag, Score: checker.InconclusiveResultScore}
+	if scanErr != nil {
+		p.Error = scanErr.Error()
+		s.Points = append(s.Points, p)
+		return nil
+	}
+
+	score, err := result.GetAggregateScore(checkDocs)
+	if err != nil {
+		return fmt.Errorf("computing score of %s: %w", c.SHA, err)
+	}
+	p.Score = score
+
+	probes := make(map[string]map[finding.Outcome]int)
+	count := func(findings []finding.Finding) {
+		for i := range findings {
+			f := &findings[i]
+			if probes[f.Probe] == nil {
+				probes[f.Probe] = make(map[finding.Outcome]int)
+			}
+			probes[f.Probe][f.Outcome]++
+		}
+	}
+	for i := range result.Checks {
+		check := &result.Checks[i]
+		p.Checks = append(p.Checks, CheckScore{Name: check.Name, Score: check.Score})
+		count(check.Findings)
+	}
+	count(result.Findings)
+	sort.Slice(p.Checks, func(i, j int) bool {
+		return p.Checks[i].Name < p.Checks[j].Name
+	})
+	for probe, outcomes := range probes {
+		p.Probes = append(p.Probes, ProbeOutcomes{Probe: probe, Outcomes: outcomes})
+	}
+	sort.Slice(p.Probes, func(i, j int) bool {
+		return p.Probes[i].Probe < p.Probes[j].Probe
+	})
+
+	s.Points = append(s.Points, p)
+	return nil
+}
+
+// WriteJSON writes the series as JSON.
+func (s *Series) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(s); err != nil {
+		return fmt.Errorf("encoding series: %w", err)
+	}
+	return nil
+}
+
+// WriteTable writes the aggregate and check scores of each commit as a table,
+// oldest first. Inconclusive scores are written as "?", and commits that could
+// not be analyzed are followed by their error.
+func (s *Series) WriteTable(w io.Writer) error {
+	var checks []string
+	seen := make(map[string]bool)
+	for _, p := range s.Points {
+		for _, c := range p.Checks {
+			if !seen[c.Name] {
+				seen[c.Name] = true
+				checks = append(checks, c.Name)
+			}
+		}
+	}
+	sort.Strings(checks)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(append([]string{"DATE", "COMMIT", "TAG", "SCORE"}, checks...), "\t"))
+	for _, p := range s.Points {
+		tag := p.Tag
+		if tag == "" {
+			tag = "-"
+		}
+		row := []string{p.Date.UTC().Format(time.DateOnly), shortSHA(p.Commit), tag}
+		if p.Error != "" {
+			// Keep the check columns so that later rows stay aligned.
+			row = append(row, "error")
+			for range checks {
+				row = append(row, "-")
+			}
+			row = append(row, p.Error)
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+			continue
+		}
+		row = append(row, formatScore(p.Score))
+		scores := make(map[string]int, len(p.Checks))
+		for _, c := range p.Checks {
+			scores[c.Name] = c.Score
+		}
+		for _, name := range checks {
+			score, ok := scores[name]
+			if !ok {
+				row = append(row, "-")
+				continue
+			}
+			row = append(row, formatCheckScore(score))
+		}
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("writing table: %w", err)
+	}
+	return nil
+}
+
+func shortSHA(sha string) string {
+	const length = 12
+	if len(sha) > length {
+		return sha[:length]
+	}
+	return sha
+}
+
+func formatCheckScore(score int) string {
+	if score == checker.InconclusiveResultScore {
+		return "?"
+	}
+	return strconv.Itoa(score)
+}
+
+func formatScore(score float64) string {
+	if score == checker.InconclusiveResultScore {
+		return "?"
+	}
+	return strconv.FormatFloat(score, 'f', 1, 64)
+}
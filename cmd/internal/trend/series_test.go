@@ -0,0 +1,119 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trend
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+)
+
+var errClone = errors.New("checkout failed")
+
+func testSeries(t *testing.T) *Series {
+	t.Helper()
+	checkDocs, err := docs.Read()
+	if err != nil {
+		t.Fatalf("docs.Read: %v", err)
+	}
+	s := &Series{Repo: "github.com/ossf/scorecard"}
+	day := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	results := []*scorecard.Result{
+		{Checks: []checker.CheckResult{
+			{Name: "Pinned-Dependencies", Score: 3, Findings: []finding.Finding{
+				{Probe: "pinsDependencies", Outcome: finding.OutcomeFalse},
+				{Probe: "pinsDependencies", Outcome: finding.OutcomeFalse},
+				{Probe: "pinsDependencies", Outcome: finding.OutcomeTrue},
+			}},
+			{Name: "Binary-Artifacts", Score: 10},
+		}},
+		nil,
+		{Checks: []checker.CheckResult{
+			{Name: "Pinned-Dependencies", Score: 10, Findings: []finding.Finding{
+				{Probe: "pinsDependencies", Outcome: finding.OutcomeTrue},
+			}},
+			{Name: "Binary-Artifacts", Score: checker.InconclusiveResultScore},
+		}},
+	}
+	for i, result := range results {
+		c := Commit{SHA: "0123456789abcdef0123456789abcdef0123456" + string(rune('0'+i)), Date: day.AddDate(0, 0, i)}
+		var err error
+		if result == nil {
+			err = errClone
+		}
+		if i == 2 {
+			c.Tag = "v1.0.0"
+		}
+		if err := s.Add(c, result, err, checkDocs); err != nil {
+			t.Fatalf("Add: %v", err)
+		}
+	}
+	return s
+}
+
+func TestSeriesJSON(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	if err := testSeries(t).WriteJSON(&buf); err != nil {
+		t.Fatalf("WriteJSON: %v", err)
+	}
+	var got Series
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("parsing series: %v", err)
+	}
+	if len(got.Points) != 3 {
+		t.Fatalf("got %d points, want 3", len(got.Points))
+	}
+	first := got.Points[0]
+	wantChecks := []CheckScore{{Name: "Binary-Artifacts", Score: 10}, {Name: "Pinned-Dependencies", Score: 3}}
+	if diff := cmp.Diff(wantChecks, first.Checks); diff != "" {
+		t.Errorf("checks mismatch (-want +got):\n%s", diff)
+	}
+	wantProbes := []ProbeOutcomes{{
+		Probe:    "pinsDependencies",
+		Outcomes: map[finding.Outcome]int{finding.OutcomeFalse: 2, finding.OutcomeTrue: 1},
+	}}
+	if diff := cmp.Diff(wantProbes, first.Probes); diff != "" {
+		t.Errorf("probes mismatch (-want +got):\n%s", diff)
+	}
+	if got.Points[1].Error != errClone.Error() || got.Points[1].Score != checker.InconclusiveResultScore {
+		t.Errorf("failed point = %+v, want the error and an inconclusive score", got.Points[1])
+	}
+}
+
+func TestSeriesTable(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	if err := testSeries(t).WriteTable(&buf); err != nil {
+		t.Fatalf("WriteTable: %v", err)
+	}
+	want := `DATE        COMMIT        TAG     SCORE  Binary-Artifacts  Pinned-Dependencies
+2025-01-01  0123456789ab  -       7.2    10                3
+2025-01-02  0123456789ab  -       error  -                 -  checkout failed
+2025-01-03  0123456789ab  v1.0.0  10.0   ?                 10
+`
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("table mismatch (-want +got):\n%s", diff)
+	}
+}
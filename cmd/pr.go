@@ -0,0 +1,72 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/ossf/scorecard/v5/cmd/internal/pullrequest"
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	"github.com/ossf/scorecard/v5/options"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+)
+
+// prCmd runs the probes which analyze files on the files changed by the pull
+// request of --pr, at its head, and writes the findings on the changed lines
+// as SARIF.
+func prCmd(
+	ctx context.Context,
+	o *options.Options,
+	uri string,
+	opts []scorecard.Option,
+	checkDocs docs.Doc,
+) error {
+	repo, err := makeRepo(uri)
+	if err != nil {
+		return err
+	}
+	pr, err := pullrequest.Fetch(ctx, repo, o.PR)
+	if err != nil {
+		return fmt.Errorf("fetching pull request %d: %w", o.PR, err)
+	}
+	fmt.Fprintf(os.Stderr, "Analyzing %d changed files of pull request %d at %s\n", len(pr.Files), o.PR, pr.HeadSHA)
+
+	opts = append(opts[:len(opts):len(opts)],
+		scorecard.WithCommitSHA(pr.HeadSHA),
+		scorecard.WithPaths(pr.Paths()),
+		scorecard.WithProbes(pullrequest.Probes),
+	)
+	result, err := scanRepo(ctx, repo, o, opts)
+	if err != nil {
+		return err
+	}
+	result.Findings = pr.Annotations(result.Findings)
+
+	output := os.Stdout
+	if o.ResultsFile != "" {
+		output, err = os.Create(o.ResultsFile)
+		if err != nil {
+			return fmt.Errorf("unable to create output file: %w", err)
+		}
+		fmt.Fprintln(os.Stderr, "Writing results to", o.ResultsFile)
+		defer output.Close()
+	}
+	if err := result.AsFindingsSARIF(output, checkDocs, o); err != nil {
+		return fmt.Errorf("writing SARIF: %w", err)
+	}
+	return nil
+}
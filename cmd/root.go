@@ -23,6 +23,7 @@ import (
 	"os"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/google/osv-scanner/v2/pkg/osvscanner"
 	"github.com/spf13/cobra"
@@ -36,6 +37,7 @@ import (
 	"github.com/ossf/scorecard/v5/clients/localdir"
 	orgpkg "github.com/ossf/scorecard/v5/cmd/internal/org"
 	pmc "github.com/ossf/scorecard/v5/cmd/internal/packagemanager"
+	"github.com/ossf/scorecard/v5/cmd/internal/telemetry"
 	docs "github.com/ossf/scorecard/v5/docs/checks"
 	sclog "github.com/ossf/scorecard/v5/log"
 	"github.com/ossf/scorecard/v5/options"
@@ -57,19 +59,10 @@ const (
 // New creates a new instance of the scorecard command.
 func New(o *options.Options) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   scorecardUse,
-		Short: scorecardShort,
-		Long:  scorecardLong,
-		PreRunE: func(cmd *cobra.Command, args []string) error {
-			err := o.Validate()
-			if err != nil {
-				return fmt.Errorf("validating options: %w", err)
-			}
-			// options are good at this point. silence usage so it doesn't print for runtime errors
-			cmd.SilenceUsage = true
-
-			return nil
-		},
+		Use:     scorecardUse,
+		Short:   scorecardShort,
+		Long:    scorecardLong,
+		PreRunE: validateOptions(o),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return rootCmd(o)
 		},
@@ -79,10 +72,24 @@ func New(o *options.Options) *cobra.Command {
 
 	// Add sub-commands.
 	cmd.AddCommand(serveCmd(o))
+	cmd.AddCommand(diffCmd(o))
 	cmd.AddCommand(version.Version())
 	return cmd
 }
 
+func validateOptions(o *options.Options) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		err := o.Validate()
+		if err != nil {
+			return fmt.Errorf("validating options: %w", err)
+		}
+		// options are good at this point. silence usage so it doesn't print for runtime errors
+		cmd.SilenceUsage = true
+
+		return nil
+	}
+}
+
 // Build the list of repositories to scan.
 func buildRepoURLs(ctx context.Context, o *options.Options) ([]string, error) {
 	// --repos has highest precedence
@@ -97,9 +104,13 @@ func buildRepoURLs(ctx context.Context, o *options.Options) ([]string, error) {
 		return urls, nil
 	}
 
-	// --org: expand to all non-archived repos
+	// --org: expand to the repos selected by the --org-* filters
 	if o.Org != "" {
-		repos, err := orgpkg.ListOrgRepos(ctx, o.Org, nil)
+		filter, err := orgFilter(o)
+		if err != nil {
+			return nil, err
+		}
+		repos, err := orgpkg.ListOrgRepos(ctx, o.Org, filter, nil)
 		if err != nil {
 			return nil, fmt.Errorf("listing repositories for org %q: %w", o.Org, err)
 		}
@@ -125,10 +136,49 @@ func buildRepoURLs(ctx context.Context, o *options.Options) ([]string, error) {
 	return []string{o.Repo}, nil
 }
 
+// orgFilter returns the filter of the repositories of --org.
+func orgFilter(o *options.Options) (*orgpkg.Filter, error) {
+	filter := &orgpkg.Filter{
+		Archived:   orgpkg.Inclusion(o.OrgArchived),
+		Forks:      orgpkg.Inclusion(o.OrgForks),
+		Visibility: o.OrgVisibility,
+		Topics:     o.OrgTopics,
+	}
+	if o.OrgActiveSince != "" {
+		since, err := orgpkg.ParseActiveSince(o.OrgActiveSince, time.Now())
+		if err != nil {
+			return nil, fmt.Errorf("parsing --%s: %w", options.FlagOrgActiveSince, err)
+		}
+		filter.ActiveSince = since
+	}
+	if o.OrgFilter != "" {
+		expr, err := orgpkg.ParseExpression(o.OrgFilter, time.Now())
+		if err != nil {
+			return nil, fmt.Errorf("parsing --%s: %w", options.FlagOrgFilter, err)
+		}
+		filter.Expression = expr
+	}
+	return filter, nil
+}
+
 // rootCmd runs scorecard checks given a set of arguments.
 func rootCmd(o *options.Options) error {
 	ctx := context.Background()
 
+	shutdownTracing, err := telemetry.StartTracing(ctx)
+	if err != nil {
+		return fmt.Errorf("starting tracing: %w", err)
+	}
+	defer func() {
+		if err := shutdownTracing(ctx); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+		}
+	}()
+
+	if o.Fix {
+		return fixCmd(ctx, o)
+	}
+
 	// Build the list of repos (only split this logic out)
 	repoURLs, err := buildRepoURLs(ctx, o)
 	if err != nil {
@@ -148,12 +198,13 @@ func rootCmd(o *options.Options) error {
 	}
 
 	var requiredRequestTypes []checker.RequestType
-	// if local option not set add file based
-	if o.Local != "" {
+	// if local option set, or commits are analyzed in a local clone, add file based
+	inClone := o.IsTrend() || o.IsDiff()
+	if o.Local != "" || inClone {
 		requiredRequestTypes = append(requiredRequestTypes, checker.FileBased)
 	}
 	// if commit option set to anything other than HEAD add commit based
-	if !strings.EqualFold(o.Commit, clients.HeadSHA) {
+	if !strings.EqualFold(o.Commit, clients.HeadSHA) || inClone {
 		requiredRequestTypes = append(requiredRequestTypes, checker.CommitBased)
 	}
 
@@ -188,6 +239,35 @@ func rootCmd(o *options.Options) error {
 	if strings.EqualFold(o.FileMode, options.FileModeGit) {
 		opts = append(opts, scorecard.WithFileModeGit())
 	}
+	if o.SASTSARIF != "" {
+		opts = append(opts, scorecard.WithCodeScanningSARIF(o.SASTSARIF))
+	}
+	if o.SASTAlertAge != 0 {
+		opts = append(opts, scorecard.WithSASTAlertAge(o.SASTAlertAge))
+	}
+	if o.RemoteWorkflows {
+		opts = append(opts, scorecard.WithRemoteWorkflows())
+	}
+
+	if o.IsPR() {
+		return prCmd(ctx, o, repoURLs[0], opts, checkDocs)
+	}
+
+	if o.IsDiff() {
+		return diffRefs(ctx, o, repoURLs[0], opts, checkDocs, pol)
+	}
+
+	if o.IsTrend() {
+		return trendCmd(ctx, o, repoURLs[0], opts, checkDocs)
+	}
+
+	if o.IsBatch() {
+		// Probes don't have scores to tabulate.
+		if len(enabledProbes) > 0 {
+			checks = nil
+		}
+		return batchCmd(ctx, o, repoURLs, opts, checks, checkDocs, pol)
+	}
 
 	// Track whether any check produced a runtime error during scans. We want to
 	// continue scanning all repos but return a non-nil error at the end so the
@@ -288,19 +368,9 @@ func processRepo(
 	checkDocs docs.Doc,
 	pol *policy.ScorecardPolicy,
 ) (*scorecard.Result, error) {
-	var repo clients.Repo
-	var err error
-
-	if o.Local != "" && uri == o.Local {
-		repo, err = localdir.MakeLocalDirRepo(uri)
-		if err != nil {
-			return nil, fmt.Errorf("localdir: %w", err)
-		}
-	} else {
-		repo, err = makeRepo(uri)
-		if err != nil {
-			return nil, err
-		}
+	repo, err := repoFromURI(o, uri)
+	if err != nil {
+		return nil, err
 	}
 
 	// Start banners with repo uri (show banners in default format only)
@@ -312,18 +382,11 @@ func processRepo(
 		}
 	}
 
-	result, err := scorecard.Run(ctx, repo, opts...)
+	result, err := scanRepo(ctx, repo, o, opts)
 	if err != nil {
-		return nil, fmt.Errorf("run: %w", err)
+		return nil, err
 	}
 
-	result.Metadata = append(result.Metadata, o.Metadata...)
-
-	// Stable order
-	sort.Slice(result.Checks, func(i, j int) bool {
-		return result.Checks[i].Name < result.Checks[j].Name
-	})
-
 	// End banners BEFORE RESULTS
 	if o.Format == options.FormatDefault {
 		if len(enabledProbes) > 0 {
@@ -334,7 +397,7 @@ func processRepo(
 		}
 	}
 
-	if err := scorecard.FormatResults(o, &result, checkDocs, pol); err != nil {
+	if err := scorecard.FormatResults(o, result, checkDocs, pol); err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to format results for %s: %v\n", uri, err)
 	}
 
@@ -345,5 +408,38 @@ func processRepo(
 		}
 	}
 
+	return result, nil
+}
+
+// repoFromURI returns the repository to scan for uri.
+func repoFromURI(o *options.Options, uri string) (clients.Repo, error) {
+	if o.Local != "" && uri == o.Local {
+		repo, err := localdir.MakeLocalDirRepo(uri)
+		if err != nil {
+			return nil, fmt.Errorf("localdir: %w", err)
+		}
+		return repo, nil
+	}
+	return makeRepo(uri)
+}
+
+// scanRepo runs scorecard on repo and adds the metadata of the options.
+func scanRepo(
+	ctx context.Context,
+	repo clients.Repo,
+	o *options.Options,
+	opts []scorecard.Option,
+) (*scorecard.Result, error) {
+	result, err := scorecard.Run(ctx, repo, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("run: %w", err)
+	}
+
+	result.Metadata = append(result.Metadata, o.Metadata...)
+
+	// Stable order
+	sort.Slice(result.Checks, func(i, j int) bool {
+		return result.Checks[i].Name < result.Checks[j].Name
+	})
 	return &result, nil
 }
@@ -15,24 +15,36 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
 	stdlog "log"
+	"math"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"sort"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
 
 	"github.com/spf13/cobra"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"sigs.k8s.io/release-utils/version"
 
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/cmd/internal/auth"
+	"github.com/ossf/scorecard/v5/cmd/internal/jobs"
 	pmc "github.com/ossf/scorecard/v5/cmd/internal/packagemanager"
+	"github.com/ossf/scorecard/v5/cmd/internal/resultcache"
+	"github.com/ossf/scorecard/v5/cmd/internal/telemetry"
 	docs "github.com/ossf/scorecard/v5/docs/checks"
 	"github.com/ossf/scorecard/v5/log"
 	"github.com/ossf/scorecard/v5/options"
@@ -40,8 +52,33 @@ import (
 	"github.com/ossf/scorecard/v5/policy"
 )
 
+var (
+	// errInvalidRequest indicates a request can't be served as given.
+	errInvalidRequest = errors.New("invalid request")
+	// errForbidden indicates a request is valid but not allowed.
+	errForbidden    = errors.New("forbidden")
+	errTLSConfig    = errors.New("invalid TLS configuration")
+	errTooManyScans = errors.New("too many concurrent scans")
+)
+
+// anonymous is the authentication method of clients when the server doesn't
+// authenticate them. Anonymous clients are identified by their IP address.
+const anonymous = "anonymous"
+
 type server struct {
 	logger *log.Logger
+	jobs   *jobs.Manager
+	// cache is nil if results aren't cached.
+	cache resultcache.Cache
+	// authenticator is nil if clients don't authenticate.
+	authenticator auth.Authenticator
+	limiter       *auth.Limiter
+	// metrics serves /metrics, unless nil.
+	metrics      http.Handler
+	allowedRepos auth.Allowlist
+	// scanOptions are added to the options of every scan, e.g. to replace the
+	// clients of the analyses in tests.
+	scanOptions []scorecard.Option
 }
 
 type scorecardRequest struct {
@@ -57,35 +94,61 @@ type scorecardRequest struct {
 	CommitDepth     int      `json:"commit_depth,omitempty"`
 	ShowDetails     bool     `json:"show_details,omitempty"`
 	ShowAnnotations bool     `json:"show_annotations,omitempty"`
+	// Refresh analyzes the repository even if a cached result exists.
+	Refresh bool `json:"refresh,omitempty"`
 }
 
-func newServer(logger *log.Logger) *server {
-	return &server{
-		logger: logger,
+// scan is a validated request, ready to run.
+type scan struct {
+	opts          *options.Options
+	repo          clients.Repo
+	checks        []string
+	scorecardOpts []scorecard.Option
+	refresh       bool
+}
+
+func newServer(logger *log.Logger, manager *jobs.Manager, cache resultcache.Cache,
+	authConfig *auth.Config,
+) (*server, error) {
+	authenticator, err := authConfig.Authenticator()
+	if err != nil {
+		return nil, fmt.Errorf("configuring authentication: %w", err)
 	}
+	return &server{
+		logger:        logger,
+		jobs:          manager,
+		cache:         cache,
+		authenticator: authenticator,
+		limiter:       authConfig.Limiter(),
+		allowedRepos:  authConfig.AllowedRepos,
+	}, nil
 }
 
-func (s *server) handleScorecard(w http.ResponseWriter, r *http.Request) {
+func decodeScorecardRequest(r *http.Request) (*scorecardRequest, error) {
 	var req scorecardRequest
 	if r.Method == http.MethodPost {
 		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			http.Error(w, "invalid request body", http.StatusBadRequest)
-			return
+			return nil, fmt.Errorf("%w: invalid request body", errInvalidRequest)
 		}
-	} else {
-		req.Repo = r.URL.Query().Get("repo")
-		req.NPM = r.URL.Query().Get("npm")
-		req.PyPI = r.URL.Query().Get("pypi")
-		req.RubyGems = r.URL.Query().Get("rubygems")
-		req.Nuget = r.URL.Query().Get("nuget")
-		req.Checks = strings.Split(r.URL.Query().Get("checks"), ",")
-		req.Commit = r.URL.Query().Get("commit")
-		req.ShowDetails = r.URL.Query().Get("show_details") == "true"
-		req.ShowAnnotations = r.URL.Query().Get("show_annotations") == "true"
-		req.Probes = strings.Split(r.URL.Query().Get("probes"), ",")
-		req.FileMode = r.URL.Query().Get("file_mode")
+		return &req, nil
 	}
+	req.Repo = r.URL.Query().Get("repo")
+	req.NPM = r.URL.Query().Get("npm")
+	req.PyPI = r.URL.Query().Get("pypi")
+	req.RubyGems = r.URL.Query().Get("rubygems")
+	req.Nuget = r.URL.Query().Get("nuget")
+	req.Checks = strings.Split(r.URL.Query().Get("checks"), ",")
+	req.Commit = r.URL.Query().Get("commit")
+	req.ShowDetails = r.URL.Query().Get("show_details") == "true"
+	req.ShowAnnotations = r.URL.Query().Get("show_annotations") == "true"
+	req.Probes = strings.Split(r.URL.Query().Get("probes"), ",")
+	req.FileMode = r.URL.Query().Get("file_mode")
+	req.Refresh = r.URL.Query().Get("refresh") == "true"
+	return &req, nil
+}
 
+// prepareScan validates a request and resolves the repository and checks to run.
+func (s *server) prepareScan(req *scorecardRequest) (*scan, error) {
 	// Create a new options instance for each request to avoid race conditions
 	opts := options.New()
 
@@ -115,36 +178,25 @@ func (s *server) handleScorecard(w http.ResponseWriter, r *http.Request) {
 
 	// Validate options
 	if err := opts.Validate(); err != nil {
-		http.Error(w, fmt.Sprintf("invalid options: %v", err), http.StatusBadRequest)
-		return
+		return nil, fmt.Errorf("%w: invalid options: %w", errInvalidRequest, err)
 	}
 
 	p := &pmc.PackageManagerClient{}
 	// Set repo from package managers
 	pkgResp, err := fetchGitRepositoryFromPackageManagers(opts.NPM, opts.PyPI, opts.RubyGems, opts.Nuget, p)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("fetchGitRepositoryFromPackageManagers: %v", err), http.StatusInternalServerError)
-		return
+		return nil, fmt.Errorf("fetchGitRepositoryFromPackageManagers: %w", err)
 	}
 	if pkgResp.exists {
 		opts.Repo = pkgResp.associatedRepo
 	}
 
-	ctx := r.Context()
-
-	var repo clients.Repo
-
-	repo, err = makeRepo(opts.Repo)
+	repo, err := makeRepo(opts.Repo)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("making remote repo: %v", err), http.StatusInternalServerError)
-		return
+		return nil, fmt.Errorf("making remote repo: %w", err)
 	}
-
-	// Read docs
-	checkDocs, err := docs.Read()
-	if err != nil {
-		http.Error(w, fmt.Sprintf("cannot read yaml file: %v", err), http.StatusInternalServerError)
-		return
+	if !s.allowedRepos.Allows(repo.URI()) {
+		return nil, fmt.Errorf("%w: %s is not in the allowed repositories", errForbidden, repo.URI())
 	}
 
 	var requiredRequestTypes []checker.RequestType
@@ -156,8 +208,7 @@ func (s *server) handleScorecard(w http.ResponseWriter, r *http.Request) {
 	}
 	enabledChecks, err := policy.GetEnabled(nil, opts.Checks(), requiredRequestTypes, repo.Type())
 	if err != nil {
-		http.Error(w, fmt.Sprintf("GetEnabled: %v", err), http.StatusInternalServerError)
-		return
+		return nil, fmt.Errorf("GetEnabled: %w", err)
 	}
 
 	checks := make([]string, 0, len(enabledChecks))
@@ -178,39 +229,454 @@ func (s *server) handleScorecard(w http.ResponseWriter, r *http.Request) {
 	if strings.EqualFold(opts.FileMode, options.FileModeGit) {
 		scorecardOpts = append(scorecardOpts, scorecard.WithFileModeGit())
 	}
+	scorecardOpts = append(scorecardOpts, s.scanOptions...)
+
+	// Probes run instead of checks.
+	if len(enabledProbes) > 0 {
+		checks = nil
+	}
+	return &scan{
+		opts:          opts,
+		repo:          repo,
+		checks:        checks,
+		scorecardOpts: scorecardOpts,
+		refresh:       req.Refresh,
+	}, nil
+}
 
-	repoResult, err := scorecard.Run(ctx, repo, scorecardOpts...)
+func (sc *scan) run(ctx context.Context, extra ...scorecard.Option) (*scorecard.Result, error) {
+	opts := append(append([]scorecard.Option{}, sc.scorecardOpts...), extra...)
+	repoResult, err := scorecard.Run(ctx, sc.repo, opts...)
 	if err != nil {
-		s.logger.Error(err, "scorecard.Run")
-		http.Error(w, fmt.Sprintf("scorecard.Run: %v", err), http.StatusInternalServerError)
-		return
+		return nil, fmt.Errorf("scorecard.Run: %w", err)
 	}
 
-	repoResult.Metadata = append(repoResult.Metadata, opts.Metadata...)
+	repoResult.Metadata = append(repoResult.Metadata, sc.opts.Metadata...)
 
 	// Sort by name
 	sort.Slice(repoResult.Checks, func(i, j int) bool {
 		return repoResult.Checks[i].Name < repoResult.Checks[j].Name
 	})
+	return &repoResult, nil
+}
 
-	// Return results
-	w.Header().Set("Content-Type", "application/json")
-	if err := repoResult.AsJSON2(w, checkDocs, &scorecard.AsJSON2ResultOption{
-		LogLevel:    log.ParseLevel(opts.LogLevel),
-		Details:     opts.ShowDetails,
-		Annotations: opts.ShowAnnotations,
-	}); err != nil {
-		s.logger.Error(err, "writing JSON response")
+// cacheKey returns the key of the results of the scan of a commit.
+func (sc *scan) cacheKey(commit string) string {
+	key := resultcache.Key{
+		Repo:     sc.repo.URI(),
+		Commit:   commit,
+		Version:  version.GetVersionInfo().GitVersion,
+		FileMode: sc.opts.FileMode,
+		Checks:   sc.checks,
+		Probes:   sc.opts.Probes(),
+		Depth:    sc.opts.CommitDepth,
+	}
+	return key.String()
+}
+
+// runScan runs a scan, or returns the cached result of the same scan of the
+// same commit. The returned bool is true if the result comes from the cache.
+func (s *server) runScan(ctx context.Context, sc *scan, extra ...scorecard.Option) (*scorecard.Result, bool, error) {
+	if s.cache == nil {
+		result, err := sc.run(ctx, extra...)
+		return result, false, err
+	}
+	if !sc.refresh {
+		// Resolving the commit is cheap compared to the analysis.
+		commit, err := scorecard.ResolveCommit(ctx, sc.repo, sc.scorecardOpts...)
+		if err == nil {
+			result, err := s.cache.Get(ctx, sc.cacheKey(commit))
+			if err == nil {
+				return result, true, nil
+			}
+			if !errors.Is(err, resultcache.ErrMiss) {
+				s.logger.Error(err, "reading result cache")
+			}
+		} else {
+			s.logger.Error(err, "resolving commit, skipping result cache")
+		}
+	}
+
+	result, err := sc.run(ctx, extra...)
+	if err != nil {
+		return nil, false, err
+	}
+	// Results with check errors aren't cached, so that the checks are retried.
+	for i := range result.Checks {
+		if result.Checks[i].Error != nil {
+			return result, false, nil
+		}
+	}
+	if err := s.cache.Put(ctx, sc.cacheKey(result.Repo.CommitSHA), result); err != nil {
+		s.logger.Error(err, "writing result cache")
+	}
+	return result, false, nil
+}
+
+func httpStatus(err error) int {
+	switch {
+	case errors.Is(err, errInvalidRequest):
+		return http.StatusBadRequest
+	case errors.Is(err, errForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, errTooManyScans):
+		return http.StatusTooManyRequests
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// owner returns the owner of the jobs submitted by the client of a request.
+// Jobs of anonymous clients have no owner, so that any client can follow them.
+func owner(r *http.Request) string {
+	client := auth.ClientFrom(r.Context())
+	if client == nil || client.Method == anonymous {
+		return ""
+	}
+	return client.Key()
+}
+
+// acquireScan reserves an analysis for the client of a request, within its
+// concurrency quota.
+func (s *server) acquireScan(r *http.Request) (func(), error) {
+	client := auth.ClientFrom(r.Context())
+	if client == nil {
+		return func() {}, nil
+	}
+	release, ok := s.limiter.Acquire(client.Key())
+	if !ok {
+		return nil, errTooManyScans
+	}
+	return release, nil
+}
+
+func (s *server) handleScorecard(w http.ResponseWriter, r *http.Request) {
+	req, err := decodeScorecardRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+	sc, err := s.prepareScan(req)
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+	release, err := s.acquireScan(r)
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+	defer release()
+
+	repoResult, cached, err := s.runScan(r.Context(), sc)
+	if err != nil {
+		s.logger.Error(err, "scorecard.Run")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if s.cache != nil {
+		w.Header().Set("X-Scorecard-Cache", cacheStatus(cached))
+	}
+	s.writeResult(w, repoResult, options.FormatJSON, sc.opts.ShowDetails, sc.opts.ShowAnnotations)
+}
+
+// writeResult writes a result in the given format: json, sarif or intoto.
+func (s *server) writeResult(w http.ResponseWriter, result *scorecard.Result, format string,
+	showDetails, showAnnotations bool,
+) {
+	// Read docs
+	checkDocs, err := docs.Read()
+	if err != nil {
+		http.Error(w, fmt.Sprintf("cannot read yaml file: %v", err), http.StatusInternalServerError)
+		return
+	}
+	jsonOpts := scorecard.AsJSON2ResultOption{
+		LogLevel:    log.InfoLevel,
+		Details:     showDetails,
+		Annotations: showAnnotations,
+	}
+
+	// Results are buffered so errors can still be reported with a status code.
+	var buf bytes.Buffer
+	switch format {
+	case options.FormatJSON:
+		w.Header().Set("Content-Type", "application/json")
+		err = result.AsJSON2(&buf, checkDocs, &jsonOpts)
+	case options.FormatSarif:
+		w.Header().Set("Content-Type", "application/sarif+json")
+		err = result.AsSARIF(showDetails, log.InfoLevel, &buf, checkDocs, enforceAllChecks(result), options.New())
+	case options.FormatInToto:
+		w.Header().Set("Content-Type", "application/json")
+		err = result.AsInToto(&buf, checkDocs, &scorecard.AsInTotoResultOption{AsJSON2ResultOption: jsonOpts})
+	default:
+		http.Error(w, fmt.Sprintf("unsupported format %q: expected json, sarif or intoto", format),
+			http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		s.logger.Error(err, "formatting results")
+		w.Header().Del("Content-Type")
 		http.Error(w, fmt.Sprintf("failed to format results: %v", err), http.StatusInternalServerError)
 		return
 	}
+	if _, err := buf.WriteTo(w); err != nil {
+		s.logger.Error(err, "writing response")
+	}
+}
+
+// enforceAllChecks returns a policy requiring the maximum score for all the
+// checks of a result, so that SARIF results report all their findings.
+func enforceAllChecks(result *scorecard.Result) *policy.ScorecardPolicy {
+	pol := &policy.ScorecardPolicy{Version: 1, Policies: map[string]*policy.CheckPolicy{}}
+	for _, c := range result.Checks {
+		pol.Policies[c.Name] = &policy.CheckPolicy{
+			Mode:  policy.CheckPolicy_ENFORCED,
+			Score: checker.MaxResultScore,
+		}
+	}
+	return pol
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	//nolint:errchkjson // The status is already sent.
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func jobsErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, jobs.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, jobs.ErrFinished):
+		return http.StatusConflict
+	case errors.Is(err, jobs.ErrQueueFull), errors.Is(err, jobs.ErrStopped):
+		return http.StatusServiceUnavailable
+	default:
+		return httpStatus(err)
+	}
+}
+
+// handleSubmitJob queues the analysis of a repository and returns its job
+// without waiting for the analysis.
+func (s *server) handleSubmitJob(w http.ResponseWriter, r *http.Request) {
+	req, err := decodeScorecardRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+	sc, err := s.prepareScan(req)
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+	// Queued jobs count towards the quota, so that clients can't flood the queue.
+	release, err := s.acquireScan(r)
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return
+	}
+	job, err := s.jobs.Submit(&jobs.Spec{
+		Owner:           owner(r),
+		OnFinish:        release,
+		Repo:            sc.repo.URI(),
+		Checks:          sc.checks,
+		ShowDetails:     sc.opts.ShowDetails,
+		ShowAnnotations: sc.opts.ShowAnnotations,
+		Run: func(ctx context.Context, progress func(checker.CheckResult)) (*scorecard.Result, error) {
+			result, cached, err := s.runScan(ctx, sc, scorecard.WithProgress(progress))
+			if err != nil {
+				s.logger.Error(err, "scorecard.Run")
+				return nil, err
+			}
+			if cached {
+				for i := range result.Checks {
+					progress(result.Checks[i])
+				}
+			}
+			return result, nil
+		},
+	})
+	if err != nil {
+		release()
+		http.Error(w, err.Error(), jobsErrorStatus(err))
+		return
+	}
+	w.Header().Set("Location", "/jobs/"+job.ID)
+	writeJSON(w, http.StatusAccepted, job)
+}
+
+// getJob returns a job of the client of a request. The jobs of other clients
+// aren't found.
+func (s *server) getJob(r *http.Request) (jobs.Job, error) {
+	job, err := s.jobs.Get(r.PathValue("id"))
+	if err != nil {
+		return jobs.Job{}, fmt.Errorf("jobs.Get: %w", err)
+	}
+	if job.Owner != owner(r) {
+		return jobs.Job{}, jobs.ErrNotFound
+	}
+	return job, nil
+}
+
+func (s *server) handleGetJob(w http.ResponseWriter, r *http.Request) {
+	job, err := s.getJob(r)
+	if err != nil {
+		http.Error(w, err.Error(), jobsErrorStatus(err))
+		return
+	}
+	writeJSON(w, http.StatusOK, job)
+}
+
+func (s *server) handleCancelJob(w http.ResponseWriter, r *http.Request) {
+	if _, err := s.getJob(r); err != nil {
+		http.Error(w, err.Error(), jobsErrorStatus(err))
+		return
+	}
+	job, err := s.jobs.Cancel(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), jobsErrorStatus(err))
+		return
+	}
+	writeJSON(w, http.StatusOK, job)
+}
+
+func (s *server) handleJobResult(w http.ResponseWriter, r *http.Request) {
+	job, result, err := s.jobs.Result(r.PathValue("id"))
+	if err == nil && job.Owner != owner(r) {
+		err = jobs.ErrNotFound
+	}
+	if err != nil {
+		http.Error(w, err.Error(), jobsErrorStatus(err))
+		return
+	}
+	switch job.Status {
+	case jobs.StatusSucceeded:
+	case jobs.StatusFailed:
+		http.Error(w, fmt.Sprintf("job failed: %s", job.Error), http.StatusConflict)
+		return
+	case jobs.StatusQueued, jobs.StatusRunning, jobs.StatusCanceling, jobs.StatusCanceled:
+		http.Error(w, fmt.Sprintf("job is %s", job.Status), http.StatusConflict)
+		return
+	}
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = options.FormatJSON
+	}
+	s.writeResult(w, result, format, job.ShowDetails, job.ShowAnnotations)
+}
+
+func cacheStatus(cached bool) string {
+	if cached {
+		return "hit"
+	}
+	return "miss"
+}
+
+// handleInvalidateCache removes the cached results of a repository, or of one
+// of its commits.
+func (s *server) handleInvalidateCache(w http.ResponseWriter, r *http.Request) {
+	if s.cache == nil {
+		http.Error(w, "result cache is disabled", http.StatusNotFound)
+		return
+	}
+	repo, err := makeRepo(r.URL.Query().Get("repo"))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("%v: %v", errInvalidRequest, err), http.StatusBadRequest)
+		return
+	}
+	if !s.allowedRepos.Allows(repo.URI()) {
+		http.Error(w, fmt.Sprintf("%v: %s is not in the allowed repositories", errForbidden, repo.URI()),
+			http.StatusForbidden)
+		return
+	}
+	n, err := s.cache.Invalidate(r.Context(), resultcache.Prefix(repo.URI(), r.URL.Query().Get("commit")))
+	if err != nil {
+		s.logger.Error(err, "invalidating result cache")
+		http.Error(w, fmt.Sprintf("invalidating result cache: %v", err), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]int{"invalidated": n})
+}
+
+// routes returns the handler of the server's API.
+func (s *server) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet || r.Method == http.MethodPost {
+			s.handleScorecard(w, r)
+		} else {
+			http.NotFound(w, r)
+		}
+	})
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("POST /jobs", s.handleSubmitJob)
+	mux.HandleFunc("GET /jobs/{id}", s.handleGetJob)
+	mux.HandleFunc("DELETE /jobs/{id}", s.handleCancelJob)
+	mux.HandleFunc("GET /jobs/{id}/result", s.handleJobResult)
+	mux.HandleFunc("DELETE /cache", s.handleInvalidateCache)
+	if s.metrics != nil {
+		mux.Handle("GET /metrics", s.metrics)
+	}
+
+	handler := loggerMiddleware(recoverMiddleware(corsMiddleware(s.authMiddleware(mux))))
+	return otelhttp.NewHandler(handler, "scorecard serve")
+}
+
+// authenticate returns the client of a request.
+func (s *server) authenticate(r *http.Request) (*auth.Client, error) {
+	if s.authenticator == nil {
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			host = r.RemoteAddr
+		}
+		return &auth.Client{ID: host, Method: anonymous}, nil
+	}
+	client, err := s.authenticator.Authenticate(r)
+	if err != nil {
+		return nil, fmt.Errorf("authenticating request: %w", err)
+	}
+	return client, nil
+}
+
+// authMiddleware authenticates the clients of all the requests but health
+// checks and metrics scrapes, and enforces their rate limits. Metrics are
+// served without authentication so that scrapers don't need credentials: they
+// are only labeled by check and forge host, and don't identify clients or
+// repositories.
+func (s *server) authMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/health" || r.URL.Path == "/metrics" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		client, err := s.authenticate(r)
+		switch {
+		case errors.Is(err, auth.ErrNoCredentials), errors.Is(err, auth.ErrInvalidCredentials):
+			w.Header().Set("WWW-Authenticate", `Bearer realm="scorecard"`)
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		case err != nil:
+			s.logger.Error(err, "authenticating request")
+			http.Error(w, "authentication failed", http.StatusInternalServerError)
+			return
+		}
+		if retryAfter, ok := s.limiter.Allow(client.Key()); !ok {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(auth.WithClient(r.Context(), client)))
+	})
 }
 
 func corsMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Accept, Authorization, Content-Type")
 		if r.Method == http.MethodOptions {
 			w.WriteHeader(http.StatusOK)
 			return
@@ -240,27 +706,104 @@ func loggerMiddleware(next http.Handler) http.Handler {
 }
 
 func serveCmd(o *options.Options) *cobra.Command {
-	return &cobra.Command{
+	var (
+		workers      int
+		queueSize    int
+		jobRetention time.Duration
+		cacheURL     string
+		cacheSize    int
+		cacheTTL     time.Duration
+		authPath     string
+		tlsCert      string
+		tlsKey       string
+		tlsClientCA  string
+	)
+	cmd := &cobra.Command{
 		Use:   "serve",
 		Short: "Serve the scorecard program over http",
-		Long:  `Start an HTTP server to run scorecard checks on repositories with REST API support.`,
+		Long: `Start an HTTP server to run scorecard checks on repositories with REST API support.
+
+Besides the synchronous API on /, analyses can be run as jobs: POST /jobs
+queues an analysis and returns its ID, GET /jobs/{id} returns its status and
+the progress of each check, GET /jobs/{id}/result?format=json|sarif|intoto
+returns its result and DELETE /jobs/{id} cancels it. Running jobs are
+canceling, and keep their worker, until their analysis stops.
+
+With --cache, results are cached by repository, commit, scorecard version and
+requested checks or probes. Requests with "refresh" set ignore cached results,
+and DELETE /cache?repo=<repo>[&commit=<sha>] invalidates them.
+
+With --auth-config, clients authenticate with static bearer tokens, TLS client
+certificates (which need --tls-client-ca) or OIDC JWTs, and the file sets the
+rate limits and concurrent scans of each client and the repositories which can
+be analyzed, as hosts, owners or repositories:
+
+  tokens:
+    - client: ci
+      env: SCORECARD_CI_TOKEN
+  oidc:
+    issuer: https://token.actions.githubusercontent.com
+    audience: scorecard
+    client_claim: repository_owner
+  limits:
+    requests_per_minute: 60
+    burst: 10
+    max_concurrent_scans: 2
+  allowed_repos:
+    - github.com/ossf
+
+Clients are identified by their authentication method and ID, such as
+token:ci or oidc:ossf, which also key the limits of limits.clients. Without
+authentication, the limits apply to each client IP address.
+
+GET /metrics serves Prometheus metrics, including the latency of each check
+and the RepoClient calls and errors of each check. Like /health, it doesn't
+need authentication, so it shouldn't be exposed publicly. Setting
+OTEL_EXPORTER_OTLP_ENDPOINT exports traces of the requests, analyses, checks
+and forge API calls over OTLP/HTTP.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			logger := log.NewLogger(log.ParseLevel(o.LogLevel))
-			srv := newServer(logger)
-
-			mux := http.NewServeMux()
-			mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
-				if r.Method == http.MethodGet || r.Method == http.MethodPost {
-					srv.handleScorecard(w, r)
-				} else {
-					http.NotFound(w, r)
+			var cache resultcache.Cache
+			if cacheURL != "" {
+				var err error
+				cache, err = resultcache.Open(context.Background(), cacheURL, cacheSize, cacheTTL)
+				if err != nil {
+					return fmt.Errorf("opening result cache: %w", err)
 				}
-			})
-			mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
-				w.WriteHeader(http.StatusOK)
-			})
-
-			handler := loggerMiddleware(recoverMiddleware(corsMiddleware(mux)))
+				defer cache.Close()
+			}
+			authConfig := &auth.Config{}
+			if authPath != "" {
+				var err error
+				authConfig, err = auth.LoadConfig(authPath)
+				if err != nil {
+					return fmt.Errorf("loading auth config: %w", err)
+				}
+			}
+			tlsConfig, err := serverTLSConfig(tlsCert, tlsKey, tlsClientCA, authConfig.MTLS)
+			if err != nil {
+				return err
+			}
+			manager := jobs.NewManager(workers, queueSize, jobRetention)
+			defer manager.Stop()
+			srv, err := newServer(logger, manager, cache, authConfig)
+			if err != nil {
+				return err
+			}
+			srv.metrics, err = telemetry.MetricsHandler()
+			if err != nil {
+				return fmt.Errorf("serving metrics: %w", err)
+			}
+			shutdownTracing, err := telemetry.StartTracing(context.Background())
+			if err != nil {
+				return fmt.Errorf("starting tracing: %w", err)
+			}
+			defer func() {
+				if err := shutdownTracing(context.Background()); err != nil {
+					logger.Error(err, "shutting down tracing")
+				}
+			}()
+			handler := srv.routes()
 
 			port := os.Getenv("PORT")
 			if port == "" {
@@ -271,6 +814,7 @@ func serveCmd(o *options.Options) *cobra.Command {
 				Addr:              fmt.Sprintf("0.0.0.0:%s", port),
 				Handler:           handler,
 				ReadHeaderTimeout: 10 * time.Second,
+				TLSConfig:         tlsConfig,
 			}
 
 			done := make(chan os.Signal, 1)
@@ -278,7 +822,13 @@ func serveCmd(o *options.Options) *cobra.Command {
 
 			go func() {
 				logger.Info("Server starting on port " + port)
-				if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				var err error
+				if tlsCert != "" {
+					err = httpServer.ListenAndServeTLS(tlsCert, tlsKey)
+				} else {
+					err = httpServer.ListenAndServe()
+				}
+				if err != nil && !errors.Is(err, http.ErrServerClosed) {
 					logger.Error(err, "server error")
 				}
 			}()
@@ -296,4 +846,46 @@ func serveCmd(o *options.Options) *cobra.Command {
 			return nil
 		},
 	}
+	cmd.Flags().IntVar(&workers, "workers", 4, "number of jobs run concurrently")
+	cmd.Flags().IntVar(&queueSize, "queue-size", 100, "number of jobs waiting for a worker before new jobs are rejected")
+	cmd.Flags().DurationVar(&jobRetention, "job-retention", time.Hour, "how long the results of finished jobs are kept")
+	cmd.Flags().StringVar(&cacheURL, "cache", "",
+		"result cache: memory, or a blob bucket URL such as file:///var/cache/scorecard or gs://bucket")
+	cmd.Flags().IntVar(&cacheSize, "cache-size", 1000, "number of results kept by the memory cache")
+	cmd.Flags().DurationVar(&cacheTTL, "cache-ttl", 24*time.Hour, "how long results are cached")
+	cmd.Flags().StringVar(&authPath, "auth-config", "",
+		"YAML file configuring authentication, rate limits and allowed repositories")
+	cmd.Flags().StringVar(&tlsCert, "tls-cert", "", "TLS certificate file, to serve HTTPS")
+	cmd.Flags().StringVar(&tlsKey, "tls-key", "", "TLS private key file")
+	cmd.Flags().StringVar(&tlsClientCA, "tls-client-ca", "", "CA certificates file verifying TLS client certificates")
+	return cmd
+}
+
+// serverTLSConfig returns the TLS configuration of the server, or nil to serve HTTP.
+func serverTLSConfig(cert, key, clientCA string, mtls bool) (*tls.Config, error) {
+	switch {
+	case (cert == "") != (key == ""):
+		return nil, fmt.Errorf("%w: --tls-cert and --tls-key go together", errTLSConfig)
+	case cert == "" && clientCA != "":
+		return nil, fmt.Errorf("%w: --tls-client-ca needs --tls-cert", errTLSConfig)
+	case mtls && clientCA == "":
+		return nil, fmt.Errorf("%w: mtls authentication needs --tls-client-ca", errTLSConfig)
+	case cert == "":
+		return nil, nil
+	}
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if clientCA != "" {
+		pem, err := os.ReadFile(clientCA)
+		if err != nil {
+			return nil, fmt.Errorf("os.ReadFile: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("%w: no certificates in %s", errTLSConfig, clientCA)
+		}
+		config.ClientCAs = pool
+		// Clients can still authenticate with other methods.
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return config, nil
 }
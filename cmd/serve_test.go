@@ -0,0 +1,252 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ossf/scorecard/v5/clients"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+	"github.com/ossf/scorecard/v5/cmd/internal/auth"
+	"github.com/ossf/scorecard/v5/cmd/internal/jobs"
+	"github.com/ossf/scorecard/v5/cmd/internal/resultcache"
+	"github.com/ossf/scorecard/v5/log"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+)
+
+const testCommit = "0123456789abcdef0123456789abcdef01234567"
+
+// newTestServer serves the API with a fake repository client. Listing files
+// waits for listFiles to be closed, if set.
+func newTestServer(t *testing.T, cache resultcache.Cache, authConfig *auth.Config,
+	listFiles chan struct{},
+) *httptest.Server {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	repoClient := mockrepo.NewMockRepoClient(ctrl)
+	repoClient.EXPECT().InitRepo(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	repoClient.EXPECT().Close().Return(nil).AnyTimes()
+	repoClient.EXPECT().GetDefaultBranchName().Return("main", nil).AnyTimes()
+	repoClient.EXPECT().LocalPath().Return(t.TempDir(), nil).AnyTimes()
+	repoClient.EXPECT().GetFileReader(gomock.Any()).Return(nil, os.ErrNotExist).AnyTimes()
+	repoClient.EXPECT().ListCommits().Return([]clients.Commit{{SHA: testCommit}}, nil).AnyTimes()
+	repoClient.EXPECT().ListFiles(gomock.Any()).DoAndReturn(func(func(string) (bool, error)) ([]string, error) {
+		if listFiles != nil {
+			<-listFiles
+		}
+		return nil, nil
+	}).AnyTimes()
+
+	manager := jobs.NewManager(1, 10, time.Hour)
+	t.Cleanup(manager.Stop)
+	srv, err := newServer(log.NewLogger(log.InfoLevel), manager, cache, authConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.scanOptions = []scorecard.Option{scorecard.WithRepoClient(repoClient)}
+	ts := httptest.NewServer(srv.routes())
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func doRequest(t *testing.T, method, url, token, body string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequestWithContext(t.Context(), method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func decodeJob(t *testing.T, resp *http.Response) jobs.Job {
+	t.Helper()
+	var job jobs.Job
+	if err := json.NewDecoder(resp.Body).Decode(&job); err != nil {
+		t.Fatal(err)
+	}
+	return job
+}
+
+func submitJob(t *testing.T, ts *httptest.Server, token string) jobs.Job {
+	t.Helper()
+	resp := doRequest(t, http.MethodPost, ts.URL+"/jobs", token,
+		`{"repo": "github.com/ossf/scorecard", "checks": ["Binary-Artifacts"]}`)
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("POST /jobs status = %d", resp.StatusCode)
+	}
+	job := decodeJob(t, resp)
+	if resp.Header.Get("Location") != "/jobs/"+job.ID {
+		t.Errorf("Location = %q", resp.Header.Get("Location"))
+	}
+	return job
+}
+
+func waitForJob(t *testing.T, ts *httptest.Server, token, id string, status jobs.Status) jobs.Job {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		resp := doRequest(t, http.MethodGet, ts.URL+"/jobs/"+id, token, "")
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("GET /jobs/%s status = %d", id, resp.StatusCode)
+		}
+		job := decodeJob(t, resp)
+		if job.Status == status {
+			return job
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("job status = %s, want %s", job.Status, status)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestServeJob(t *testing.T) {
+	t.Parallel()
+	ts := newTestServer(t, nil, &auth.Config{}, nil)
+
+	job := submitJob(t, ts, "")
+	if len(job.Checks) != 1 || job.Checks[0].Name != "Binary-Artifacts" {
+		t.Errorf("unexpected checks: %+v", job.Checks)
+	}
+	done := waitForJob(t, ts, "", job.ID, jobs.StatusSucceeded)
+	if done.Checks[0].Status != jobs.CheckDone {
+		t.Errorf("unexpected progress: %+v", done.Checks)
+	}
+
+	resp := doRequest(t, http.MethodGet, ts.URL+"/jobs/"+job.ID+"/result", "", "")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("GET /jobs/%s/result status = %d", job.ID, resp.StatusCode)
+	}
+	var result struct {
+		Repo struct {
+			Name   string `json:"name"`
+			Commit string `json:"commit"`
+		} `json:"repo"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatal(err)
+	}
+	if result.Repo.Name != "github.com/ossf/scorecard" || result.Repo.Commit != testCommit {
+		t.Errorf("unexpected result: %+v", result)
+	}
+
+	if resp := doRequest(t, http.MethodDelete, ts.URL+"/jobs/"+job.ID, "", ""); resp.StatusCode != http.StatusConflict {
+		t.Errorf("DELETE finished job status = %d, want %d", resp.StatusCode, http.StatusConflict)
+	}
+	if resp := doRequest(t, http.MethodGet, ts.URL+"/jobs/unknown", "", ""); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("GET unknown job status = %d, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+}
+
+func TestServeCancelJob(t *testing.T) {
+	t.Parallel()
+	listFiles := make(chan struct{})
+	ts := newTestServer(t, nil, &auth.Config{}, listFiles)
+
+	job := submitJob(t, ts, "")
+	waitForJob(t, ts, "", job.ID, jobs.StatusRunning)
+
+	// The analysis is still running, so the job is canceling until it returns.
+	resp := doRequest(t, http.MethodDelete, ts.URL+"/jobs/"+job.ID, "", "")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("DELETE /jobs/%s status = %d", job.ID, resp.StatusCode)
+	}
+	if canceling := decodeJob(t, resp); canceling.Status != jobs.StatusCanceling {
+		t.Errorf("canceled job status = %s, want %s", canceling.Status, jobs.StatusCanceling)
+	}
+	close(listFiles)
+	waitForJob(t, ts, "", job.ID, jobs.StatusCanceled)
+
+	resp = doRequest(t, http.MethodGet, ts.URL+"/jobs/"+job.ID+"/result", "", "")
+	if resp.StatusCode != http.StatusConflict {
+		t.Errorf("GET canceled job result status = %d, want %d", resp.StatusCode, http.StatusConflict)
+	}
+}
+
+func TestServeAuth(t *testing.T) {
+	t.Parallel()
+	hash := func(token string) string {
+		sum := sha256.Sum256([]byte(token))
+		return hex.EncodeToString(sum[:])
+	}
+	ts := newTestServer(t, nil, &auth.Config{
+		Tokens: []auth.TokenConfig{
+			{Client: "ci", SHA256: hash("ci-token")},
+			{Client: "portal", SHA256: hash("portal-token")},
+		},
+	}, nil)
+
+	for _, token := range []string{"", "wrong-token"} {
+		resp := doRequest(t, http.MethodPost, ts.URL+"/jobs", token, `{"repo": "github.com/ossf/scorecard"}`)
+		if resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("POST /jobs with token %q status = %d, want %d", token, resp.StatusCode, http.StatusUnauthorized)
+		}
+		if resp.Header.Get("WWW-Authenticate") == "" {
+			t.Errorf("POST /jobs with token %q has no WWW-Authenticate header", token)
+		}
+	}
+	if resp := doRequest(t, http.MethodGet, ts.URL+"/health", "", ""); resp.StatusCode != http.StatusOK {
+		t.Errorf("GET /health status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	job := submitJob(t, ts, "ci-token")
+	if job.Owner != "token:ci" {
+		t.Errorf("Owner = %q, want %q", job.Owner, "token:ci")
+	}
+	waitForJob(t, ts, "ci-token", job.ID, jobs.StatusSucceeded)
+	// The jobs of other clients aren't found.
+	resp := doRequest(t, http.MethodGet, ts.URL+"/jobs/"+job.ID, "portal-token", "")
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("GET job of another client status = %d, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+}
+
+func TestServeCache(t *testing.T) {
+	t.Parallel()
+	ts := newTestServer(t, resultcache.NewMemory(10, time.Hour), &auth.Config{}, nil)
+
+	url := ts.URL + "/?repo=github.com/ossf/scorecard&checks=Binary-Artifacts"
+	for _, want := range []string{"miss", "hit"} {
+		resp := doRequest(t, http.MethodGet, url, "", "")
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("GET / status = %d", resp.StatusCode)
+		}
+		if got := resp.Header.Get("X-Scorecard-Cache"); got != want {
+			t.Errorf("X-Scorecard-Cache = %q, want %q", got, want)
+		}
+	}
+	resp := doRequest(t, http.MethodGet, url+"&refresh=true", "", "")
+	if got := resp.Header.Get("X-Scorecard-Cache"); got != "miss" {
+		t.Errorf("X-Scorecard-Cache with refresh = %q, want %q", got, "miss")
+	}
+}
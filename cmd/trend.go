@@ -0,0 +1,141 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	gogit "github.com/go-git/go-git/v5"
+
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/git"
+	"github.com/ossf/scorecard/v5/cmd/internal/trend"
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	"github.com/ossf/scorecard/v5/options"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
+)
+
+// trendCmd analyzes the commits selected by --since-ref and --until-ref, or
+// by --tags, in a clone of the repository, and writes the time series of
+// their scores. Only the checks which can run on files are run.
+func trendCmd(
+	ctx context.Context,
+	o *options.Options,
+	uri string,
+	opts []scorecard.Option,
+	checkDocs docs.Doc,
+) error {
+	repo, client, gitRepo, err := cloneRepo(o, uri)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	var commits []trend.Commit
+	if o.SinceRef != "" {
+		until := o.UntilRef
+		if until == "" {
+			until = "HEAD"
+		}
+		commits, err = trend.Range(gitRepo, o.SinceRef, until)
+	} else {
+		commits, err = trend.Tags(gitRepo, o.Tags)
+	}
+	if err != nil {
+		return fmt.Errorf("selecting commits: %w", err)
+	}
+	if sampled := trend.Sample(commits, o.MaxCommits); len(sampled) < len(commits) {
+		fmt.Fprintf(os.Stderr, "Sampling %d of %d commits, see --%s\n",
+			len(sampled), len(commits), options.FlagMaxCommits)
+		commits = sampled
+	}
+
+	opts = append(opts[:len(opts):len(opts)], scorecard.WithRepoClient(trend.CheckoutClient{Client: client}))
+	series := &trend.Series{Repo: repo.URI()}
+	var sawRuntimeErr bool
+	for i, c := range commits {
+		fmt.Fprintf(os.Stderr, "Analyzing commit %d/%d %s %s\n", i+1, len(commits), c.SHA, c.Tag)
+		result, err := scanRepo(ctx, repo, o, append(opts, scorecard.WithCommitSHA(c.SHA)))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Skipping %s: %v\n", c.SHA, err)
+		}
+		if err == nil {
+			for _, r := range result.Checks {
+				if r.Error != nil {
+					sawRuntimeErr = true
+					fmt.Fprintf(os.Stderr, "Check %s failed for %s: %v\n", r.Name, c.SHA, r.Error)
+				}
+			}
+		}
+		if err := series.Add(c, result, err, checkDocs); err != nil {
+			return err //nolint:wrapcheck // Add names the commit.
+		}
+	}
+
+	output := os.Stdout
+	if o.ResultsFile != "" {
+		output, err = os.Create(o.ResultsFile)
+		if err != nil {
+			return fmt.Errorf("unable to create output file: %w", err)
+		}
+		fmt.Fprintln(os.Stderr, "Writing results to", o.ResultsFile)
+		defer output.Close()
+	}
+	if o.Format == options.FormatJSON {
+		err = series.WriteJSON(output)
+	} else {
+		err = series.WriteTable(output)
+	}
+	if err != nil {
+		return fmt.Errorf("writing trend: %w", err)
+	}
+
+	if sawRuntimeErr {
+		return errChecksFailed
+	}
+	return nil
+}
+
+// cloneRepo clones the repository of uri, or copies it if it is the --local
+// directory, so that its commits can be checked out and analyzed in turn. The
+// returned client must be closed to remove the clone.
+func cloneRepo(o *options.Options, uri string) (clients.Repo, *git.Client, *gogit.Repository, error) {
+	repo, err := repoFromURI(o, uri)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	depth := o.CommitDepth
+	if depth <= 0 {
+		depth = 30 // default
+	}
+	// Clone progress goes to stderr since the trend may be written to stdout.
+	client := &git.Client{Progress: os.Stderr}
+	if err := client.InitRepo(repo, clients.HeadSHA, depth); err != nil {
+		return nil, nil, nil, fmt.Errorf("cloning %s: %w", uri, err)
+	}
+	path, err := client.LocalPath()
+	if err != nil {
+		client.Close()
+		return nil, nil, nil, fmt.Errorf("locating clone: %w", err)
+	}
+	gitRepo, err := gogit.PlainOpen(path)
+	if err != nil {
+		client.Close()
+		return nil, nil, nil, fmt.Errorf("opening clone: %w", err)
+	}
+	return repo, client, gitRepo, nil
+}
@@ -33,6 +33,7 @@ const (
 	PinnedDependencies   CheckName = "Pinned-Dependencies"
 	SAST                 CheckName = "SAST"
 	SBOM                 CheckName = "SBOM"
+	SecretScanning       CheckName = "Secret-Scanning"
 	SecurityPolicy       CheckName = "Security-Policy"
 	SignedReleases       CheckName = "Signed-Releases"
 	TokenPermissions     CheckName = "Token-Permissions"
@@ -56,6 +57,7 @@ var AllValidChecks []string = []string{
 	PinnedDependencies,
 	SAST,
 	SBOM,
+	SecretScanning,
 	SecurityPolicy,
 	SignedReleases,
 	TokenPermissions,
@@ -29,6 +29,24 @@ const (
 	FlagRepos = "repos"
 	FlagOrg   = "org"
 
+	// FlagOrgArchived is the flag name for whether archived repositories of --org are scanned.
+	FlagOrgArchived = "org-archived"
+
+	// FlagOrgForks is the flag name for whether forks of --org are scanned.
+	FlagOrgForks = "org-forks"
+
+	// FlagOrgVisibility is the flag name for the visibilities of the repositories of --org to scan.
+	FlagOrgVisibility = "org-visibility"
+
+	// FlagOrgTopics is the flag name for the topics of the repositories of --org to scan.
+	FlagOrgTopics = "org-topics"
+
+	// FlagOrgActiveSince is the flag name for the date of the last activity of the repositories of --org to scan.
+	FlagOrgActiveSince = "org-active-since"
+
+	// FlagOrgFilter is the flag name for the expression selecting the repositories of --org to scan.
+	FlagOrgFilter = "org-filter"
+
 	// FlagLocal is the flag name for specifying a local run.
 	FlagLocal = "local"
 
@@ -80,6 +98,54 @@ const (
 	FlagCommitDepth = "commit-depth"
 
 	FlagProbes = "probes"
+
+	// FlagSASTSARIF is the flag name for specifying a SARIF file with SAST results.
+	FlagSASTSARIF = "sast-sarif"
+
+	// FlagSASTAlertAge is the flag name for specifying how long SAST alerts may stay open.
+	FlagSASTAlertAge = "sast-alert-age"
+
+	// FlagRemoteWorkflows is the flag name for following workflows and actions from other repositories.
+	FlagRemoteWorkflows = "remote-workflows"
+
+	// FlagFix is the flag name for applying remediations to a local repository.
+	FlagFix = "fix"
+
+	// FlagDryRun is the flag name for printing the changes of --fix as a diff instead of applying them.
+	FlagDryRun = "dry-run"
+
+	// FlagParallelism is the flag name for the number of repositories scanned concurrently.
+	FlagParallelism = "parallelism"
+
+	// FlagRequestsPerHour is the flag name for the GitHub API requests budget shared by concurrent scans.
+	FlagRequestsPerHour = "requests-per-hour"
+
+	// FlagCheckpoint is the flag name for the file recording the repositories already scanned.
+	FlagCheckpoint = "checkpoint"
+
+	// FlagSinceRef is the flag name for the first commit of the range of commits to analyze.
+	FlagSinceRef = "since-ref"
+
+	// FlagUntilRef is the flag name for the last commit of the range of commits to analyze.
+	FlagUntilRef = "until-ref"
+
+	// FlagTags is the flag name for the tags of the commits to analyze.
+	FlagTags = "tags"
+
+	// FlagMaxCommits is the flag name for the maximum number of commits to analyze.
+	FlagMaxCommits = "max-commits"
+
+	// FlagPR is the flag name for the pull request whose changed files are analyzed.
+	FlagPR = "pr"
+
+	// FlagBaseRef is the flag name for the commit compared against by the diff command.
+	FlagBaseRef = "base"
+
+	// FlagHeadRef is the flag name for the commit compared by the diff command.
+	FlagHeadRef = "head"
+
+	// FlagReport is the flag name for the consolidated report of all the scanned repositories.
+	FlagReport = "report"
 )
 
 // Command is an interface for handling options for command-line utilities.
@@ -109,8 +175,55 @@ func (o *Options) AddFlags(cmd *cobra.Command) {
 		&o.Org,
 		FlagOrg,
 		o.Org,
-		"scans all non-archived repositories in an organization. "+
-			"Currently only supports GitHub, e.g., 'github.com/ossf' or 'ossf'",
+		"scans all non-archived repositories in a GitHub organization, e.g., 'github.com/ossf' or 'ossf', "+
+			"a GitLab group and its subgroups, e.g., 'gitlab.com/group/subgroup', "+
+			"or an Azure DevOps organization or project, e.g., 'dev.azure.com/org/project'",
+	)
+
+	orgRepos := []string{OrgReposInclude, OrgReposExclude, OrgReposOnly}
+	cmd.Flags().StringVar(
+		&o.OrgArchived,
+		FlagOrgArchived,
+		o.OrgArchived,
+		fmt.Sprintf("whether archived repositories of --org are scanned, %s. Default: exclude, include with --org-filter",
+			strings.Join(orgRepos, ", ")),
+	)
+
+	cmd.Flags().StringVar(
+		&o.OrgForks,
+		FlagOrgForks,
+		o.OrgForks,
+		fmt.Sprintf("whether forks of --org are scanned, %s. Default: include",
+			strings.Join(orgRepos, ", ")),
+	)
+
+	cmd.Flags().StringSliceVar(
+		&o.OrgVisibility,
+		FlagOrgVisibility,
+		o.OrgVisibility,
+		"only scan the repositories of --org with one of these visibilities: public, private, internal",
+	)
+
+	cmd.Flags().StringSliceVar(
+		&o.OrgTopics,
+		FlagOrgTopics,
+		o.OrgTopics,
+		"only scan the repositories of --org with one of these topics",
+	)
+
+	cmd.Flags().StringVar(
+		&o.OrgActiveSince,
+		FlagOrgActiveSince,
+		o.OrgActiveSince,
+		"only scan the repositories of --org active since a date (2006-01-02) or for a duration (90d, 720h)",
+	)
+
+	cmd.Flags().StringVar(
+		&o.OrgFilter,
+		FlagOrgFilter,
+		o.OrgFilter,
+		"only scan the repositories of --org matching an expression of their properties, "+
+			"e.g. 'not fork and language in (Go, Python) and pushed > 90d'",
 	)
 
 	cmd.Flags().StringVar(
@@ -252,4 +365,123 @@ func (o *Options) AddFlags(cmd *cobra.Command) {
 		o.FileMode,
 		fmt.Sprintf("mode to fetch repository files: %s", strings.Join(allowedModes, ", ")),
 	)
+
+	cmd.Flags().StringVar(
+		&o.SASTSARIF,
+		FlagSASTSARIF,
+		o.SASTSARIF,
+		"SARIF file with SAST results to evaluate instead of the forge's code scanning alerts",
+	)
+
+	cmd.Flags().DurationVar(
+		&o.SASTAlertAge,
+		FlagSASTAlertAge,
+		o.SASTAlertAge,
+		"how long critical and high severity SAST alerts may stay open before they're reported (default 720h)",
+	)
+
+	cmd.Flags().BoolVar(
+		&o.RemoteWorkflows,
+		FlagRemoteWorkflows,
+		o.RemoteWorkflows,
+		"follow reusable workflows and composite actions hosted in other GitHub repositories",
+	)
+
+	cmd.Flags().BoolVar(
+		&o.Fix,
+		FlagFix,
+		o.Fix,
+		"apply supported remediations to the files of the --local repository",
+	)
+
+	cmd.Flags().BoolVar(
+		&o.DryRun,
+		FlagDryRun,
+		o.DryRun,
+		"with --fix, print the changes as a unified diff instead of applying them",
+	)
+
+	cmd.Flags().IntVar(
+		&o.Parallelism,
+		FlagParallelism,
+		o.Parallelism,
+		"number of repositories scanned concurrently, with --report",
+	)
+
+	cmd.Flags().IntVar(
+		&o.RequestsPerHour,
+		FlagRequestsPerHour,
+		o.RequestsPerHour,
+		"GitHub API requests per hour of each token, shared by the concurrent scans, 0 for no limit besides GitHub's",
+	)
+
+	cmd.Flags().StringVar(
+		&o.Checkpoint,
+		FlagCheckpoint,
+		o.Checkpoint,
+		"file recording the scanned repositories, which are skipped when scanning again, with --report",
+	)
+
+	cmd.Flags().IntVar(
+		&o.PR,
+		FlagPR,
+		o.PR,
+		"analyze the files changed by this pull request or merge request at its head, "+
+			"and print SARIF annotations of its changed lines",
+	)
+
+	cmd.Flags().StringVar(
+		&o.SinceRef,
+		FlagSinceRef,
+		o.SinceRef,
+		"analyze each commit from this ref to --until-ref in a local clone, and print the score trend",
+	)
+
+	cmd.Flags().StringVar(
+		&o.UntilRef,
+		FlagUntilRef,
+		o.UntilRef,
+		"last commit analyzed with --since-ref. Default: HEAD",
+	)
+
+	cmd.Flags().StringSliceVar(
+		&o.Tags,
+		FlagTags,
+		o.Tags,
+		"analyze the commits of these tags, or tag patterns, e.g. 'v*', in a local clone, and print the score trend",
+	)
+
+	cmd.Flags().IntVar(
+		&o.MaxCommits,
+		FlagMaxCommits,
+		o.MaxCommits,
+		"analyze at most this many commits with --since-ref or --tags, evenly sampled "+
+			"including the first and last, 0 for all",
+	)
+
+	allowedReports := []string{ReportJSONL, ReportCSV, ReportSarif}
+	cmd.Flags().StringVar(
+		&o.Report,
+		FlagReport,
+		o.Report,
+		fmt.Sprintf("consolidated report of all the repositories, instead of --format: %s",
+			strings.Join(allowedReports, ", ")),
+	)
+}
+
+// AddDiffFlags adds the flags of the commits compared by the diff command.
+func (o *Options) AddDiffFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&o.BaseRef,
+		FlagBaseRef,
+		o.BaseRef,
+		"ref of the commit compared against, e.g. the target branch of a pull request",
+	)
+
+	cmd.Flags().StringVar(
+		&o.HeadRef,
+		FlagHeadRef,
+		o.HeadRef,
+		"ref of the commit compared, e.g. the head of a pull request",
+	)
 }
@@ -21,6 +21,7 @@ import (
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/caarlos0/env/v6"
 
@@ -33,6 +34,17 @@ type Options struct {
 	Repo            string
 	Repos           []string
 	Org             string
+	OrgArchived     string
+	OrgForks        string
+	OrgActiveSince  string
+	OrgFilter       string
+	SinceRef        string
+	UntilRef        string
+	BaseRef         string
+	HeadRef         string
+	OrgVisibility   []string
+	OrgTopics       []string
+	Tags            []string
 	Local           string
 	Commit          string
 	LogLevel        string
@@ -44,12 +56,23 @@ type Options struct {
 	PolicyFile      string
 	ResultsFile     string
 	FileMode        string
+	SASTSARIF       string
+	Report          string
+	Checkpoint      string
 	ChecksToRun     []string
 	ProbesToRun     []string
 	Metadata        []string
 	CommitDepth     int
+	MaxCommits      int
+	Parallelism     int
+	RequestsPerHour int
+	SASTAlertAge    time.Duration
+	PR              int
 	ShowDetails     bool
 	ShowAnnotations bool
+	RemoteWorkflows bool
+	Fix             bool
+	DryRun          bool
 	// Feature flags.
 	EnableSarif                 bool `env:"ENABLE_SARIF"`
 	EnableScorecardV6           bool `env:"SCORECARD_V6"`
@@ -59,10 +82,12 @@ type Options struct {
 // New creates a new instance of `Options`.
 func New() *Options {
 	opts := &Options{
-		Commit:   DefaultCommit,
-		Format:   FormatDefault,
-		LogLevel: DefaultLogLevel,
-		FileMode: FileModeArchive,
+		Commit:      DefaultCommit,
+		Format:      FormatDefault,
+		LogLevel:    DefaultLogLevel,
+		FileMode:    FileModeArchive,
+		Parallelism: 1,
+		MaxCommits:  100,
 	}
 	if err := env.Parse(opts); err != nil {
 		log.Printf("could not parse env vars, using default options: %v", err)
@@ -88,6 +113,22 @@ const (
 	// FormatInToto specifies that results should be output in an in-toto statement.
 	FormatInToto = "intoto"
 
+	// Reports.
+	// ReportJSONL specifies that results should be reported as JSON lines.
+	ReportJSONL = "jsonl"
+	// ReportCSV specifies that results should be reported as a CSV table of scores.
+	ReportCSV = "csv"
+	// ReportSarif specifies that results should be reported as a single SARIF log.
+	ReportSarif = "sarif"
+
+	// Organization repositories.
+	// OrgReposInclude specifies that repositories with a property are scanned.
+	OrgReposInclude = "include"
+	// OrgReposExclude specifies that repositories with a property are skipped.
+	OrgReposExclude = "exclude"
+	// OrgReposOnly specifies that only repositories with a property are scanned.
+	OrgReposOnly = "only"
+
 	// File Modes
 	// FileModeGit specifies that files should be fetched using git.
 	FileModeGit = "git"
@@ -113,13 +154,35 @@ var (
 	errCommitIsEmpty          = errors.New("commit should be non-empty")
 	errFormatNotSupported     = errors.New("unsupported format")
 	errFileModeNotSupported   = errors.New("unsupported file mode")
+	errFixRequiresLocal       = errors.New("`fix` requires `local`")
+	errDryRunRequiresFix      = errors.New("`dry-run` requires `fix`")
 	errPolicyFileNotSupported = errors.New("policy file is not supported yet")
 	errRawOptionNotSupported  = errors.New("raw option is not supported yet")
 	errRepoOptionMustBeSet    = errors.New(
 		"exactly one of `repo`, `repos`, `org`, `npm`, `pypi`, `rubygems`, `nuget` or `local` must be set",
 	)
-	errSARIFNotSupported = errors.New("SARIF format is not supported yet")
-	errValidate          = errors.New("some options could not be validated")
+	errSARIFNotSupported      = errors.New("SARIF format is not supported yet")
+	errReportNotSupported     = errors.New("unsupported report")
+	errReportRequired         = errors.New("`parallelism` and `checkpoint` require `report`")
+	errCheckpointSarif        = errors.New("`checkpoint` can't resume a `sarif` report")
+	errNegativeParallelism    = errors.New("`parallelism` should not be negative")
+	errOrgFilterRequiresOrg   = errors.New("`org-*` filters require `org`")
+	errOrgReposNotSupported   = errors.New("unsupported value for `org-archived` or `org-forks`")
+	errVisibilityNotSupported = errors.New("unsupported `org-visibility`")
+	errUntilRequiresSince     = errors.New("`until-ref` requires `since-ref`")
+	errTrendRange             = errors.New("only one of `since-ref` and `tags` can be set")
+	errTrendRequiresRepo      = errors.New("`since-ref` and `tags` require `repo`, `local` or a package")
+	errTrendFormatUnsupported = errors.New("`since-ref` and `tags` only support the default and json formats")
+	errNegativeMaxCommits     = errors.New("`max-commits` should not be negative")
+	errDiffRequiresRefs       = errors.New("`base` and `head` must both be set")
+	errDiffRequiresRepo       = errors.New("`base` and `head` require `repo`, `local` or a package")
+	errDiffTrend              = errors.New("`base` and `head` cannot be set with `since-ref` or `tags`")
+	errDiffFormatUnsupported  = errors.New("`base` and `head` only support the default and json formats")
+	errNegativeSASTAlertAge   = errors.New("`sast-alert-age` should not be negative")
+	errNegativePR             = errors.New("`pr` should not be negative")
+	errPRRequiresRepo         = errors.New("`pr` requires `repo`")
+	errPRFormatUnsupported    = errors.New("`pr` only supports the default and sarif formats")
+	errValidate               = errors.New("some options could not be validated")
 )
 
 // Validate validates scorecard configuration options.
@@ -145,7 +208,7 @@ func (o *Options) Validate() error {
 
 	// Validate SARIF features are flag-guarded.
 	if !o.isSarifEnabled() {
-		if o.Format == FormatSarif {
+		if o.Format == FormatSarif || o.Report == ReportSarif {
 			errs = append(
 				errs,
 				errSARIFNotSupported,
@@ -192,6 +255,168 @@ func (o *Options) Validate() error {
 		)
 	}
 
+	if o.Fix && o.Local == "" {
+		errs = append(
+			errs,
+			errFixRequiresLocal,
+		)
+	}
+
+	if o.DryRun && !o.Fix {
+		errs = append(
+			errs,
+			errDryRunRequiresFix,
+		)
+	}
+
+	if o.Org == "" && (o.OrgArchived != "" || o.OrgForks != "" || o.OrgActiveSince != "" ||
+		o.OrgFilter != "" || len(o.OrgVisibility) > 0 || len(o.OrgTopics) > 0) {
+		errs = append(
+			errs,
+			errOrgFilterRequiresOrg,
+		)
+	}
+
+	if !validateOrgRepos(o.OrgArchived) || !validateOrgRepos(o.OrgForks) {
+		errs = append(
+			errs,
+			errOrgReposNotSupported,
+		)
+	}
+
+	for _, v := range o.OrgVisibility {
+		if !validateVisibility(v) {
+			errs = append(
+				errs,
+				errVisibilityNotSupported,
+			)
+			break
+		}
+	}
+
+	if o.UntilRef != "" && o.SinceRef == "" {
+		errs = append(
+			errs,
+			errUntilRequiresSince,
+		)
+	}
+
+	if o.SinceRef != "" && len(o.Tags) > 0 {
+		errs = append(
+			errs,
+			errTrendRange,
+		)
+	}
+
+	if o.MaxCommits < 0 {
+		errs = append(
+			errs,
+			errNegativeMaxCommits,
+		)
+	}
+
+	if o.IsTrend() {
+		if len(o.Repos) > 0 || o.Org != "" || o.Report != "" || o.Fix {
+			errs = append(
+				errs,
+				errTrendRequiresRepo,
+			)
+		}
+		if o.Format != FormatDefault && o.Format != FormatJSON {
+			errs = append(
+				errs,
+				errTrendFormatUnsupported,
+			)
+		}
+	}
+
+	if o.IsDiff() {
+		if o.BaseRef == "" || o.HeadRef == "" {
+			errs = append(
+				errs,
+				errDiffRequiresRefs,
+			)
+		}
+		if o.IsTrend() {
+			errs = append(
+				errs,
+				errDiffTrend,
+			)
+		}
+		if len(o.Repos) > 0 || o.Org != "" || o.Report != "" || o.Fix {
+			errs = append(
+				errs,
+				errDiffRequiresRepo,
+			)
+		}
+		if o.Format != FormatDefault && o.Format != FormatJSON {
+			errs = append(
+				errs,
+				errDiffFormatUnsupported,
+			)
+		}
+	}
+
+	if o.SASTAlertAge < 0 {
+		errs = append(
+			errs,
+			errNegativeSASTAlertAge,
+		)
+	}
+
+	if o.PR < 0 {
+		errs = append(
+			errs,
+			errNegativePR,
+		)
+	}
+
+	if o.IsPR() {
+		if o.Repo == "" || o.Report != "" || o.Fix || o.IsTrend() || o.IsDiff() {
+			errs = append(
+				errs,
+				errPRRequiresRepo,
+			)
+		}
+		if o.Format != FormatDefault && o.Format != FormatSarif {
+			errs = append(
+				errs,
+				errPRFormatUnsupported,
+			)
+		}
+	}
+
+	if o.Report != "" && !validateReport(o.Report) {
+		errs = append(
+			errs,
+			errReportNotSupported,
+		)
+	}
+
+	if o.Parallelism < 0 {
+		errs = append(
+			errs,
+			errNegativeParallelism,
+		)
+	}
+
+	// Concurrent and resumed scans can't print each result as it comes.
+	if (o.Parallelism > 1 || o.Checkpoint != "") && o.Report == "" {
+		errs = append(
+			errs,
+			errReportRequired,
+		)
+	}
+
+	// SARIF reports are written once all the scans are done, so the scans
+	// skipped when resuming would be missing from them.
+	if o.Checkpoint != "" && o.Report == ReportSarif {
+		errs = append(
+			errs,
+			errCheckpointSarif,
+		)
+	}
+
 	if len(errs) != 0 {
 		return fmt.Errorf(
 			"%w: %+v",
@@ -269,6 +494,54 @@ func validateFormat(format string) bool {
 	}
 }
 
+func validateOrgRepos(value string) bool {
+	switch value {
+	case "", OrgReposInclude, OrgReposExclude, OrgReposOnly:
+		return true
+	default:
+		return false
+	}
+}
+
+func validateVisibility(visibility string) bool {
+	switch visibility {
+	case "public", "private", "internal":
+		return true
+	default:
+		return false
+	}
+}
+
+func validateReport(report string) bool {
+	switch report {
+	case ReportJSONL, ReportCSV, ReportSarif:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsTrend returns true if a series of commits is analyzed, rather than one.
+func (o *Options) IsTrend() bool {
+	return o.SinceRef != "" || len(o.Tags) > 0
+}
+
+// IsDiff returns true if the results of two commits are compared.
+func (o *Options) IsDiff() bool {
+	return o.BaseRef != "" || o.HeadRef != ""
+}
+
+// IsPR returns true if only the files changed by a pull request are analyzed.
+func (o *Options) IsPR() bool {
+	return o.PR > 0
+}
+
+// IsBatch returns true if repositories are scanned as a batch with a
+// consolidated report, rather than one at a time.
+func (o *Options) IsBatch() bool {
+	return o.Report != ""
+}
+
 func validateFileMode(mode string) bool {
 	switch strings.ToLower(mode) {
 	case FileModeGit, FileModeArchive:
@@ -17,6 +17,7 @@ package options
 
 import (
 	"testing"
+	"time"
 )
 
 func TestOptions_Validate(t *testing.T) {
@@ -36,8 +37,25 @@ func TestOptions_Validate(t *testing.T) {
 		ChecksToRun       []string
 		Metadata          []string
 		ShowDetails       bool
+		Fix               bool
+		DryRun            bool
 		EnableSarif       bool
 		EnableScorecardV6 bool
+		Report            string
+		Checkpoint        string
+		Parallelism       int
+		Org               string
+		OrgArchived       string
+		OrgForks          string
+		OrgVisibility     []string
+		SinceRef          string
+		UntilRef          string
+		Tags              []string
+		MaxCommits        int
+		BaseRef           string
+		HeadRef           string
+		PR                int
+		SASTAlertAge      time.Duration
 	}
 	tests := []struct {
 		name    string
@@ -105,6 +123,331 @@ func TestOptions_Validate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "fix requires local",
+			fields: fields{
+				Repo:   "github.com/ossf/scorecard",
+				Commit: "HEAD",
+				Format: "default",
+				Fix:    true,
+			},
+			wantErr: true,
+		},
+		{
+			name: "dry-run requires fix",
+			fields: fields{
+				Local:  ".",
+				Commit: "HEAD",
+				Format: "default",
+				DryRun: true,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fix dry-run on a local repository is valid",
+			fields: fields{
+				Local:  ".",
+				Commit: "HEAD",
+				Format: "default",
+				Fix:    true,
+				DryRun: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "parallel scans with a report are valid",
+			fields: fields{
+				Repo:        "github.com/ossf/scorecard",
+				Commit:      "HEAD",
+				Format:      "default",
+				Report:      ReportCSV,
+				Checkpoint:  "scanned.jsonl",
+				Parallelism: 8,
+			},
+			wantErr: false,
+		},
+		{
+			name: "parallel scans require a report",
+			fields: fields{
+				Repo:        "github.com/ossf/scorecard",
+				Commit:      "HEAD",
+				Format:      "default",
+				Parallelism: 8,
+			},
+			wantErr: true,
+		},
+		{
+			name: "checkpoint requires a report",
+			fields: fields{
+				Repo:       "github.com/ossf/scorecard",
+				Commit:     "HEAD",
+				Format:     "default",
+				Checkpoint: "scanned.jsonl",
+			},
+			wantErr: true,
+		},
+		{
+			name: "checkpoint can't resume a sarif report",
+			fields: fields{
+				Repo:       "github.com/ossf/scorecard",
+				Commit:     "HEAD",
+				Format:     "default",
+				Report:     ReportSarif,
+				Checkpoint: "scanned.jsonl",
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative parallelism",
+			fields: fields{
+				Repo:        "github.com/ossf/scorecard",
+				Commit:      "HEAD",
+				Format:      "default",
+				Report:      ReportJSONL,
+				Parallelism: -1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative max commits",
+			fields: fields{
+				Repo:       "github.com/ossf/scorecard",
+				Commit:     "HEAD",
+				Format:     "default",
+				Tags:       []string{"v*"},
+				MaxCommits: -1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "unsupported report",
+			fields: fields{
+				Repo:   "github.com/ossf/scorecard",
+				Commit: "HEAD",
+				Format: "default",
+				Report: "xml",
+			},
+			wantErr: true,
+		},
+		{
+			name: "org filters are valid",
+			fields: fields{
+				Org:           "gitlab.com/ossf/tests",
+				Commit:        "HEAD",
+				Format:        "default",
+				OrgArchived:   OrgReposOnly,
+				OrgForks:      OrgReposExclude,
+				OrgVisibility: []string{"public", "internal"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "org filters require org",
+			fields: fields{
+				Repo:        "github.com/ossf/scorecard",
+				Commit:      "HEAD",
+				Format:      "default",
+				OrgArchived: OrgReposInclude,
+			},
+			wantErr: true,
+		},
+		{
+			name: "unsupported org-forks",
+			fields: fields{
+				Org:      "ossf",
+				Commit:   "HEAD",
+				Format:   "default",
+				OrgForks: "maybe",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unsupported org-visibility",
+			fields: fields{
+				Org:           "ossf",
+				Commit:        "HEAD",
+				Format:        "default",
+				OrgVisibility: []string{"secret"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ref range is valid",
+			fields: fields{
+				Repo:     "github.com/ossf/scorecard",
+				Commit:   "HEAD",
+				Format:   "json",
+				SinceRef: "v4.0.0",
+				UntilRef: "v5.0.0",
+			},
+			wantErr: false,
+		},
+		{
+			name: "tags are valid",
+			fields: fields{
+				Local:  ".",
+				Commit: "HEAD",
+				Format: "default",
+				Tags:   []string{"v*"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "until-ref requires since-ref",
+			fields: fields{
+				Repo:     "github.com/ossf/scorecard",
+				Commit:   "HEAD",
+				Format:   "default",
+				UntilRef: "v5.0.0",
+			},
+			wantErr: true,
+		},
+		{
+			name: "since-ref and tags are exclusive",
+			fields: fields{
+				Repo:     "github.com/ossf/scorecard",
+				Commit:   "HEAD",
+				Format:   "default",
+				SinceRef: "v4.0.0",
+				Tags:     []string{"v*"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "tags require a single repo",
+			fields: fields{
+				Org:    "ossf",
+				Commit: "HEAD",
+				Format: "default",
+				Tags:   []string{"v*"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "tags don't support probe format",
+			fields: fields{
+				Repo:   "github.com/ossf/scorecard",
+				Commit: "HEAD",
+				Format: "probe",
+				Tags:   []string{"v*"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "diff is valid",
+			fields: fields{
+				Local:   ".",
+				Commit:  "HEAD",
+				Format:  "default",
+				BaseRef: "main",
+				HeadRef: "feature",
+			},
+			wantErr: false,
+		},
+		{
+			name: "diff requires base and head",
+			fields: fields{
+				Repo:    "github.com/ossf/scorecard",
+				Commit:  "HEAD",
+				Format:  "default",
+				HeadRef: "feature",
+			},
+			wantErr: true,
+		},
+		{
+			name: "diff requires a single repo",
+			fields: fields{
+				Org:     "ossf",
+				Commit:  "HEAD",
+				Format:  "default",
+				BaseRef: "main",
+				HeadRef: "feature",
+			},
+			wantErr: true,
+		},
+		{
+			name: "diff cannot be combined with tags",
+			fields: fields{
+				Repo:    "github.com/ossf/scorecard",
+				Commit:  "HEAD",
+				Format:  "json",
+				BaseRef: "main",
+				HeadRef: "feature",
+				Tags:    []string{"v*"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "diff doesn't support sarif format",
+			fields: fields{
+				Repo:    "github.com/ossf/scorecard",
+				Commit:  "HEAD",
+				Format:  "sarif",
+				BaseRef: "main",
+				HeadRef: "feature",
+			},
+			wantErr: true,
+		},
+		{
+			name: "pr is valid",
+			fields: fields{
+				Repo:   "github.com/ossf/scorecard",
+				Commit: "HEAD",
+				Format: "default",
+				PR:     42,
+			},
+			wantErr: false,
+		},
+		{
+			name: "pr requires repo",
+			fields: fields{
+				Local:  ".",
+				Commit: "HEAD",
+				Format: "default",
+				PR:     42,
+			},
+			wantErr: true,
+		},
+		{
+			name: "pr doesn't support json format",
+			fields: fields{
+				Repo:   "github.com/ossf/scorecard",
+				Commit: "HEAD",
+				Format: "json",
+				PR:     42,
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative pr",
+			fields: fields{
+				Repo:   "github.com/ossf/scorecard",
+				Commit: "HEAD",
+				Format: "default",
+				PR:     -1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative sast alert age",
+			fields: fields{
+				Repo:         "github.com/ossf/scorecard",
+				Commit:       "HEAD",
+				Format:       "default",
+				SASTAlertAge: -time.Hour,
+			},
+			wantErr: true,
+		},
+		{
+			name: "sarif report requires sarif to be enabled",
+			fields: fields{
+				Repo:   "github.com/ossf/scorecard",
+				Commit: "HEAD",
+				Format: "default",
+				Report: ReportSarif,
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		if tt.fields.FileMode == "" {
@@ -127,8 +470,25 @@ func TestOptions_Validate(t *testing.T) {
 				ChecksToRun:       tt.fields.ChecksToRun,
 				Metadata:          tt.fields.Metadata,
 				ShowDetails:       tt.fields.ShowDetails,
+				Fix:               tt.fields.Fix,
+				DryRun:            tt.fields.DryRun,
 				EnableSarif:       tt.fields.EnableSarif,
 				EnableScorecardV6: tt.fields.EnableScorecardV6,
+				Report:            tt.fields.Report,
+				Checkpoint:        tt.fields.Checkpoint,
+				Parallelism:       tt.fields.Parallelism,
+				Org:               tt.fields.Org,
+				OrgArchived:       tt.fields.OrgArchived,
+				OrgForks:          tt.fields.OrgForks,
+				OrgVisibility:     tt.fields.OrgVisibility,
+				SinceRef:          tt.fields.SinceRef,
+				UntilRef:          tt.fields.UntilRef,
+				Tags:              tt.fields.Tags,
+				MaxCommits:        tt.fields.MaxCommits,
+				BaseRef:           tt.fields.BaseRef,
+				HeadRef:           tt.fields.HeadRef,
+				PR:                tt.fields.PR,
+				SASTAlertAge:      tt.fields.SASTAlertAge,
 			}
 			if o.EnableSarif {
 				t.Setenv(EnvVarEnableSarif, "1")
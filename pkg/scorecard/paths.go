@@ -0,0 +1,48 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// pathsClient only lists the files of the repository with the given paths,
+// so that file-based checks only analyze those.
+type pathsClient struct {
+	clients.RepoClient
+	paths map[string]bool
+}
+
+func newPathsClient(client clients.RepoClient, paths []string) *pathsClient {
+	c := &pathsClient{RepoClient: client, paths: make(map[string]bool, len(paths))}
+	for _, p := range paths {
+		c.paths[p] = true
+	}
+	return c
+}
+
+func (c *pathsClient) ListFiles(predicate func(string) (bool, error)) ([]string, error) {
+	return c.RepoClient.ListFiles(func(path string) (bool, error) {
+		if !c.paths[path] {
+			return false, nil
+		}
+		return predicate(path)
+	})
+}
+
+// Unwrap returns the client whose files are filtered.
+func (c *pathsClient) Unwrap() clients.RepoClient {
+	return c.RepoClient
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+)
+
+func Test_pathsClient_ListFiles(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	files := []string{".github/workflows/ci.yml", ".github/workflows/release.yml", "Dockerfile", "main.go"}
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).DoAndReturn(
+		func(predicate func(string) (bool, error)) ([]string, error) {
+			var matches []string
+			for _, f := range files {
+				ok, err := predicate(f)
+				if err != nil {
+					return nil, err
+				}
+				if ok {
+					matches = append(matches, f)
+				}
+			}
+			return matches, nil
+		})
+
+	c := newPathsClient(mockRepoClient, []string{".github/workflows/ci.yml", "main.go", "deleted.yml"})
+	got, err := c.ListFiles(func(path string) (bool, error) {
+		return strings.HasPrefix(path, ".github/workflows/"), nil
+	})
+	if err != nil {
+		t.Fatalf("ListFiles: %v", err)
+	}
+	if diff := cmp.Diff([]string{".github/workflows/ci.yml"}, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
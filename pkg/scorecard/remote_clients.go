@@ -0,0 +1,90 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/githubrepo"
+)
+
+// remoteRepoClients creates clients for other GitHub repositories referenced
+// by the analyzed one, e.g. for reusable workflows, and caches them for the
+// duration of a run. It is safe for concurrent use by checks.
+type remoteRepoClients struct {
+	ctx     context.Context
+	rt      http.RoundTripper
+	clients map[string]remoteRepoClient
+	mu      sync.Mutex
+}
+
+type remoteRepoClient struct {
+	client clients.RepoClient
+	err    error
+}
+
+func newRemoteRepoClients(ctx context.Context, rt http.RoundTripper) *remoteRepoClients {
+	return &remoteRepoClients{
+		ctx:     ctx,
+		rt:      rt,
+		clients: make(map[string]remoteRepoClient),
+	}
+}
+
+// get returns a client for repo, in the owner/name form, at ref.
+func (r *remoteRepoClients) get(repo, ref string) (clients.RepoClient, error) {
+	key := repo + "@" + ref
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if c, ok := r.clients[key]; ok {
+		return c.client, c.err
+	}
+	client, err := r.create(repo, ref)
+	r.clients[key] = remoteRepoClient{client: client, err: err}
+	return client, err
+}
+
+func (r *remoteRepoClients) create(repo, ref string) (clients.RepoClient, error) {
+	ghRepo, err := githubrepo.MakeGithubRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("parsing remote repo: %w", err)
+	}
+	var opts []githubrepo.Option
+	if r.rt != nil {
+		opts = append(opts, githubrepo.WithRoundTripper(r.rt))
+	}
+	client, err := githubrepo.NewRepoClient(r.ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("creating github client: %w", err)
+	}
+	if err := client.InitRepo(ghRepo, ref, 0); err != nil {
+		return nil, fmt.Errorf("initializing remote repo %s: %w", repo, err)
+	}
+	return client, nil
+}
+
+func (r *remoteRepoClients) close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, c := range r.clients {
+		if c.client != nil {
+			c.client.Close()
+		}
+	}
+}
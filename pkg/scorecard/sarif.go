@@ -121,6 +121,8 @@ type driver struct {
 
 type tool struct {
 	Driver driver `json:"driver"`
+	// Plugins of the tool, which may define rules of their own.
+	Extensions []driver `json:"extensions,omitempty"`
 }
 
 //nolint:govet
@@ -135,6 +137,9 @@ type result struct {
 	// https://docs.oasis-open.org/sarif/sarif/v2.1.0/cs01/sarif-v2.1.0-cs01.html#_Toc16012457.
 	// Not supported by GitHub, but possibly useful.
 	PartialFingerprints partialFingerprints `json:"partialFingerprints,omitempty"`
+	// Set by tools when a result was suppressed in the source, e.g. by a nolint comment.
+	// Only read when ingesting SARIF files.
+	Suppressions []json.RawMessage `json:"suppressions,omitempty"`
 }
 
 type automationDetails struct {
@@ -146,7 +151,7 @@ type run struct {
 	Tool              tool              `json:"tool"`
 	// For generated files during analysis. We leave this blank.
 	// See https://github.com/microsoft/sarif-tutorials/blob/main/docs/1-Introduction.md#simple-example.
-	Artifacts string `json:"artifacts,omitempty"`
+	Artifacts json.RawMessage `json:"artifacts,omitempty"`
 	// This MUST never be omitted or set as `nil`.
 	Results []result `json:"results"`
 }
@@ -0,0 +1,122 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// sarifAlertsClient serves code scanning alerts read from a SARIF file
+// instead of asking the forge for them.
+type sarifAlertsClient struct {
+	clients.RepoClient
+	alerts []clients.CodeScanningAlert
+}
+
+func (c *sarifAlertsClient) ListCodeScanningAlerts() ([]clients.CodeScanningAlert, error) {
+	return c.alerts, nil
+}
+
+// Unwrap returns the client whose alerts are replaced.
+func (c *sarifAlertsClient) Unwrap() clients.RepoClient {
+	return c.RepoClient
+}
+
+func readSARIFAlerts(path string) ([]clients.CodeScanningAlert, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening SARIF file: %w", err)
+	}
+	defer f.Close()
+	return parseSARIFAlerts(f)
+}
+
+// parseSARIFAlerts converts the results of a SARIF log into code scanning alerts.
+// Suppressed results are skipped, as the tool doesn't consider them open.
+func parseSARIFAlerts(r io.Reader) ([]clients.CodeScanningAlert, error) {
+	var doc sarif210
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("decoding SARIF: %w", err)
+	}
+	var alerts []clients.CodeScanningAlert
+	for i := range doc.Runs {
+		run := &doc.Runs[i]
+		rules := sarifRules(&run.Tool)
+		for j := range run.Results {
+			res := &run.Results[j]
+			if len(res.Suppressions) > 0 {
+				continue
+			}
+			alert := clients.CodeScanningAlert{
+				Tool:     run.Tool.Driver.Name,
+				RuleID:   res.RuleID,
+				Severity: sarifSeverity(res, rules[res.RuleID]),
+			}
+			if len(res.Locations) > 0 {
+				loc := &res.Locations[0].PhysicalLocation
+				alert.Path = loc.ArtifactLocation.URI
+				if loc.Region.StartLine != nil {
+					alert.Line = *loc.Region.StartLine
+				}
+			}
+			alerts = append(alerts, alert)
+		}
+	}
+	return alerts, nil
+}
+
+func sarifRules(t *tool) map[string]*rule {
+	rules := make(map[string]*rule)
+	for _, d := range append([]driver{t.Driver}, t.Extensions...) {
+		for i := range d.Rules {
+			rules[d.Rules[i].ID] = &d.Rules[i]
+		}
+	}
+	return rules
+}
+
+// sarifSeverity maps the security-severity score of a rule the same way
+// GitHub code scanning does, and falls back to the SARIF level otherwise:
+// https://docs.github.com/en/code-security/code-scanning/integrating-with-code-scanning/sarif-support-for-code-scanning#reportingdescriptor-object
+func sarifSeverity(res *result, r *rule) string {
+	if r != nil {
+		if score, err := strconv.ParseFloat(r.Properties.SeverityLevel, 64); err == nil {
+			switch {
+			case score >= 9.0:
+				return "critical"
+			case score >= 7.0:
+				return "high"
+			case score >= 4.0:
+				return "medium"
+			default:
+				return "low"
+			}
+		}
+	}
+	if res.Level != "" {
+		return res.Level
+	}
+	if r != nil && r.DefaultConfig.Level != "" {
+		return r.DefaultConfig.Level
+	}
+	// The default level defined by the SARIF spec.
+	return "warning"
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+func Test_readSARIFAlerts(t *testing.T) {
+	t.Parallel()
+	got, err := readSARIFAlerts("testdata/sast-results.sarif")
+	if err != nil {
+		t.Fatalf("readSARIFAlerts: %v", err)
+	}
+	want := []clients.CodeScanningAlert{
+		{
+			Tool:     "Semgrep OSS",
+			RuleID:   "go.lang.security.audit.sqli.string-formatted-query",
+			Severity: "high",
+			Path:     "db/query.go",
+			Line:     42,
+		},
+		{
+			Tool:     "Semgrep OSS",
+			RuleID:   "go.lang.correctness.useless-eqeq",
+			Severity: "note",
+			Path:     "main.go",
+			Line:     7,
+		},
+		{
+			Tool:     "Semgrep OSS",
+			RuleID:   "custom.hardcoded-key",
+			Severity: "critical",
+			Path:     "config/keys.go",
+			Line:     3,
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_readSARIFAlerts_invalid(t *testing.T) {
+	t.Parallel()
+	if _, err := readSARIFAlerts("testdata/does-not-exist.sarif"); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
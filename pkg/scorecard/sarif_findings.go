@@ -0,0 +1,136 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/ossf/scorecard/v5/checker"
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/finding"
+	proberegistration "github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/options"
+)
+
+// AsFindingsSARIF outputs the findings of the probes which call for a
+// remediation in SARIF 2.1.0 format, with a rule per probe and a result per
+// finding. Unlike AsSARIF, which reports the checks which violate a policy,
+// it suits annotating the lines of the findings, e.g. in a pull request.
+func (r *Result) AsFindingsSARIF(writer io.Writer, checkDocs docs.Doc, opts *options.Options) error {
+	sarif := createSARIFHeader()
+	run := createSARIFRun("https://github.com/ossf/scorecard", toolName(opts),
+		r.Scorecard.Version, r.Scorecard.CommitSHA, r.Date, "supply-chain", "findings")
+
+	ruleIndex := make(map[string]int)
+	for i := range r.Findings {
+		f := &r.Findings[i]
+		// Probes only set the remediation of the findings with a bad outcome.
+		if f.Remediation == nil || (f.Outcome != finding.OutcomeFalse && f.Outcome != finding.OutcomeTrue) {
+			continue
+		}
+		pos, ok := ruleIndex[f.Probe]
+		if !ok {
+			rule, err := createSARIFProbeRule(f, checkDocs, r.Scorecard.CommitSHA)
+			if err != nil {
+				return err
+			}
+			pos = len(run.Tool.Driver.Rules)
+			ruleIndex[f.Probe] = pos
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, rule)
+		}
+		loc := findingToLocation(f)
+		run.Results = append(run.Results, result{
+			RuleID:    f.Probe,
+			RuleIndex: pos,
+			Message:   text{Text: f.Message},
+			Locations: []location{loc},
+		})
+	}
+	sarif.Runs = append(sarif.Runs, run)
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "   ")
+	if err := encoder.Encode(sarif); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+	}
+	return nil
+}
+
+// createSARIFProbeRule creates the rule of the probe of f, with the risk and
+// tags of the check whose raw data the probe evaluates.
+func createSARIFProbeRule(f *finding.Finding, checkDocs docs.Doc, commit string) (rule, error) {
+	probe, err := proberegistration.Get(f.Probe)
+	if err != nil {
+		return rule{}, fmt.Errorf("getting probe: %w", err)
+	}
+	if len(probe.RequiredRawData) == 0 {
+		return rule{}, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("probe without a check: %s", f.Probe))
+	}
+	doc, err := checkDocs.GetCheck(string(probe.RequiredRawData[0]))
+	if err != nil {
+		return rule{}, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("GetCheck: %v: %s", err, f.Probe))
+	}
+	risk := doc.GetRisk()
+	return rule{
+		ID:        f.Probe,
+		Name:      f.Probe,
+		ShortDesc: text{Text: f.Probe},
+		FullDesc:  text{Text: doc.GetShort()},
+		HelpURI:   doc.GetDocumentationURL(commit),
+		Help: help{
+			Text:     f.Remediation.Text,
+			Markdown: f.Remediation.Markdown,
+		},
+		DefaultConfig: defaultConfig{
+			Level: generateDefaultConfig(risk),
+		},
+		Properties: properties{
+			Tags:            doc.GetTags(),
+			Precision:       "high",
+			ProblemSeverity: generateProblemSeverity(risk),
+			SeverityLevel:   calculateSeverityLevel(risk),
+		},
+	}, nil
+}
+
+func findingToLocation(f *finding.Finding) location {
+	startLine := checker.OffsetDefault
+	loc := location{
+		PhysicalLocation: physicalLocation{
+			ArtifactLocation: artifactLocation{URIBaseID: "%SRCROOT%"},
+			Region:           region{StartLine: &startLine},
+		},
+	}
+	if f.Location == nil {
+		loc.PhysicalLocation.ArtifactLocation.URI = "no file associated with this alert"
+		return loc
+	}
+	loc.PhysicalLocation.ArtifactLocation.URI = f.Location.Path
+	if f.Location.LineStart != nil {
+		startLine = max(checker.OffsetDefault, *f.Location.LineStart)
+	}
+	endLine := startLine
+	if f.Location.LineEnd != nil {
+		endLine = max(startLine, *f.Location.LineEnd)
+	}
+	loc.PhysicalLocation.Region = region{StartLine: &startLine, EndLine: &endLine}
+	if f.Location.Snippet != nil {
+		loc.PhysicalLocation.Region.Snippet = &text{Text: *f.Location.Snippet}
+	}
+	return loc
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/options"
+)
+
+func TestAsFindingsSARIF(t *testing.T) {
+	t.Parallel()
+	checkDocs, err := docs.Read()
+	if err != nil {
+		t.Fatalf("docs.Read: %v", err)
+	}
+	line, snippet := uint(12), "uses: actions/checkout@v4"
+	r := &Result{Findings: []finding.Finding{
+		{
+			Probe:       "pinsDependencies",
+			Outcome:     finding.OutcomeFalse,
+			Message:     "GitHub-owned GitHubAction not pinned by hash",
+			Location:    &finding.Location{Path: ".github/workflows/ci.yml", LineStart: &line, Snippet: &snippet},
+			Remediation: &finding.Remediation{Text: "pin the action by hash"},
+		},
+		{
+			Probe:    "pinsDependencies",
+			Outcome:  finding.OutcomeTrue,
+			Message:  "pinned",
+			Location: &finding.Location{Path: ".github/workflows/ci.yml"},
+		},
+		{
+			Probe:       "hasUnverifiedBinaryArtifacts",
+			Outcome:     finding.OutcomeTrue,
+			Message:     "binary detected",
+			Location:    &finding.Location{Path: "bin/tool"},
+			Remediation: &finding.Remediation{Text: "remove the binary"},
+		},
+	}}
+	var buf bytes.Buffer
+	if err := r.AsFindingsSARIF(&buf, checkDocs, options.New()); err != nil {
+		t.Fatalf("AsFindingsSARIF: %v", err)
+	}
+
+	var got sarif210
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("parsing SARIF: %v", err)
+	}
+	if len(got.Runs) != 1 {
+		t.Fatalf("got %d runs, want 1", len(got.Runs))
+	}
+	run := got.Runs[0]
+	var rules []string
+	for _, rule := range run.Tool.Driver.Rules {
+		rules = append(rules, rule.ID)
+	}
+	if diff := cmp.Diff([]string{"pinsDependencies", "hasUnverifiedBinaryArtifacts"}, rules); diff != "" {
+		t.Errorf("rules mismatch (-want +got):\n%s", diff)
+	}
+	type annotation struct {
+		rule, uri, snippet string
+		index              int
+		line               uint
+	}
+	var annotations []annotation
+	for _, res := range run.Results {
+		loc := res.Locations[0].PhysicalLocation
+		a := annotation{rule: res.RuleID, index: res.RuleIndex, uri: loc.ArtifactLocation.URI, line: *loc.Region.StartLine}
+		if loc.Region.Snippet != nil {
+			a.snippet = loc.Region.Snippet.Text
+		}
+		annotations = append(annotations, a)
+	}
+	want := []annotation{
+		{rule: "pinsDependencies", index: 0, uri: ".github/workflows/ci.yml", line: 12, snippet: snippet},
+		{rule: "hasUnverifiedBinaryArtifacts", index: 1, uri: "bin/tool", line: 1},
+	}
+	if diff := cmp.Diff(want, annotations, cmp.AllowUnexported(annotation{})); diff != "" {
+		t.Errorf("results mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -20,10 +20,15 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"strings"
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"sigs.k8s.io/release-utils/version"
 
 	"github.com/ossf/scorecard/v5/checker"
@@ -45,6 +50,8 @@ import (
 // errEmptyRepository indicates the repository is empty.
 var errEmptyRepository = errors.New("repository empty")
 
+var tracer = otel.Tracer("github.com/ossf/scorecard/v5/pkg/scorecard")
+
 func runEnabledChecks(ctx context.Context,
 	repo clients.Repo,
 	request *checker.CheckRequest,
@@ -96,6 +103,9 @@ func runScorecard(ctx context.Context,
 	ciiClient clients.CIIBestPracticesClient,
 	vulnsClient clients.VulnerabilitiesClient,
 	projectClient packageclient.ProjectPackageClient,
+	remoteRepoClient func(repo, ref string) (clients.RepoClient, error),
+	sastAlertAge time.Duration,
+	progress func(checker.CheckResult),
 ) (Result, error) {
 	if err := repoClient.InitRepo(repo, commitSHA, commitDepth); err != nil {
 		// No need to call sce.WithMessage() since InitRepo will do that for us.
@@ -160,6 +170,9 @@ func runScorecard(ctx context.Context,
 		CIIClient:             ciiClient,
 		VulnerabilitiesClient: vulnsClient,
 		ProjectClient:         projectClient,
+		RemoteRepoClient:      remoteRepoClient,
+		SASTAlertAge:          sastAlertAge,
+		Cache:                 &checker.RequestCache{},
 		Repo:                  repo,
 		RawResults:            &ret.RawResults,
 	}
@@ -191,6 +204,9 @@ func runScorecard(ctx context.Context,
 	}
 
 	for result := range resultsCh {
+		if progress != nil {
+			progress(result)
+		}
 		ret.Checks = append(ret.Checks, result)
 		ret.Findings = append(ret.Findings, result.Findings...)
 	}
@@ -261,8 +277,18 @@ type runConfig struct {
 	logLevel      sclog.Level
 	checks        []string
 	probes        []string
+	sarifAlerts   []clients.CodeScanningAlert
+	sastAlertAge  time.Duration
 	commitDepth   int
 	gitMode       bool
+	useSARIF      bool
+	// remoteWorkflows enables following reusable workflows and actions
+	// hosted in other repositories.
+	remoteWorkflows bool
+	progress        func(checker.CheckResult)
+	githubTransport http.RoundTripper
+	// paths restricts the files analyzed by file-based checks, if set.
+	paths []string
 }
 
 type Option func(*runConfig) error
@@ -358,10 +384,149 @@ func WithFileModeGit() Option {
 	}
 }
 
+// WithCodeScanningSARIF reads the project's open code scanning alerts from
+// the given SARIF file, instead of querying the repo host or forge for them.
+// This lets results of SAST tools which don't upload to the forge be evaluated.
+func WithCodeScanningSARIF(path string) Option {
+	return func(c *runConfig) error {
+		alerts, err := readSARIFAlerts(path)
+		if err != nil {
+			return err
+		}
+		c.sarifAlerts = alerts
+		c.useSARIF = true
+		return nil
+	}
+}
+
+// WithSASTAlertAge configures how long critical and high severity code scanning
+// alerts may stay open before they're reported. The default is 30 days.
+func WithSASTAlertAge(age time.Duration) Option {
+	return func(c *runConfig) error {
+		c.sastAlertAge = age
+		return nil
+	}
+}
+
+// WithRemoteWorkflows will configure checks analyzing GitHub workflows to also
+// follow the reusable workflows and composite actions they call from other
+// repositories. Each such repository is downloaded, so analysis may be slower.
+func WithRemoteWorkflows() Option {
+	return func(c *runConfig) error {
+		c.remoteWorkflows = true
+		return nil
+	}
+}
+
+// WithProgress will call fn with the result of each check as soon as it
+// completes, before Run returns. fn isn't called concurrently.
+func WithProgress(fn func(checker.CheckResult)) Option {
+	return func(c *runConfig) error {
+		c.progress = fn
+		return nil
+	}
+}
+
+// WithGitHubTransport configures the GitHub clients created for the analysis
+// to send their requests through rt, e.g. to share a rate limit budget
+// between concurrent analyses. rt is responsible for authenticating requests.
+func WithGitHubTransport(rt http.RoundTripper) Option {
+	return func(c *runConfig) error {
+		c.githubTransport = rt
+		return nil
+	}
+}
+
+// WithPaths restricts the files analyzed by the checks to the given paths,
+// relative to the root of the repository, e.g. the files changed by a pull
+// request. Checks which don't analyze files aren't affected.
+func WithPaths(paths []string) Option {
+	return func(c *runConfig) error {
+		c.paths = paths
+		return nil
+	}
+}
+
+// initRepoClient creates the client of the repo host or forge of repo, unless
+// one was given with [WithRepoClient].
+func (c *runConfig) initRepoClient(ctx context.Context, repo clients.Repo, logger *sclog.Logger) error {
+	if c.client != nil {
+		return nil
+	}
+	var err error
+	switch repo.(type) {
+	case *localdir.Repo:
+		c.client = localdir.CreateLocalDirClient(ctx, logger)
+	case *githubrepo.Repo:
+		var opts []githubrepo.Option
+		if c.gitMode {
+			opts = append(opts, githubrepo.WithFileModeGit())
+		}
+		if c.githubTransport != nil {
+			opts = append(opts, githubrepo.WithRoundTripper(c.githubTransport))
+		}
+		c.client, err = githubrepo.NewRepoClient(ctx, opts...)
+		if err != nil {
+			return fmt.Errorf("creating github client: %w", err)
+		}
+	case *gitlabrepo.Repo:
+		c.client, err = gitlabrepo.CreateGitlabClient(ctx, repo.Host())
+		if err != nil {
+			return fmt.Errorf("creating gitlab client: %w", err)
+		}
+	case *azuredevopsrepo.Repo:
+		c.client, err = azuredevopsrepo.CreateAzureDevOpsClient(ctx, repo)
+		if err != nil {
+			return fmt.Errorf("creating azure devops client: %w", err)
+		}
+	}
+	return nil
+}
+
+// ResolveCommit returns the SHA of the commit [Run] would analyze with the same
+// options, without analyzing it. This is much cheaper than a full analysis, so
+// it can be used to look up previous results of the same commit.
+func ResolveCommit(ctx context.Context, repo clients.Repo, opts ...Option) (string, error) {
+	c := runConfig{
+		commit:   clients.HeadSHA,
+		logLevel: sclog.DefaultLevel,
+	}
+	for _, option := range opts {
+		if err := option(&c); err != nil {
+			return "", err
+		}
+	}
+	if err := c.initRepoClient(ctx, repo, sclog.NewLogger(c.logLevel)); err != nil {
+		return "", err
+	}
+	if c.client == nil {
+		return "", sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("unsupported repo: %s", repo.URI()))
+	}
+	if err := c.client.InitRepo(repo, c.commit, 1); err != nil {
+		//nolint:wrapcheck // InitRepo already wraps its errors.
+		return "", err
+	}
+	defer c.client.Close()
+	sha, err := getRepoCommitHash(c.client)
+	if err != nil {
+		return "", err
+	}
+	return sha, nil
+}
+
 // Run analyzes a given repository and returns the result. You can modify the
 // run behavior by passing in [Option] arguments. In the absence of a particular
 // option a default is used. Refer to the various Options for details.
-func Run(ctx context.Context, repo clients.Repo, opts ...Option) (Result, error) {
+func Run(ctx context.Context, repo clients.Repo, opts ...Option) (result Result, err error) {
+	ctx, span := tracer.Start(ctx, "scorecard.Run", trace.WithAttributes(attribute.String("scorecard.repo", repo.URI())))
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
 	c := runConfig{
 		commit:   clients.HeadSHA,
 		logLevel: sclog.DefaultLevel,
@@ -386,39 +551,18 @@ func Run(ctx context.Context, repo clients.Repo, opts ...Option) (Result, error)
 	}
 
 	var requiredRequestTypes []checker.RequestType
-	var err error
-	switch repo.(type) {
-	case *localdir.Repo:
+	if _, ok := repo.(*localdir.Repo); ok {
 		requiredRequestTypes = append(requiredRequestTypes, checker.FileBased)
-		if c.client == nil {
-			c.client = localdir.CreateLocalDirClient(ctx, logger)
-		}
-	case *githubrepo.Repo:
-		if c.client == nil {
-			var opts []githubrepo.Option
-			if c.gitMode {
-				opts = append(opts, githubrepo.WithFileModeGit())
-			}
-			client, err := githubrepo.NewRepoClient(ctx, opts...)
-			if err != nil {
-				return Result{}, fmt.Errorf("creating github client: %w", err)
-			}
-			c.client = client
-		}
-	case *gitlabrepo.Repo:
-		if c.client == nil {
-			c.client, err = gitlabrepo.CreateGitlabClient(ctx, repo.Host())
-			if err != nil {
-				return Result{}, fmt.Errorf("creating gitlab client: %w", err)
-			}
-		}
-	case *azuredevopsrepo.Repo:
-		if c.client == nil {
-			c.client, err = azuredevopsrepo.CreateAzureDevOpsClient(ctx, repo)
-			if err != nil {
-				return Result{}, fmt.Errorf("creating azure devops client: %w", err)
-			}
-		}
+	}
+	if err := c.initRepoClient(ctx, repo, logger); err != nil {
+		return Result{}, err
+	}
+
+	if c.useSARIF {
+		c.client = &sarifAlertsClient{RepoClient: c.client, alerts: c.sarifAlerts}
+	}
+	if c.paths != nil {
+		c.client = newPathsClient(c.client, c.paths)
 	}
 
 	if !strings.EqualFold(c.commit, clients.HeadSHA) {
@@ -430,6 +574,13 @@ func Run(ctx context.Context, repo clients.Repo, opts ...Option) (Result, error)
 		return Result{}, fmt.Errorf("getting enabled checks: %w", err)
 	}
 
+	var remoteRepoClient func(repo, ref string) (clients.RepoClient, error)
+	if _, ok := repo.(*githubrepo.Repo); ok && c.remoteWorkflows {
+		remoteClients := newRemoteRepoClients(ctx, c.githubTransport)
+		defer remoteClients.close()
+		remoteRepoClient = remoteClients.get
+	}
+
 	return runScorecard(ctx, repo, c.commit, c.commitDepth, checksToRun, c.probes,
-		c.client, c.ossfuzzClient, c.ciiClient, c.vulnClient, c.projectClient)
+		c.client, c.ossfuzzClient, c.ciiClient, c.vulnClient, c.projectClient, remoteRepoClient, c.sastAlertAge, c.progress)
 }
@@ -27,10 +27,14 @@ import (
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/checks"
 	"github.com/ossf/scorecard/v5/checks/raw"
+	"github.com/ossf/scorecard/v5/checks/raw/azuredevops"
 	"github.com/ossf/scorecard/v5/checks/raw/github"
 	"github.com/ossf/scorecard/v5/checks/raw/gitlab"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/azuredevopsrepo"
 	"github.com/ossf/scorecard/v5/clients/githubrepo"
 	"github.com/ossf/scorecard/v5/clients/gitlabrepo"
+	"github.com/ossf/scorecard/v5/clients/localdir"
 	"github.com/ossf/scorecard/v5/config"
 	docChecks "github.com/ossf/scorecard/v5/docs/checks"
 	sce "github.com/ossf/scorecard/v5/errors"
@@ -274,6 +278,24 @@ func (r *Result) AsString(writer io.Writer, checkDocs docChecks.Doc, opt *AsStri
 	return nil
 }
 
+// localPackaging runs the packaging detection of every forge, since the forge
+// of a local directory isn't known.
+func localPackaging(request *checker.CheckRequest) (checker.PackagingData, error) {
+	var rawData checker.PackagingData
+	for _, packaging := range []func(*checker.CheckRequest) (checker.PackagingData, error){
+		github.Packaging,
+		gitlab.Packaging,
+		azuredevops.Packaging,
+	} {
+		data, err := packaging(request)
+		if err != nil {
+			return checker.PackagingData{}, err
+		}
+		rawData.Packages = append(rawData.Packages, data.Packages...)
+	}
+	return rawData, nil
+}
+
 //nolint:gocognit,gocyclo // nothing better to do right now
 func assignRawData(probeCheckName string, request *checker.CheckRequest, ret *Result) error {
 	switch probeCheckName {
@@ -296,7 +318,7 @@ func assignRawData(probeCheckName string, request *checker.CheckRequest, ret *Re
 		}
 		ret.RawResults.CIIBestPracticesResults = rawData
 	case checks.CheckCITests:
-		rawData, err := raw.CITests(request.RepoClient)
+		rawData, err := raw.CITests(request)
 		if err != nil {
 			return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
 		}
@@ -344,7 +366,13 @@ func assignRawData(probeCheckName string, request *checker.CheckRequest, ret *Re
 		}
 		ret.RawResults.MaintainedResults = rawData
 	case checks.CheckPackaging:
-		switch request.RepoClient.(type) {
+		switch clients.Unwrap(request.RepoClient).(type) {
+		case *localdir.Client:
+			rawData, err := localPackaging(request)
+			if err != nil {
+				return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+			}
+			ret.RawResults.PackagingResults = rawData
 		case *githubrepo.Client:
 			rawData, err := github.Packaging(request)
 			if err != nil {
@@ -357,8 +385,14 @@ func assignRawData(probeCheckName string, request *checker.CheckRequest, ret *Re
 				return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
 			}
 			ret.RawResults.PackagingResults = rawData
+		case *azuredevopsrepo.Client:
+			rawData, err := azuredevops.Packaging(request)
+			if err != nil {
+				return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+			}
+			ret.RawResults.PackagingResults = rawData
 		default:
-			return sce.WithMessage(sce.ErrScorecardInternal, "Only github and gitlab are supported")
+			return sce.WithMessage(sce.ErrScorecardInternal, "Only github, gitlab and azure devops are supported")
 		}
 	case checks.CheckPinnedDependencies:
 		rawData, err := raw.PinningDependencies(request)
@@ -378,6 +412,12 @@ func assignRawData(probeCheckName string, request *checker.CheckRequest, ret *Re
 			return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
 		}
 		ret.RawResults.SBOMResults = rawData
+	case checks.CheckSecretScanning:
+		rawData, err := raw.SecretScanning(request)
+		if err != nil {
+			return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+		}
+		ret.RawResults.SecretScanningResults = rawData
 	case checks.CheckSecurityPolicy:
 		rawData, err := raw.SecurityPolicy(request)
 		if err != nil {
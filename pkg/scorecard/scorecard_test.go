@@ -16,6 +16,8 @@ package scorecard
 import (
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"slices"
 	"strings"
 	"testing"
@@ -32,6 +34,7 @@ import (
 	"github.com/ossf/scorecard/v5/finding"
 	"github.com/ossf/scorecard/v5/log"
 	"github.com/ossf/scorecard/v5/probes/fuzzed"
+	"github.com/ossf/scorecard/v5/probes/packagedWithAutomatedWorkflow"
 )
 
 func Test_getRepoCommitHash(t *testing.T) {
@@ -200,6 +203,29 @@ func TestRun(t *testing.T) {
 	}
 }
 
+func TestResolveCommit(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	repo := mockrepo.NewMockRepo(ctrl)
+	repo.EXPECT().URI().Return("github.com/ossf/scorecard").AnyTimes()
+
+	mockRepoClient.EXPECT().InitRepo(repo, clients.HeadSHA, 1).Return(nil)
+	mockRepoClient.EXPECT().Close().Return(nil)
+	mockRepoClient.EXPECT().ListCommits().Return([]clients.Commit{
+		{SHA: "0123456789abcdef0123456789abcdef01234567"},
+		{SHA: "89abcdef0123456789abcdef0123456789abcdef"},
+	}, nil)
+
+	got, err := ResolveCommit(t.Context(), repo, WithRepoClient(mockRepoClient))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "0123456789abcdef0123456789abcdef01234567" {
+		t.Errorf("ResolveCommit() = %s", got)
+	}
+}
+
 func TestRun_WithProbes(t *testing.T) {
 	t.Parallel()
 	// These values depend on the environment,
@@ -427,3 +453,44 @@ func Test_findConfigFile(t *testing.T) {
 		})
 	}
 }
+
+func TestRun_WithProbesWrappedClient(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	workflows := filepath.Join(dir, ".github", "workflows")
+	if err := os.MkdirAll(workflows, 0o755); err != nil {
+		t.Fatalf("MkdirAll: %v", err)
+	}
+	workflow, err := os.ReadFile("../../checks/testdata/.github/workflows/github-workflow-packaging-npm.yaml")
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(workflows, "publish.yml"), workflow, 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	repo, err := localdir.MakeLocalDirRepo(dir)
+	if err != nil {
+		t.Fatalf("MakeLocalDirRepo: %v", err)
+	}
+	ctrl := gomock.NewController(t)
+	mockOSSFuzzClient := mockrepo.NewMockRepoClient(ctrl)
+
+	// WithPaths wraps the local client, which the Packaging raw data must unwrap.
+	got, err := Run(t.Context(), repo,
+		WithRepoClient(localdir.CreateLocalDirClient(t.Context(), log.NewLogger(log.DefaultLevel))),
+		WithOSSFuzzClient(mockOSSFuzzClient),
+		WithPaths([]string{".github/workflows/publish.yml"}),
+		WithProbes([]string{packagedWithAutomatedWorkflow.Probe}),
+	)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(got.Findings) == 0 {
+		t.Fatal("no findings")
+	}
+	for _, f := range got.Findings {
+		if f.Outcome != finding.OutcomeTrue {
+			t.Errorf("finding %q has outcome %v, want %v", f.Message, f.Outcome, finding.OutcomeTrue)
+		}
+	}
+}
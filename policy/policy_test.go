@@ -338,7 +338,7 @@ func TestGetEnabled(t *testing.T) {
 			argsChecks:            []string{},
 			requiredRequestTypes:  []checker.RequestType{checker.FileBased, checker.CommitBased},
 			repoType:              clients.RepoTypeGitHub,
-			expectedEnabledChecks: 7, // All checks which are FileBased and CommitBased
+			expectedEnabledChecks: 8, // All checks which are FileBased and CommitBased
 			expectedError:         false,
 		},
 		{
@@ -371,7 +371,7 @@ func TestGetEnabled(t *testing.T) {
 			argsChecks:            []string{},
 			requiredRequestTypes:  []checker.RequestType{},
 			repoType:              clients.RepoTypeAzureDevOps,
-			expectedEnabledChecks: 13, // Only checks with Azure DevOps in repos field
+			expectedEnabledChecks: 15, // Only checks with Azure DevOps in repos field
 			expectedError:         false,
 		},
 	}
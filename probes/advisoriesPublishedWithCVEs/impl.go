@@ -0,0 +1,89 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package advisoriesPublishedWithCVEs
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.SecurityPolicy})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe       = "advisoriesPublishedWithCVEs"
+	AdvisoryKey = "advisory"
+	CVEKey      = "cve"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := raw.SecurityPolicyResults.VulnerabilityReporting
+	if !r.AdvisoriesAvailable {
+		f, err := finding.NewNotAvailable(fs, Probe, "could not list security advisories", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	if len(r.Advisories) == 0 {
+		f, err := finding.NewNotApplicable(fs, Probe, "no published security advisories", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	findings := make([]finding.Finding, 0, len(r.Advisories))
+	for i := range r.Advisories {
+		a := &r.Advisories[i]
+		var f *finding.Finding
+		var err error
+		if a.CVEID != "" {
+			f, err = finding.NewTrue(fs, Probe, fmt.Sprintf("advisory %s has CVE ID %s", a.ID, a.CVEID), nil)
+		} else {
+			f, err = finding.NewFalse(fs, Probe, fmt.Sprintf("advisory %s has no CVE ID", a.ID), nil)
+		}
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValue(AdvisoryKey, a.ID)
+		if a.CVEID != "" {
+			f = f.WithValue(CVEKey, a.CVEID)
+		}
+		if a.URL != "" {
+			f = f.WithLocation(&finding.Location{
+				Type: finding.FileTypeURL,
+				Path: a.URL,
+			})
+		}
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
@@ -17,6 +17,7 @@ package probes
 import (
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/advisoriesPublishedWithCVEs"
 	"github.com/ossf/scorecard/v5/probes/archived"
 	"github.com/ossf/scorecard/v5/probes/blocksDeleteOnBranches"
 	"github.com/ossf/scorecard/v5/probes/blocksForcePushOnBranches"
@@ -30,22 +31,31 @@ import (
 	"github.com/ossf/scorecard/v5/probes/dismissesStaleReviews"
 	"github.com/ossf/scorecard/v5/probes/fuzzed"
 	"github.com/ossf/scorecard/v5/probes/hasBinaryArtifacts"
+	"github.com/ossf/scorecard/v5/probes/hasCommittedSecrets"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowArtifactPoisoning"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowCachePoisoning"
 	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowScriptInjection"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowSelfHostedRunner"
 	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowUntrustedCheckout"
 	"github.com/ossf/scorecard/v5/probes/hasFSFOrOSIApprovedLicense"
 	"github.com/ossf/scorecard/v5/probes/hasLicenseFile"
+	"github.com/ossf/scorecard/v5/probes/hasLockfile"
 	"github.com/ossf/scorecard/v5/probes/hasNoGitHubWorkflowPermissionUnknown"
 	"github.com/ossf/scorecard/v5/probes/hasOSVVulnerabilities"
 	"github.com/ossf/scorecard/v5/probes/hasOpenSSFBadge"
 	"github.com/ossf/scorecard/v5/probes/hasPermissiveLicense"
 	"github.com/ossf/scorecard/v5/probes/hasRecentCommits"
+	"github.com/ossf/scorecard/v5/probes/hasRuntimeHardening"
 	"github.com/ossf/scorecard/v5/probes/hasReleaseSBOM"
 	"github.com/ossf/scorecard/v5/probes/hasSBOM"
+	"github.com/ossf/scorecard/v5/probes/hasUnresolvedSASTAlerts"
 	"github.com/ossf/scorecard/v5/probes/hasUnverifiedBinaryArtifacts"
 	"github.com/ossf/scorecard/v5/probes/issueActivityByProjectMember"
 	"github.com/ossf/scorecard/v5/probes/jobLevelPermissions"
+	"github.com/ossf/scorecard/v5/probes/lockfileHasIntegrityHashes"
 	"github.com/ossf/scorecard/v5/probes/packagedWithAutomatedWorkflow"
 	"github.com/ossf/scorecard/v5/probes/pinsDependencies"
+	"github.com/ossf/scorecard/v5/probes/privateVulnerabilityReportingEnabled"
 	"github.com/ossf/scorecard/v5/probes/releasesAreSigned"
 	"github.com/ossf/scorecard/v5/probes/releasesHaveProvenance"
 	"github.com/ossf/scorecard/v5/probes/releasesHaveVerifiedProvenance"
@@ -57,10 +67,14 @@ import (
 	"github.com/ossf/scorecard/v5/probes/runsStatusChecksBeforeMerging"
 	"github.com/ossf/scorecard/v5/probes/sastToolConfigured"
 	"github.com/ossf/scorecard/v5/probes/sastToolRunsOnAllCommits"
+	"github.com/ossf/scorecard/v5/probes/secretPushProtectionEnabled"
+	"github.com/ossf/scorecard/v5/probes/secretScanningEnabled"
+	"github.com/ossf/scorecard/v5/probes/secretScanningToolConfigured"
 	"github.com/ossf/scorecard/v5/probes/securityPolicyContainsLinks"
 	"github.com/ossf/scorecard/v5/probes/securityPolicyContainsText"
 	"github.com/ossf/scorecard/v5/probes/securityPolicyContainsVulnerabilityDisclosure"
 	"github.com/ossf/scorecard/v5/probes/securityPolicyPresent"
+	"github.com/ossf/scorecard/v5/probes/testsConfiguredInCI"
 	"github.com/ossf/scorecard/v5/probes/testsRunInCI"
 	"github.com/ossf/scorecard/v5/probes/topLevelPermissions"
 	"github.com/ossf/scorecard/v5/probes/unsafeblock"
@@ -115,6 +129,9 @@ var (
 	DangerousWorkflows = []ProbeImpl{
 		hasDangerousWorkflowScriptInjection.Run,
 		hasDangerousWorkflowUntrustedCheckout.Run,
+		hasDangerousWorkflowArtifactPoisoning.Run,
+		hasDangerousWorkflowCachePoisoning.Run,
+		hasDangerousWorkflowSelfHostedRunner.Run,
 	}
 	Maintained = []ProbeImpl{
 		archived.Run,
@@ -133,11 +150,18 @@ var (
 	}
 	CITests = []ProbeImpl{
 		testsRunInCI.Run,
+		testsConfiguredInCI.Run,
 	}
 	SBOM = []ProbeImpl{
 		hasSBOM.Run,
 		hasReleaseSBOM.Run,
 	}
+	SecretScanning = []ProbeImpl{
+		secretScanningEnabled.Run,
+		secretPushProtectionEnabled.Run,
+		secretScanningToolConfigured.Run,
+		hasCommittedSecrets.Run,
+	}
 	SignedReleases = []ProbeImpl{
 		releasesAreSigned.Run,
 		releasesHaveProvenance.Run,
@@ -157,6 +181,8 @@ var (
 	}
 	PinnedDependencies = []ProbeImpl{
 		pinsDependencies.Run,
+		hasLockfile.Run,
+		lockfileHasIntegrityHashes.Run,
 	}
 	TokenPermissions = []ProbeImpl{
 		hasNoGitHubWorkflowPermissionUnknown.Run,
@@ -171,11 +197,15 @@ var (
 		codeReviewOneReviewers.Run,
 		hasBinaryArtifacts.Run,
 		releasesHaveVerifiedProvenance.Run,
+		privateVulnerabilityReportingEnabled.Run,
+		advisoriesPublishedWithCVEs.Run,
+		hasUnresolvedSASTAlerts.Run,
 	}
 
 	// Probes which don't use pre-computed raw data but rather collect it themselves.
 	Independent = []IndependentProbeImpl{
 		unsafeblock.Run,
+		hasRuntimeHardening.Run,
 	}
 )
 
@@ -0,0 +1,66 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hasCommittedSecrets
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.SecretScanning})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe   = "hasCommittedSecrets"
+	RuleKey = "rule"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, Probe, fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	secrets := raw.SecretScanningResults.CommittedSecrets
+	if len(secrets) == 0 {
+		f, err := finding.NewFalse(fs, Probe, "no committed secrets found", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	var findings []finding.Finding
+	for i := range secrets {
+		secret := &secrets[i]
+		f, err := finding.NewTrue(fs, Probe, "committed secret: "+secret.Rule, secret.File.Location())
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValue(RuleKey, secret.Rule)
+		findings = append(findings, *f)
+	}
+
+	return findings, Probe, nil
+}
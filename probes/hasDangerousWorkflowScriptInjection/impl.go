@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"strings"
 
 	"github.com/rhysd/actionlint"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/ossf/scorecard/v5/internal/checknames"
 	"github.com/ossf/scorecard/v5/internal/probes"
 	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowScriptInjection/internal/patch"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/callchain"
 	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
 )
 
@@ -37,7 +39,12 @@ func init() {
 //go:embed *.yml
 var fs embed.FS
 
-const Probe = "hasDangerousWorkflowScriptInjection"
+const (
+	Probe = "hasDangerousWorkflowScriptInjection"
+	// TaintPathKey is the Values map key for the values through which
+	// untrusted input reached the script, starting with the input itself.
+	TaintPathKey = "taintPath"
+)
 
 func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 	if raw == nil {
@@ -81,6 +88,19 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 			Snippet:   &w.File.Snippet,
 		})
 
+		if len(w.TaintPath) > 0 {
+			f = f.WithValue(TaintPathKey, strings.Join(w.TaintPath, " -> "))
+		}
+		// Patches are only generated for the repository's own workflows,
+		// and only for untrusted input used directly in the script.
+		if w.File.CallChain != "" || len(w.TaintPath) > 0 {
+			if w.File.CallChain != "" {
+				f = f.WithValue(callchain.Key, w.File.CallChain)
+			}
+			findings = append(findings, *f)
+			continue
+		}
+
 		err = parseWorkflow(localPath, &w, &currWorkflow, &content, &workflow, &errs)
 		if err == nil {
 			generatePatch(&w, content, workflow, errs, f)
@@ -88,3 +88,38 @@ func Test_Run(t *testing.T) {
 		})
 	}
 }
+
+func Test_Run_taintPath(t *testing.T) {
+	t.Parallel()
+	raw := &checker.RawResults{
+		DangerousWorkflowResults: checker.DangerousWorkflowData{
+			NumWorkflows: 1,
+			Workflows: []checker.DangerousWorkflow{
+				{
+					Type: checker.DangerousWorkflowScriptInjection,
+					File: checker.File{
+						Path:    "patch/testdata/userInputAssignedToVariable.yaml",
+						Type:    finding.FileTypeSource,
+						Offset:  12,
+						Snippet: " env.TITLE ",
+					},
+					TaintPath: []string{"github.event.issue.title", "env.TITLE"},
+				},
+			},
+		},
+	}
+	findings, _, err := Run(raw)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(findings) != 1 {
+		t.Fatalf("got %d findings, want 1", len(findings))
+	}
+	want := "github.event.issue.title -> env.TITLE"
+	if got := findings[0].Values[TaintPathKey]; got != want {
+		t.Errorf("taintPath = %q, want %q", got, want)
+	}
+	if findings[0].Remediation != nil && findings[0].Remediation.Patch != nil {
+		t.Errorf("unexpected patch for tainted value")
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hasDangerousWorkflowSelfHostedRunner
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		err      error
+	}{
+		{
+			name: "nil raw results",
+			raw:  nil,
+			err:  uerror.ErrNil,
+		},
+		{
+			name: "no workflows",
+			raw: &checker.RawResults{
+				DangerousWorkflowResults: checker.DangerousWorkflowData{
+					NumWorkflows: 0,
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "other dangerous patterns only",
+			raw: &checker.RawResults{
+				DangerousWorkflowResults: checker.DangerousWorkflowData{
+					NumWorkflows: 2,
+					Workflows: []checker.DangerousWorkflow{
+						{
+							Type: checker.DangerousWorkflowScriptInjection,
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeFalse,
+			},
+		},
+		{
+			name: "two jobs with the pattern",
+			raw: &checker.RawResults{
+				DangerousWorkflowResults: checker.DangerousWorkflowData{
+					NumWorkflows: 2,
+					Workflows: []checker.DangerousWorkflow{
+						{
+							Type: checker.DangerousWorkflowSelfHostedRunner,
+							File: checker.File{
+								Path:    ".github/workflows/a.yml",
+								Type:    finding.FileTypeSource,
+								Offset:  10,
+								Snippet: "self-hosted",
+							},
+						},
+						{
+							Type: checker.DangerousWorkflowSelfHostedRunner,
+							File: checker.File{
+								Path:      ".github/workflows/b.yml",
+								Type:      finding.FileTypeSource,
+								Offset:    20,
+								Snippet:   "self-hosted",
+								CallChain: ".github/workflows/c.yml",
+							},
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeTrue,
+				finding.OutcomeTrue,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, s, err := Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(Probe, s); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+		})
+	}
+}
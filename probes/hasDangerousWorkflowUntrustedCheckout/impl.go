@@ -22,6 +22,7 @@ import (
 	"github.com/ossf/scorecard/v5/finding"
 	"github.com/ossf/scorecard/v5/internal/checknames"
 	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/callchain"
 	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
 )
 
@@ -32,7 +33,9 @@ func init() {
 //go:embed *.yml
 var fs embed.FS
 
-const Probe = "hasDangerousWorkflowUntrustedCheckout"
+const (
+	Probe = "hasDangerousWorkflowUntrustedCheckout"
+)
 
 func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 	if raw == nil {
@@ -66,6 +69,9 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 				LineStart: &e.File.Offset,
 				Snippet:   &e.File.Snippet,
 			})
+			if e.File.CallChain != "" {
+				f = f.WithValue(callchain.Key, e.File.CallChain)
+			}
 			findings = append(findings, *f)
 		}
 	}
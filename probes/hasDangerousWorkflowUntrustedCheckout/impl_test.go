@@ -22,6 +22,7 @@ import (
 
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/callchain"
 	"github.com/ossf/scorecard/v5/probes/internal/utils/test"
 )
 
@@ -85,3 +86,33 @@ func Test_Run(t *testing.T) {
 		})
 	}
 }
+
+func Test_Run_callChain(t *testing.T) {
+	t.Parallel()
+	raw := &checker.RawResults{
+		DangerousWorkflowResults: checker.DangerousWorkflowData{
+			NumWorkflows: 1,
+			Workflows: []checker.DangerousWorkflow{
+				{
+					Type: checker.DangerousWorkflowUntrustedCheckout,
+					File: checker.File{
+						Path:      ".github/actions/setup/action.yml",
+						Type:      finding.FileTypeSource,
+						Offset:    10,
+						CallChain: ".github/workflows/ci.yml",
+					},
+				},
+			},
+		},
+	}
+	findings, _, err := Run(raw)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(findings) != 1 {
+		t.Fatalf("got %d findings, want 1", len(findings))
+	}
+	if got := findings[0].Values[callchain.Key]; got != ".github/workflows/ci.yml" {
+		t.Errorf("callChain = %q, want %q", got, ".github/workflows/ci.yml")
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hasLockfile
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.PinnedDependencies})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe       = "hasLockfile"
+	DepTypeKey  = "dependencyType"
+	LockfileKey = "lockfile"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	var findings []finding.Finding
+	for i := range raw.PinningDependenciesResults.Dependencies {
+		d := &raw.PinningDependenciesResults.Dependencies[i]
+		if !d.Type.IsManifest() || d.Location == nil {
+			continue
+		}
+		text, outcome := fmt.Sprintf("%s dependencies are not locked", d.Location.Path), finding.OutcomeFalse
+		if d.Lockfile != nil {
+			text, outcome = fmt.Sprintf("%s dependencies are locked by %s", d.Location.Path, d.Lockfile.Path),
+				finding.OutcomeTrue
+		}
+		f, err := finding.NewWith(fs, Probe, text, d.Location.Location(), outcome)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValue(DepTypeKey, string(d.Type))
+		if d.Lockfile != nil {
+			f = f.WithValue(LockfileKey, d.Lockfile.Path)
+		}
+		findings = append(findings, *f)
+	}
+
+	if len(findings) == 0 {
+		f, err := finding.NewWith(fs, Probe, "no manifest declaring dependencies found", nil, finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+	return findings, Probe, nil
+}
@@ -0,0 +1,204 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hasRuntimeHardening
+
+import (
+	"embed"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/rhysd/actionlint"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/probes"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe = "hasRuntimeHardening"
+	// JobKey is the ID of the job in its workflow.
+	JobKey = "job"
+	// MethodKey is how the job is hardened: "harden-runner" or "runnerLabel".
+	MethodKey = "method"
+	// ReleaseKey is "true" if the workflow of the job publishes packages.
+	ReleaseKey = "releaseWorkflow"
+
+	MethodHardenRunner = "harden-runner"
+	MethodRunnerLabel  = "runnerLabel"
+
+	hardenRunner = "step-security/harden-runner"
+)
+
+// Labels of self-hosted runners without network access or with a restricted egress.
+var reRestrictedLabel = regexp.MustCompile(`(?i)^(air-?gapped|offline|isolated|no-?(network|internet|egress)|` +
+	`(network|internet|egress)-?(restricted|blocked|isolated|locked|none|allowlist))$`)
+
+func init() {
+	probes.MustRegisterIndependent(Probe, Run)
+}
+
+type workflowData struct {
+	findings []finding.Finding
+}
+
+func Run(c *checker.CheckRequest) ([]finding.Finding, string, error) {
+	var data workflowData
+	err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+		Pattern:       ".github/workflows/*",
+		CaseSensitive: false,
+	}, checkWorkflow, &data)
+	if err != nil {
+		return nil, Probe, err
+	}
+
+	if len(data.findings) == 0 {
+		f, err := finding.NewWith(fs, Probe, "no GitHub workflow jobs found", nil, finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+	return data.findings, Probe, nil
+}
+
+var checkWorkflow fileparser.DoWhileTrueOnFileContent = func(path string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if !fileparser.IsWorkflowFile(path) {
+		return true, nil
+	}
+	data, ok := args[0].(*workflowData)
+	if !ok {
+		// panic if it is not correct type
+		panic(fmt.Sprintf("expected type *workflowData, got %v", reflect.TypeOf(args[0])))
+	}
+	workflow, errs := actionlint.Parse(content)
+	if len(errs) > 0 && workflow == nil {
+		return false, fileparser.FormatActionlintError(errs)
+	}
+	_, release := fileparser.IsPackagingWorkflow(workflow, path)
+
+	ids := make([]string, 0, len(workflow.Jobs))
+	for id := range workflow.Jobs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		job := workflow.Jobs[id]
+		// Reusable workflows are hardened in their own jobs.
+		if job == nil || job.WorkflowCall != nil {
+			continue
+		}
+		outcome := finding.OutcomeTrue
+		method, text := jobHardening(job)
+		if method == "" {
+			outcome = finding.OutcomeFalse
+		}
+		loc := &finding.Location{
+			Type:      finding.FileTypeSource,
+			Path:      path,
+			LineStart: asPointer(fileparser.GetLineNumber(job.Pos)),
+			Snippet:   &id,
+		}
+		f, err := finding.NewWith(fs, Probe, fmt.Sprintf("job '%s' %s", id, text), loc, outcome)
+		if err != nil {
+			return false, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValue(JobKey, id)
+		f = f.WithValue(ReleaseKey, fmt.Sprint(release))
+		if method != "" {
+			f = f.WithValue(MethodKey, method)
+		}
+		data.findings = append(data.findings, *f)
+	}
+	return true, nil
+}
+
+// jobHardening returns how the job restricts its network access, if it does,
+// and a description of it.
+func jobHardening(job *actionlint.Job) (method, text string) {
+	first, policy := hardenRunnerStep(job)
+	if first && policy == "block" {
+		return MethodHardenRunner, "blocks egress traffic with " + hardenRunner
+	}
+	if label, ok := restrictedRunnerLabel(job); ok {
+		return MethodRunnerLabel, fmt.Sprintf("runs on a network-restricted self-hosted runner '%s'", label)
+	}
+	switch {
+	case policy == "":
+		return "", "has no runtime hardening"
+	case !first:
+		// Egress traffic is only blocked for the steps following harden-runner.
+		return "", "runs " + hardenRunner + " after other steps"
+	default:
+		return "", "runs " + hardenRunner + " without 'egress-policy: block'"
+	}
+}
+
+// hardenRunnerStep returns whether harden-runner is the first step of the job
+// and its egress policy, which is "audit" by default, or an empty policy if
+// the job doesn't run it.
+func hardenRunnerStep(job *actionlint.Job) (first bool, policy string) {
+	for i, step := range job.Steps {
+		if step == nil {
+			continue
+		}
+		exec, ok := step.Exec.(*actionlint.ExecAction)
+		if !ok || exec.Uses == nil {
+			continue
+		}
+		action, _, _ := strings.Cut(strings.ToLower(exec.Uses.Value), "@")
+		if action != hardenRunner {
+			continue
+		}
+		policy = "audit"
+		if p, ok := exec.Inputs["egress-policy"]; ok && p.Value != nil {
+			policy = p.Value.Value
+		}
+		return i == 0, policy
+	}
+	return false, ""
+}
+
+func restrictedRunnerLabel(job *actionlint.Job) (string, bool) {
+	// Jobs whose labels can't be resolved are considered not restricted.
+	labels, err := fileparser.GetOSesForJob(job)
+	if err != nil {
+		return "", false
+	}
+	selfHosted := false
+	restricted := ""
+	for _, label := range labels {
+		switch {
+		case strings.EqualFold(label, "self-hosted"):
+			selfHosted = true
+		case reRestrictedLabel.MatchString(label):
+			restricted = label
+		}
+	}
+	return restricted, selfHosted && restricted != ""
+}
+
+func asPointer(n uint) *uint {
+	return &n
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hasRuntimeHardening
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ossf/scorecard/v5/checker"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+const workflow = `on: push
+jobs:
+  blocked:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: step-security/harden-runner@v2
+        with:
+          egress-policy: block
+          allowed-endpoints: github.com:443
+      - run: make
+  audit:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: step-security/harden-runner@v2
+        with:
+          egress-policy: audit
+      - run: make
+  runner:
+    runs-on: [self-hosted, linux, egress-restricted]
+    steps:
+      - run: make
+  hosted:
+    runs-on: [no-network]
+    steps:
+      - run: make
+  late:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v4
+      - uses: step-security/harden-runner@v2
+        with:
+          egress-policy: block
+      - run: make
+  reusable:
+    uses: org/repo/.github/workflows/build.yml@main
+`
+
+const releaseWorkflow = `on: release
+jobs:
+  publish:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/setup-node@v4
+        with:
+          registry-url: https://registry.npmjs.org
+      - run: npm publish
+`
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	type result struct {
+		outcome finding.Outcome
+		job     string
+		method  string
+		release string
+	}
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  []result
+	}{
+		{
+			name:  "no workflows",
+			files: map[string]string{},
+			want:  []result{{outcome: finding.OutcomeNotApplicable}},
+		},
+		{
+			name:  "jobs",
+			files: map[string]string{".github/workflows/ci.yml": workflow},
+			want: []result{
+				{finding.OutcomeFalse, "audit", "", "false"},
+				{finding.OutcomeTrue, "blocked", MethodHardenRunner, "false"},
+				{finding.OutcomeFalse, "hosted", "", "false"},
+				{finding.OutcomeFalse, "late", "", "false"},
+				{finding.OutcomeTrue, "runner", MethodRunnerLabel, "false"},
+			},
+		},
+		{
+			name:  "release workflow",
+			files: map[string]string{".github/workflows/release.yml": releaseWorkflow},
+			want:  []result{{finding.OutcomeFalse, "publish", "", "true"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockRepo := mockrepo.NewMockRepoClient(ctrl)
+			var names []string
+			for name := range tt.files {
+				names = append(names, name)
+			}
+			mockRepo.EXPECT().ListFiles(gomock.Any()).Return(names, nil).AnyTimes()
+			mockRepo.EXPECT().GetFileReader(gomock.Any()).AnyTimes().DoAndReturn(
+				func(file string) (io.ReadCloser, error) {
+					return io.NopCloser(strings.NewReader(tt.files[file])), nil
+				})
+
+			findings, s, err := Run(&checker.CheckRequest{RepoClient: mockRepo})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s != Probe {
+				t.Errorf("probe = %s, want %s", s, Probe)
+			}
+			got := make([]result, 0, len(findings))
+			for i := range findings {
+				f := &findings[i]
+				got = append(got, result{f.Outcome, f.Values[JobKey], f.Values[MethodKey], f.Values[ReleaseKey]})
+			}
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(result{})); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
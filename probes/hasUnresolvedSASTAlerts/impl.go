@@ -0,0 +1,140 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hasUnresolvedSASTAlerts
+
+import (
+	"embed"
+	"fmt"
+	"time"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.SAST})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe       = "hasUnresolvedSASTAlerts"
+	ToolKey     = "tool"
+	RuleKey     = "rule"
+	SeverityKey = "severity"
+
+	// Alerts younger than this are still considered under triage, unless
+	// another age is configured.
+	defaultGracePeriod = 30 * 24 * time.Hour
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := raw.SASTResults
+	if !r.AlertsAvailable {
+		f, err := finding.NewNotAvailable(fs, Probe, "could not list code scanning alerts", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	gracePeriod := r.AlertAge
+	if gracePeriod == 0 {
+		gracePeriod = defaultGracePeriod
+	}
+	var findings []finding.Finding
+	cutoff := time.Now().Add(-gracePeriod)
+	for i := range r.Alerts {
+		a := &r.Alerts[i]
+		if !severe(a.Severity) {
+			continue
+		}
+		// Alerts without a creation time, e.g. from a SARIF file, can't be
+		// shown to be recent, so they're always reported.
+		if !a.CreatedAt.IsZero() && a.CreatedAt.After(cutoff) {
+			continue
+		}
+		msg := fmt.Sprintf("unresolved %s severity alert from %s: %s", a.Severity, a.Tool, a.RuleID)
+		f, err := finding.NewTrue(fs, Probe, msg, alertLocation(a))
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValue(ToolKey, a.Tool).
+			WithValue(RuleKey, a.RuleID).
+			WithValue(SeverityKey, a.Severity)
+		findings = append(findings, *f)
+	}
+
+	if len(findings) == 0 {
+		msg := fmt.Sprintf("no critical or high severity alerts open for more than %s", formatAge(gracePeriod))
+		f, err := finding.NewFalse(fs, Probe, msg, nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
+
+func formatAge(d time.Duration) string {
+	const day = 24 * time.Hour
+	if d%day == 0 {
+		return fmt.Sprintf("%d days", d/day)
+	}
+	return d.String()
+}
+
+// severe returns whether an alert of the given severity is reported. Alerts of
+// rules without a security severity only have a SARIF level, of which "error"
+// is the most severe.
+func severe(severity string) bool {
+	switch severity {
+	case "critical", "high", "error":
+		return true
+	default:
+		return false
+	}
+}
+
+func alertLocation(a *clients.CodeScanningAlert) *finding.Location {
+	switch {
+	case a.Path != "":
+		loc := &finding.Location{
+			Type: finding.FileTypeSource,
+			Path: a.Path,
+		}
+		if a.Line > 0 {
+			line := a.Line
+			loc.LineStart = &line
+		}
+		return loc
+	case a.URL != "":
+		return &finding.Location{
+			Type: finding.FileTypeURL,
+			Path: a.URL,
+		}
+	default:
+		return nil
+	}
+}
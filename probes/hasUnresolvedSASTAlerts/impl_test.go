@@ -0,0 +1,132 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hasUnresolvedSASTAlerts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	old := time.Now().Add(-90 * 24 * time.Hour)
+	recent := time.Now().Add(-24 * time.Hour)
+	tests := []struct {
+		err      error
+		raw      *checker.RawResults
+		name     string
+		outcomes []finding.Outcome
+	}{
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+		{
+			name: "alerts not available",
+			raw:  &checker.RawResults{},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotAvailable,
+			},
+		},
+		{
+			name: "no alerts",
+			raw: &checker.RawResults{
+				SASTResults: checker.SASTData{
+					AlertsAvailable: true,
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeFalse,
+			},
+		},
+		{
+			name: "recent and low severity alerts",
+			raw: &checker.RawResults{
+				SASTResults: checker.SASTData{
+					AlertsAvailable: true,
+					Alerts: []clients.CodeScanningAlert{
+						{Tool: "CodeQL", RuleID: "go/sql-injection", Severity: "critical", CreatedAt: recent},
+						{Tool: "CodeQL", RuleID: "go/log-injection", Severity: "medium", CreatedAt: old},
+						{Tool: "Semgrep", RuleID: "style", Severity: "warning"},
+						{Tool: "Semgrep", RuleID: "unsafe-eval", Severity: "error", CreatedAt: recent},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeFalse,
+			},
+		},
+		{
+			name: "configured age",
+			raw: &checker.RawResults{
+				SASTResults: checker.SASTData{
+					AlertsAvailable: true,
+					AlertAge:        time.Hour,
+					Alerts: []clients.CodeScanningAlert{
+						{Tool: "CodeQL", RuleID: "go/sql-injection", Severity: "critical", CreatedAt: recent},
+						{Tool: "Semgrep", RuleID: "unsafe-eval", Severity: "error", CreatedAt: time.Now()},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeTrue,
+			},
+		},
+		{
+			name: "stale and sarif alerts",
+			raw: &checker.RawResults{
+				SASTResults: checker.SASTData{
+					AlertsAvailable: true,
+					Alerts: []clients.CodeScanningAlert{
+						{Tool: "CodeQL", RuleID: "go/sql-injection", Severity: "critical", CreatedAt: old},
+						{Tool: "CodeQL", RuleID: "go/path-injection", Severity: "high", CreatedAt: recent},
+						{Tool: "gosec", RuleID: "G101", Severity: "high", Path: "main.go", Line: 12},
+						{Tool: "Bandit", RuleID: "B602", Severity: "error", Path: "run.py", Line: 3},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeTrue,
+				finding.OutcomeTrue,
+				finding.OutcomeTrue,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			findings, s, err := Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(Probe, s); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/ossf/scorecard/v5/checker"
 	sce "github.com/ossf/scorecard/v5/errors"
 	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/callchain"
 )
 
 func createText(t checker.TokenPermission) (string, error) {
@@ -71,12 +72,19 @@ func CreateFalseFinding(r checker.TokenPermission,
 
 	if r.File != nil {
 		f = f.WithLocation(r.File.Location())
+		if r.File.CallChain != "" {
+			f = f.WithValue(callchain.Key, r.File.CallChain)
+		}
 		workflowPath := strings.TrimPrefix(f.Location.Path, ".github/workflows/")
 		f = f.WithRemediationMetadata(map[string]string{"workflow": workflowPath})
 	}
 	if metadata != nil {
 		f = f.WithRemediationMetadata(metadata)
 	}
+	// The patch declares the minimal permissions of the job or workflow.
+	if r.Remediation != nil && r.Remediation.Patch != nil && f.Remediation != nil {
+		f = f.WithPatch(r.Remediation.Patch)
+	}
 
 	if r.Name != nil {
 		f = f.WithValue("tokenName", *r.Name)
@@ -100,6 +108,9 @@ func ReadTrueLevelFinding(probe string,
 	}
 	if r.File != nil {
 		f = f.WithLocation(r.File.Location())
+		if r.File.CallChain != "" {
+			f = f.WithValue(callchain.Key, r.File.CallChain)
+		}
 		workflowPath := strings.TrimPrefix(f.Location.Path, ".github/workflows/")
 		f = f.WithRemediationMetadata(map[string]string{"workflow": workflowPath})
 	}
@@ -125,6 +136,9 @@ func CreateNoneFinding(probe string,
 	}
 	if r.File != nil {
 		f = f.WithLocation(r.File.Location())
+		if r.File.CallChain != "" {
+			f = f.WithValue(callchain.Key, r.File.CallChain)
+		}
 		workflowPath := strings.TrimPrefix(f.Location.Path, ".github/workflows/")
 		f = f.WithRemediationMetadata(map[string]string{"workflow": workflowPath})
 	}
@@ -35,6 +35,17 @@ func AssertOutcomes(t *testing.T, got []finding.Finding, want []finding.Outcome)
 	}
 }
 
+// Manifest returns a manifest declaring dependencies at path, locked by
+// lockfile if it isn't nil.
+func Manifest(typ checker.DependencyUseType, path string, lockfile *checker.Lockfile) checker.Dependency {
+	return checker.Dependency{
+		Name:     &path,
+		Location: &checker.File{Path: path, Type: finding.FileTypeSource, Offset: checker.OffsetDefault},
+		Lockfile: lockfile,
+		Type:     typ,
+	}
+}
+
 // Tests for permissions-probes.
 type TestData struct {
 	Name     string
@@ -20,10 +20,16 @@ import (
 
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
 	"github.com/ossf/scorecard/v5/probes/internal/utils/permissions"
 	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
 )
 
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.TokenPermissions})
+}
+
 //go:embed *.yml
 var fs embed.FS
 
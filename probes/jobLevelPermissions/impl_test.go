@@ -21,6 +21,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
 	"github.com/ossf/scorecard/v5/probes/internal/utils/test"
 )
 
@@ -53,3 +54,36 @@ func Test_Run(t *testing.T) {
 		})
 	}
 }
+
+func Test_Run_patch(t *testing.T) {
+	t.Parallel()
+	loc := checker.PermissionLocationJob
+	patch := "--- a/.github/workflows/ci.yml\n+++ b/.github/workflows/ci.yml\n"
+	raw := &checker.RawResults{
+		TokenPermissionsResults: checker.TokenPermissionsData{
+			NumTokens: 1,
+			TokenPermissions: []checker.TokenPermission{
+				{
+					LocationType: &loc,
+					Type:         checker.PermissionLevelUndeclared,
+					File: &checker.File{
+						Path:   ".github/workflows/ci.yml",
+						Type:   finding.FileTypeSource,
+						Offset: 10,
+					},
+					Remediation: &finding.Remediation{Patch: &patch},
+				},
+			},
+		},
+	}
+	findings, _, err := Run(raw)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(findings) != 1 || findings[0].Remediation == nil || findings[0].Remediation.Patch == nil {
+		t.Fatalf("expected one finding with a patch, got %+v", findings)
+	}
+	if got := *findings[0].Remediation.Patch; got != patch {
+		t.Errorf("patch = %q, want %q", got, patch)
+	}
+}
@@ -68,7 +68,7 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 	}
 
 	f, err := finding.NewWith(fs, Probe,
-		"no GitHub/GitLab publishing workflow detected.", nil,
+		"no GitHub/GitLab/Azure Pipelines publishing workflow detected.", nil,
 		finding.OutcomeFalse)
 	if err != nil {
 		return nil, Probe, fmt.Errorf("create finding: %w", err)
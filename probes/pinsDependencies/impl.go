@@ -24,6 +24,7 @@ import (
 	"github.com/ossf/scorecard/v5/finding"
 	"github.com/ossf/scorecard/v5/internal/checknames"
 	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/callchain"
 	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
 )
 
@@ -60,6 +61,11 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 
 	for i := range r.Dependencies {
 		rr := r.Dependencies[i]
+		// Manifests are pinned by lockfiles, see the hasLockfile and
+		// lockfileHasIntegrityHashes probes.
+		if rr.Type.IsManifest() {
+			continue
+		}
 		loc := rr.Location.Location()
 		f, err := finding.NewWith(fs, Probe, "", loc, finding.OutcomeNotSupported)
 		if err != nil {
@@ -94,6 +100,9 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 			f = f.WithValues(map[string]string{
 				DepTypeKey: string(rr.Type),
 			})
+			if rr.Location.CallChain != "" {
+				f = f.WithValue(callchain.Key, rr.Location.CallChain)
+			}
 			findings = append(findings, *f)
 		} else {
 			f = f.WithMessage("").WithOutcome(finding.OutcomeTrue)
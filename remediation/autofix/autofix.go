@@ -0,0 +1,241 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package autofix applies the remediations of Scorecard findings to the files
+// of a local repository.
+package autofix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowScriptInjection"
+	"github.com/ossf/scorecard/v5/probes/jobLevelPermissions"
+	"github.com/ossf/scorecard/v5/probes/pinsDependencies"
+	"github.com/ossf/scorecard/v5/probes/topLevelPermissions"
+	"github.com/ossf/scorecard/v5/remediation"
+)
+
+// Probes are the probes whose findings and raw results a Plan uses.
+var Probes = []string{
+	pinsDependencies.Probe,
+	topLevelPermissions.Probe,
+	jobLevelPermissions.Probe,
+	hasDangerousWorkflowScriptInjection.Probe,
+}
+
+var errPatch = errors.New("invalid patch")
+
+// edit replaces lines [start, end) of a file with lines.
+type edit struct {
+	// source describes the remediation, for reporting.
+	source string
+	lines  []string
+	start  int
+	end    int
+}
+
+func (e *edit) equal(o *edit) bool {
+	return e.start == o.start && e.end == o.end && slices.Equal(e.lines, o.lines)
+}
+
+// overlaps reports whether the edits change the same lines. Insertions at
+// the same line only overlap if they fix the same kind of finding, e.g. two
+// permissions blocks. Otherwise, they're applied in the order they're added.
+func (e *edit) overlaps(o *edit) bool {
+	switch {
+	case e.start == e.end && o.start == o.end:
+		return e.start == o.start && e.source == o.source
+	case e.start == e.end:
+		return o.start < e.start && e.start < o.end
+	case o.start == o.end:
+		return e.start < o.start && o.start < e.end
+	default:
+		return e.start < o.end && o.start < e.end
+	}
+}
+
+type file struct {
+	lines []string
+	edits []edit
+}
+
+// Options configures how a Plan resolves the versions to pin dependencies to.
+type Options struct {
+	// Resolver resolves the commit SHAs to pin GitHub Actions to.
+	// Actions aren't pinned if it's nil.
+	Resolver ActionResolver
+	// Digester resolves the digests to pin container images to.
+	// Images aren't pinned if it's nil.
+	Digester remediation.Digester
+}
+
+// Plan holds the changes fixing the findings of a Scorecard run.
+type Plan struct {
+	files map[string]*file
+	root  string
+	// Skipped describes the remediations which couldn't be applied.
+	Skipped []string
+}
+
+// NewPlan computes the changes to the repository at root which fix the
+// findings and raw results of a Scorecard run of the Probes on it.
+// Remediations which can't be applied, e.g. because they conflict with
+// another change, are listed in the plan's Skipped field.
+func NewPlan(ctx context.Context, root string, raw *checker.RawResults, findings []finding.Finding,
+	opts Options,
+) (*Plan, error) {
+	p := &Plan{root: root, files: make(map[string]*file)}
+	for i := range findings {
+		f := &findings[i]
+		if f.Outcome != finding.OutcomeFalse && f.Outcome != finding.OutcomeTrue {
+			continue
+		}
+		if f.Remediation == nil || f.Remediation.Patch == nil {
+			continue
+		}
+		if err := p.addPatch(f.Probe, *f.Remediation.Patch); err != nil {
+			p.skip("%s: %v", f.Probe, err)
+		}
+	}
+	if raw != nil {
+		if err := p.addPins(ctx, raw.PinningDependenciesResults.Dependencies, opts); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+func (p *Plan) skip(format string, args ...any) {
+	p.Skipped = append(p.Skipped, fmt.Sprintf(format, args...))
+}
+
+func (p *Plan) file(path string) (*file, error) {
+	if f, ok := p.files[path]; ok {
+		return f, nil
+	}
+	if !filepath.IsLocal(path) {
+		return nil, fmt.Errorf("%w: path outside the repository: %s", errPatch, path)
+	}
+	content, err := os.ReadFile(filepath.Join(p.root, path))
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	f := &file{lines: strings.Split(string(content), "\n")}
+	p.files[path] = f
+	return f, nil
+}
+
+// add records the edits of a remediation, unless one of them conflicts
+// with an edit already recorded. Duplicates, e.g. the same permissions patch
+// reported for each scope of a job, are dropped.
+func (p *Plan) add(path string, edits ...edit) {
+	if len(edits) == 0 {
+		return
+	}
+	f, err := p.file(path)
+	if err != nil {
+		p.skip("%s: %v", edits[0].source, err)
+		return
+	}
+	var added []edit
+	for i := range edits {
+		e := &edits[i]
+		duplicate := false
+		for j := range f.edits {
+			if f.edits[j].equal(e) {
+				duplicate = true
+				break
+			}
+			if f.edits[j].overlaps(e) {
+				p.skip("%s: %s:%d conflicts with %s", e.source, path, e.start+1, f.edits[j].source)
+				return
+			}
+		}
+		if !duplicate {
+			added = append(added, *e)
+		}
+	}
+	f.edits = append(f.edits, added...)
+}
+
+// Files returns the paths of the files the plan changes.
+func (p *Plan) Files() []string {
+	var paths []string
+	for path, f := range p.files {
+		if len(f.edits) > 0 {
+			paths = append(paths, path)
+		}
+	}
+	slices.Sort(paths)
+	return paths
+}
+
+// Diff returns the changes of the plan as a unified diff.
+func (p *Plan) Diff() string {
+	var b strings.Builder
+	for _, path := range p.Files() {
+		f := p.files[path]
+		b.WriteString(unifiedDiff(path, f.lines, sortedEdits(f.edits)))
+	}
+	return b.String()
+}
+
+// Apply writes the changes of the plan to the repository.
+func (p *Plan) Apply() error {
+	for _, path := range p.Files() {
+		f := p.files[path]
+		full := filepath.Join(p.root, path)
+		info, err := os.Stat(full)
+		if err != nil {
+			return fmt.Errorf("stat %s: %w", path, err)
+		}
+		content := strings.Join(applyEdits(f.lines, f.edits), "\n")
+		if err := os.WriteFile(full, []byte(content), info.Mode().Perm()); err != nil {
+			return fmt.Errorf("writing %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+func sortedEdits(edits []edit) []edit {
+	sorted := slices.Clone(edits)
+	// At the same line, insertions go before replacements.
+	slices.SortStableFunc(sorted, func(a, b edit) int {
+		if a.start != b.start {
+			return a.start - b.start
+		}
+		return a.end - b.end
+	})
+	return sorted
+}
+
+func applyEdits(lines []string, edits []edit) []string {
+	sorted := sortedEdits(edits)
+	var out []string
+	i := 0
+	for _, e := range sorted {
+		out = append(out, lines[i:e.start]...)
+		out = append(out, e.lines...)
+		i = e.end
+	}
+	return append(out, lines[i:]...)
+}
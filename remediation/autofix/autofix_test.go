@@ -0,0 +1,299 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autofix
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+const (
+	checkoutSHA = "8e5e7e5ab8b370d6c329ec480221332ada57f0ab"
+	golangImage = "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+)
+
+var errUnknown = errors.New("unknown")
+
+type stubResolver struct{}
+
+func (stubResolver) Resolve(_ context.Context, repo, ref string) (string, error) {
+	if repo == "actions/checkout" && ref == "v4" {
+		return checkoutSHA, nil
+	}
+	return "", errUnknown
+}
+
+type stubDigester struct{}
+
+func (stubDigester) Digest(name string) (string, error) {
+	if name == "golang:1.22" {
+		return golangImage, nil
+	}
+	return "", errUnknown
+}
+
+const workflow = `name: ci
+on: push
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v4
+      - uses: actions/setup-go@v5
+      - run: go test ./...
+`
+
+const dockerfile = `FROM golang:1.22 AS build
+RUN go build ./...
+FROM scratch
+`
+
+const permissionsPatch = `diff --git a/.github/workflows/ci.yml b/.github/workflows/ci.yml
+--- a/.github/workflows/ci.yml
++++ b/.github/workflows/ci.yml
+@@ -1,5 +1,7 @@
+ name: ci
+ on: push
++permissions:
++  contents: read
+ jobs:
+   build:
+     runs-on: ubuntu-latest
+`
+
+const scriptInjectionPatch = `diff --git a/.github/workflows/ci.yml b/.github/workflows/ci.yml
+--- a/.github/workflows/ci.yml
++++ b/.github/workflows/ci.yml
+@@ -1,9 +1,11 @@
+ name: ci
+ on: push
++env:
++  REF: ${{ github.head_ref }}
+ jobs:
+   build:
+     runs-on: ubuntu-latest
+     steps:
+       - uses: actions/checkout@v4
+       - uses: actions/setup-go@v5
+-      - run: go test ./...
++      - run: go test ./... "$REF"
+`
+
+func setup(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	files := map[string]string{
+		".github/workflows/ci.yml": workflow,
+		"Dockerfile":               dockerfile,
+	}
+	for path, content := range files {
+		full := filepath.Join(root, path)
+		if err := os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(full, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func asPointer[T any](v T) *T {
+	return &v
+}
+
+func dependency(typ checker.DependencyUseType, path string, line uint, name, pinnedAt string) checker.Dependency {
+	return checker.Dependency{
+		Name:     asPointer(name),
+		PinnedAt: asPointer(pinnedAt),
+		Pinned:   asPointer(false),
+		Type:     typ,
+		Location: &checker.File{Path: path, Offset: line, EndOffset: line},
+	}
+}
+
+func patchFinding(probe, patch string) finding.Finding {
+	return finding.Finding{
+		Probe:       probe,
+		Outcome:     finding.OutcomeFalse,
+		Remediation: &finding.Remediation{Patch: &patch},
+	}
+}
+
+func TestPlan(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		deps     []checker.Dependency
+		findings []finding.Finding
+		want     string
+		skipped  int
+	}{
+		{
+			name: "pins and patches",
+			deps: []checker.Dependency{
+				dependency(checker.DependencyUseTypeGHAction, ".github/workflows/ci.yml", 7, "actions/checkout", "v4"),
+				dependency(checker.DependencyUseTypeDockerfileContainerImage, "Dockerfile", 1, "golang", "1.22"),
+			},
+			findings: []finding.Finding{
+				patchFinding("topLevelPermissions", permissionsPatch),
+				// Duplicates are applied once.
+				patchFinding("topLevelPermissions", permissionsPatch),
+			},
+			want: `--- a/.github/workflows/ci.yml
++++ b/.github/workflows/ci.yml
+@@ -1,9 +1,11 @@
+ name: ci
+ on: push
++permissions:
++  contents: read
+ jobs:
+   build:
+     runs-on: ubuntu-latest
+     steps:
+-      - uses: actions/checkout@v4
++      - uses: actions/checkout@` + checkoutSHA + ` # v4
+       - uses: actions/setup-go@v5
+       - run: go test ./...
+--- a/Dockerfile
++++ b/Dockerfile
+@@ -1,3 +1,3 @@
+-FROM golang:1.22 AS build
++FROM golang:1.22@` + golangImage + ` AS build
+ RUN go build ./...
+ FROM scratch
+`,
+		},
+		{
+			name: "insertions at the same line",
+			findings: []finding.Finding{
+				patchFinding("topLevelPermissions", permissionsPatch),
+				patchFinding("hasDangerousWorkflowScriptInjection", scriptInjectionPatch),
+			},
+			want: `--- a/.github/workflows/ci.yml
++++ b/.github/workflows/ci.yml
+@@ -1,9 +1,13 @@
+ name: ci
+ on: push
++permissions:
++  contents: read
++env:
++  REF: ${{ github.head_ref }}
+ jobs:
+   build:
+     runs-on: ubuntu-latest
+     steps:
+       - uses: actions/checkout@v4
+       - uses: actions/setup-go@v5
+-      - run: go test ./...
++      - run: go test ./... "$REF"
+`,
+		},
+		{
+			name: "unresolved refs and pinned dependencies",
+			deps: []checker.Dependency{
+				dependency(checker.DependencyUseTypeGHAction, ".github/workflows/ci.yml", 8, "actions/setup-go", "v5"),
+				{
+					Name:     asPointer("actions/checkout"),
+					PinnedAt: asPointer(checkoutSHA),
+					Pinned:   asPointer(true),
+					Type:     checker.DependencyUseTypeGHAction,
+					Location: &checker.File{Path: ".github/workflows/ci.yml", Offset: 7},
+				},
+			},
+			skipped: 1,
+		},
+		{
+			name: "conflicting and stale patches",
+			findings: []finding.Finding{
+				patchFinding("topLevelPermissions", permissionsPatch),
+				patchFinding("topLevelPermissions", strings.Replace(permissionsPatch, "contents: read", "read-all", 1)),
+				patchFinding("topLevelPermissions", strings.Replace(permissionsPatch, " on: push", " on: pull_request", 1)),
+			},
+			want: `--- a/.github/workflows/ci.yml
++++ b/.github/workflows/ci.yml
+@@ -1,5 +1,7 @@
+ name: ci
+ on: push
++permissions:
++  contents: read
+ jobs:
+   build:
+     runs-on: ubuntu-latest
+`,
+			skipped: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			root := setup(t)
+			raw := &checker.RawResults{}
+			raw.PinningDependenciesResults.Dependencies = tt.deps
+			p, err := NewPlan(context.Background(), root, raw, tt.findings, Options{
+				Resolver: stubResolver{},
+				Digester: stubDigester{},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, p.Diff()); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			if len(p.Skipped) != tt.skipped {
+				t.Errorf("skipped %v, want %d", p.Skipped, tt.skipped)
+			}
+		})
+	}
+}
+
+func TestPlan_Apply(t *testing.T) {
+	t.Parallel()
+	root := setup(t)
+	raw := &checker.RawResults{}
+	raw.PinningDependenciesResults.Dependencies = []checker.Dependency{
+		dependency(checker.DependencyUseTypeGHAction, ".github/workflows/ci.yml", 7, "actions/checkout", "v4"),
+	}
+	findings := []finding.Finding{patchFinding("topLevelPermissions", permissionsPatch)}
+	p, err := NewPlan(context.Background(), root, raw, findings, Options{Resolver: stubResolver{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Apply(); err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(filepath.Join(root, ".github/workflows/ci.yml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Replace(workflow, "on: push\n", "on: push\npermissions:\n  contents: read\n", 1)
+	want = strings.Replace(want, "checkout@v4", "checkout@"+checkoutSHA+" # v4", 1)
+	if diff := cmp.Diff(want, string(got)); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+	// Files without changes are left alone.
+	if got := p.Files(); len(got) != 1 {
+		t.Errorf("Files() = %v, want one file", got)
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autofix
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const diffContext = 3
+
+var reHunkHeader = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+\d+(?:,\d+)? @@`)
+
+// addPatch records the edits of a unified diff, after checking that it
+// applies to the files of the repository.
+func (p *Plan) addPatch(source, patch string) error {
+	var path string
+	var f *file
+	// Edits are recorded once the whole patch is known to apply.
+	edits := make(map[string][]edit)
+	lines := strings.Split(patch, "\n")
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		switch {
+		case strings.HasPrefix(line, "+++ "):
+			path = strings.TrimPrefix(strings.TrimPrefix(line, "+++ "), "b/")
+			var err error
+			if f, err = p.file(path); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "@@"):
+			m := reHunkHeader.FindStringSubmatch(line)
+			if m == nil || f == nil {
+				return fmt.Errorf("%w: %q", errPatch, line)
+			}
+			start, err := strconv.Atoi(m[1])
+			if err != nil {
+				return fmt.Errorf("%w: %q", errPatch, line)
+			}
+			var hunk []edit
+			hunk, i, err = parseHunk(f.lines, lines, i+1, max(0, start-1), source)
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			edits[path] = append(edits[path], hunk...)
+		}
+	}
+	if f == nil {
+		return fmt.Errorf("%w: no file header", errPatch)
+	}
+	for _, path := range slices.Sorted(maps.Keys(edits)) {
+		p.add(path, edits[path]...)
+	}
+	return nil
+}
+
+// parseHunk parses the hunk body starting at lines[i], for a hunk starting
+// at index start of the original file. It returns the edits and the index
+// of the last line of the hunk.
+func parseHunk(original, lines []string, i, start int, source string) ([]edit, int, error) {
+	var edits []edit
+	var current *edit
+	pos := start
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if line == "" || strings.HasPrefix(line, "@@") || strings.HasPrefix(line, "diff ") ||
+			strings.HasPrefix(line, "--- ") {
+			break
+		}
+		if strings.HasPrefix(line, "\\") {
+			// "\ No newline at end of file"
+			continue
+		}
+		op, text := line[0], line[1:]
+		switch op {
+		case ' ', '-':
+			if pos >= len(original) || original[pos] != text {
+				return nil, i, fmt.Errorf("%w: doesn't match line %d", errPatch, pos+1)
+			}
+			if op == ' ' {
+				if current != nil {
+					edits = append(edits, *current)
+					current = nil
+				}
+				pos++
+				continue
+			}
+			if current == nil {
+				current = &edit{source: source, start: pos, end: pos}
+			}
+			current.end++
+			pos++
+		case '+':
+			if current == nil {
+				current = &edit{source: source, start: pos, end: pos}
+			}
+			current.lines = append(current.lines, text)
+		default:
+			return nil, i, fmt.Errorf("%w: %q", errPatch, line)
+		}
+	}
+	if current != nil {
+		edits = append(edits, *current)
+	}
+	return edits, i - 1, nil
+}
+
+// unifiedDiff renders the edits, sorted and not overlapping, as a unified diff.
+func unifiedDiff(path string, lines []string, edits []edit) string {
+	// A trailing newline splits into an empty last line, which isn't a line of the file.
+	n := len(lines)
+	if n > 0 && lines[n-1] == "" {
+		n--
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- a/%s\n+++ b/%s\n", path, path)
+	// delta is the difference between line numbers in the new and old file.
+	delta := 0
+	for i := 0; i < len(edits); {
+		// Group the edits whose context overlaps into one hunk.
+		j := i + 1
+		for j < len(edits) && edits[j].start-diffContext <= edits[j-1].end+diffContext {
+			j++
+		}
+		from := max(0, edits[i].start-diffContext)
+		to := min(n, edits[j-1].end+diffContext)
+		var body strings.Builder
+		oldLen, newLen := 0, 0
+		pos := from
+		for _, e := range edits[i:j] {
+			for ; pos < e.start; pos++ {
+				body.WriteString(" " + lines[pos] + "\n")
+				oldLen++
+				newLen++
+			}
+			for ; pos < e.end; pos++ {
+				body.WriteString("-" + lines[pos] + "\n")
+				oldLen++
+			}
+			for _, l := range e.lines {
+				body.WriteString("+" + l + "\n")
+				newLen++
+			}
+		}
+		for ; pos < to; pos++ {
+			body.WriteString(" " + lines[pos] + "\n")
+			oldLen++
+			newLen++
+		}
+		fmt.Fprintf(&b, "@@ -%d,%d +%d,%d @@\n", from+1, oldLen, from+1+delta, newLen)
+		b.WriteString(body.String())
+		delta += newLen - oldLen
+		i = j
+	}
+	return b.String()
+}
@@ -0,0 +1,160 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autofix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/storage/memory"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/remediation"
+)
+
+var errRefNotFound = errors.New("ref not found")
+
+var reFullSHA = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// ActionResolver resolves a ref of a GitHub repository to a commit SHA.
+type ActionResolver interface {
+	Resolve(ctx context.Context, repo, ref string) (string, error)
+}
+
+// GitResolver resolves refs by listing the refs of the repository on GitHub,
+// like git ls-remote. It doesn't need a GitHub token.
+type GitResolver struct{}
+
+// Resolve implements ActionResolver.
+func (GitResolver) Resolve(ctx context.Context, repo, ref string) (string, error) {
+	remote := git.NewRemote(memory.NewStorage(), &config.RemoteConfig{
+		Name: "origin",
+		URLs: []string{"https://github.com/" + repo + ".git"},
+	})
+	refs, err := remote.ListContext(ctx, &git.ListOptions{PeelingOption: git.AppendPeeled})
+	if err != nil {
+		return "", fmt.Errorf("listing refs of %s: %w", repo, err)
+	}
+	hashes := make(map[string]string, len(refs))
+	for _, r := range refs {
+		hashes[r.Name().String()] = r.Hash().String()
+	}
+	// Annotated tags are peeled to the commit they point to.
+	for _, name := range []string{"refs/tags/" + ref + "^{}", "refs/tags/" + ref, "refs/heads/" + ref} {
+		if sha, ok := hashes[name]; ok {
+			return sha, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s@%s", errRefNotFound, repo, ref)
+}
+
+// addPins records edits pinning the unpinned GitHub Actions and container
+// images of the repository.
+func (p *Plan) addPins(ctx context.Context, deps []checker.Dependency, opts Options) error {
+	for i := range deps {
+		d := &deps[i]
+		if d.Pinned == nil || *d.Pinned || d.Location == nil || d.Location.CallChain != "" ||
+			d.Name == nil || d.Location.Offset == 0 {
+			continue
+		}
+		var err error
+		switch {
+		case d.Type == checker.DependencyUseTypeGHAction && opts.Resolver != nil:
+			err = p.pinAction(ctx, d, opts.Resolver)
+		case d.Type.IsContainerImage() && opts.Digester != nil:
+			err = p.pinImage(d, opts.Digester)
+		default:
+			continue
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("pinning dependencies: %w", ctx.Err())
+			}
+			p.skip("pinning %s in %s:%d: %v", *d.Name, d.Location.Path, d.Location.Offset, err)
+		}
+	}
+	return nil
+}
+
+// pinAction replaces owner/repo[/path]@ref with owner/repo[/path]@sha # ref.
+func (p *Plan) pinAction(ctx context.Context, d *checker.Dependency, resolver ActionResolver) error {
+	if d.PinnedAt == nil || *d.PinnedAt == "" {
+		return fmt.Errorf("%w: no ref", errRefNotFound)
+	}
+	name, ref := *d.Name, *d.PinnedAt
+	parts := strings.SplitN(name, "/", 3)
+	if len(parts) < 2 || strings.HasPrefix(name, "docker://") {
+		return fmt.Errorf("%w: not a GitHub action: %s", errRefNotFound, name)
+	}
+	sha, err := resolver.Resolve(ctx, parts[0]+"/"+parts[1], ref)
+	if err != nil {
+		return err //nolint:wrapcheck // resolvers describe the ref in their errors
+	}
+	if !reFullSHA.MatchString(sha) {
+		return fmt.Errorf("%w: %s@%s resolved to %q", errRefNotFound, name, ref, sha)
+	}
+	return p.replaceInLine(d, name+"@"+ref, name+"@"+sha, " # "+ref)
+}
+
+// pinImage replaces name[:tag] with name[:tag]@digest.
+func (p *Plan) pinImage(d *checker.Dependency, digester remediation.Digester) error {
+	image := *d.Name
+	if d.PinnedAt != nil && *d.PinnedAt != "" {
+		image += ":" + *d.PinnedAt
+	}
+	digest, err := digester.Digest(image)
+	if err != nil {
+		return fmt.Errorf("resolving the digest of %s: %w", image, err)
+	}
+	return p.replaceInLine(d, image, image+"@"+digest, "")
+}
+
+// replaceInLine edits the line of the dependency, replacing the first
+// occurrence of old not followed by a name character or a digest.
+// The comment is appended if the line has none.
+func (p *Plan) replaceInLine(d *checker.Dependency, old, replacement, comment string) error {
+	path := d.Location.Path
+	f, err := p.file(path)
+	if err != nil {
+		return err
+	}
+	i := int(d.Location.Offset) - 1
+	if i >= len(f.lines) {
+		return fmt.Errorf("%w: line %d out of range", errPatch, i+1)
+	}
+	line := f.lines[i]
+	re := regexp.MustCompile(regexp.QuoteMeta(old) + `($|[^\w.:@/-])`)
+	loc := re.FindStringIndex(line)
+	if loc == nil {
+		return fmt.Errorf("%w: %q not found on line %d", errPatch, old, i+1)
+	}
+	end := loc[0] + len(old)
+	fixed := line[:loc[0]] + replacement + line[end:]
+	if comment != "" && !strings.Contains(line, "#") {
+		fixed += comment
+	}
+	p.add(path, edit{
+		source: "pinning " + old,
+		start:  i,
+		end:    i + 1,
+		lines:  []string{fixed},
+	})
+	return nil
+}
@@ -24,4 +24,16 @@ var (
 	CheckErrors = stats.Int64("CheckErrors", "Measures the count of errors", stats.UnitDimensionless)
 	// HTTPRequests measures the count of HTTP requests.
 	HTTPRequests = stats.Int64("HTTPRequests", "Measures the count of HTTP requests", stats.UnitDimensionless)
+	// HTTPRequestErrors measures the count of failed HTTP requests, including error responses.
+	HTTPRequestErrors = stats.Int64("HTTPRequestErrors", "Measures the count of failed HTTP requests",
+		stats.UnitDimensionless)
+	// CheckLatencyInMs measures the wall-clock latency of a check in milliseconds.
+	CheckLatencyInMs = stats.Float64("CheckLatency", "Measures the wall-clock latency of a check",
+		stats.UnitMilliseconds)
+	// RepoClientCalls measures the count of RepoClient calls made by checks.
+	RepoClientCalls = stats.Int64("RepoClientCalls", "Measures the count of RepoClient calls",
+		stats.UnitDimensionless)
+	// RepoClientErrors measures the count of RepoClient calls which failed.
+	RepoClientErrors = stats.Int64("RepoClientErrors", "Measures the count of failed RepoClient calls",
+		stats.UnitDimensionless)
 )
@@ -25,4 +25,6 @@ var (
 	RequestTag = tag.MustNewKey("requestTag")
 	// RepoHost is the tag key for the host of the repository being scanned.
 	RepoHost = tag.MustNewKey("repoHost")
+	// ClientMethod is the tag key for the RepoClient method called by a check.
+	ClientMethod = tag.MustNewKey("clientMethod")
 )
@@ -54,6 +54,46 @@ var (
 		Aggregation: view.Count(),
 	}
 
+	// CheckLatency tracks the wall-clock latency of checks.
+	CheckLatency = view.View{
+		Name:        "CheckLatency",
+		Description: "Wall-clock latency per check in milliseconds",
+		Measure:     CheckLatencyInMs,
+		TagKeys:     []tag.Key{CheckName, RepoHost},
+		Aggregation: view.Distribution(
+			10, 50, 100, 250, 500,
+			1000, 2500, 5000, 10000, 30000,
+			60000, 120000, 300000, 600000),
+	}
+
+	// RepoClientCallCount tracks the RepoClient calls of checks, to tell which
+	// checks use the most forge API calls.
+	RepoClientCallCount = view.View{
+		Name:        "RepoClientCallCount",
+		Description: "RepoClient calls by method per check",
+		Measure:     RepoClientCalls,
+		TagKeys:     []tag.Key{CheckName, RepoHost, ClientMethod},
+		Aggregation: view.Count(),
+	}
+
+	// RepoClientErrorCount tracks the RepoClient calls of checks which failed.
+	RepoClientErrorCount = view.View{
+		Name:        "RepoClientErrorCount",
+		Description: "Failed RepoClient calls by method per check",
+		Measure:     RepoClientErrors,
+		TagKeys:     []tag.Key{CheckName, RepoHost, ClientMethod},
+		Aggregation: view.Count(),
+	}
+
+	// OutgoingHTTPRequestErrors tracks failed HTTPRequests.
+	OutgoingHTTPRequestErrors = view.View{
+		Name:        "OutgoingHTTPRequestErrors",
+		Description: "Failed HTTPRequests by error",
+		Measure:     HTTPRequestErrors,
+		TagKeys:     []tag.Key{RepoHost, ErrorName},
+		Aggregation: view.Count(),
+	}
+
 	// OutgoingHTTPRequests tracks HTTPRequests made.
 	OutgoingHTTPRequests = view.View{
 		Name:        "OutgoingHTTPRequests",